@@ -47,6 +47,7 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 		Long:    "List all teams that have installed the app",
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "app list", Meaning: "List all teams with the app installed"},
+			{Command: "app list --output json", Meaning: "List all teams with the app installed as JSON"},
 		}),
 		Args: cobra.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -70,6 +71,9 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	if err != nil {
 		return err
 	}
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, newListOutput(envs))
+	}
 	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
 		Emoji:     "house_buildings",
 		Text:      "Apps",
@@ -78,6 +82,49 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	return nil
 }
 
+// listOutput is the structured output of the app list command
+type listOutput struct {
+	Apps []appOutput `json:"apps"`
+}
+
+// appOutput describes an app of the project. Every workspace grant of an app
+// installed to an organization is included.
+type appOutput struct {
+	AppID           string                  `json:"app_id"`
+	TeamDomain      string                  `json:"team_domain"`
+	TeamID          string                  `json:"team_id"`
+	UserID          string                  `json:"user_id,omitempty"`
+	EnterpriseID    string                  `json:"enterprise_id,omitempty"`
+	IsDev           bool                    `json:"is_dev"`
+	Status          string                  `json:"status"`
+	WorkspaceGrants []types.EnterpriseGrant `json:"workspace_grants,omitempty"`
+}
+
+// newListOutput returns the structured output of project apps
+func newListOutput(apps []types.App) listOutput {
+	output := listOutput{Apps: []appOutput{}}
+	for _, app := range apps {
+		if app.AppID == "" {
+			continue
+		}
+		grants := append([]types.EnterpriseGrant{}, app.EnterpriseGrants...)
+		sort.Slice(grants, func(i, j int) bool {
+			return grants[i].WorkspaceDomain < grants[j].WorkspaceDomain
+		})
+		output.Apps = append(output.Apps, appOutput{
+			AppID:           app.AppID,
+			TeamDomain:      app.TeamDomain,
+			TeamID:          app.TeamID,
+			UserID:          app.UserID,
+			EnterpriseID:    app.EnterpriseID,
+			IsDev:           app.IsDev,
+			Status:          strings.ToLower(app.InstallStatus.String()),
+			WorkspaceGrants: grants,
+		})
+	}
+	return output
+}
+
 // formatListSuccess formats details about the list of project apps
 func formatListSuccess(apps []types.App) (secondaryText []string) {
 	for _, app := range apps {
@@ -228,3 +228,50 @@ func TestAppsListFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestAppsListOutput(t *testing.T) {
+	output := newListOutput([]types.App{
+		{},
+		{
+			AppID:         "A001",
+			TeamDomain:    "sandbox",
+			TeamID:        "T001",
+			InstallStatus: types.AppStatusInstalled,
+		},
+		{
+			AppID:         "A002",
+			TeamDomain:    "grid",
+			TeamID:        "E001",
+			EnterpriseID:  "E001",
+			IsDev:         true,
+			InstallStatus: types.AppStatusUninstalled,
+			EnterpriseGrants: []types.EnterpriseGrant{
+				{WorkspaceID: "T003", WorkspaceDomain: "zoo"},
+				{WorkspaceID: "T002", WorkspaceDomain: "alpha"},
+			},
+		},
+	})
+	assert.Equal(t, listOutput{
+		Apps: []appOutput{
+			{
+				AppID:           "A001",
+				TeamDomain:      "sandbox",
+				TeamID:          "T001",
+				Status:          "installed",
+				WorkspaceGrants: []types.EnterpriseGrant{},
+			},
+			{
+				AppID:        "A002",
+				TeamDomain:   "grid",
+				TeamID:       "E001",
+				EnterpriseID: "E001",
+				IsDev:        true,
+				Status:       "uninstalled",
+				WorkspaceGrants: []types.EnterpriseGrant{
+					{WorkspaceID: "T002", WorkspaceDomain: "alpha"},
+					{WorkspaceID: "T003", WorkspaceDomain: "zoo"},
+				},
+			},
+		},
+	}, output)
+}
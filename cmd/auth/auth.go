@@ -29,6 +29,7 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "auth list", Meaning: "List all authorized accounts"},
 			{Command: "auth login", Meaning: "Log in to a Slack account"},
 			{Command: "auth logout", Meaning: "Log out of a team"},
+			{Command: "auth refresh", Meaning: "Refresh expiring authentication tokens"},
 		}),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -40,6 +41,7 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.AddCommand(NewListCommand(clients))
 	cmd.AddCommand(NewLoginCommand(clients))
 	cmd.AddCommand(NewLogoutCommand(clients))
+	cmd.AddCommand(NewRefreshCommand(clients))
 	cmd.AddCommand(NewRevokeCommand(clients))
 	cmd.AddCommand(NewTokenCommand(clients))
 
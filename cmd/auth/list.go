@@ -16,6 +16,7 @@ package auth
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/logger"
@@ -41,6 +42,7 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 		Long:  "List all authorized accounts",
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "auth list", Meaning: "List all authorized accounts"},
+			{Command: "auth list --output json", Meaning: "List all authorized accounts for scripts to read"},
 		}),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,6 +54,13 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 // runListCommand will execute the list command
 func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	ctx := cmd.Context()
+	if clients.IO.IsStructuredOutput() {
+		userAuthList, err := listFunc(ctx, clients, logger.New(nil))
+		if err != nil {
+			return err
+		}
+		return clients.IO.PrintStructured(ctx, newAuthListOutput(userAuthList))
+	}
 	log := newListLogger(cmd, clients.IO)
 	userAuthList, err := listFunc(ctx, clients, log)
 	if err != nil {
@@ -61,6 +70,52 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	return nil
 }
 
+// authListOutput is the structured output of the auth list command. Tokens are
+// never included.
+type authListOutput struct {
+	Authorizations []authOutput `json:"authorizations"`
+}
+
+// authOutput describes a saved authorization
+type authOutput struct {
+	TeamDomain   string     `json:"team_domain"`
+	TeamID       string     `json:"team_id"`
+	EnterpriseID string     `json:"enterprise_id,omitempty"`
+	UserID       string     `json:"user_id"`
+	Profile      string     `json:"profile,omitempty"`
+	APIHost      string     `json:"api_host,omitempty"`
+	AuthLevel    string     `json:"auth_level"`
+	LastUpdated  time.Time  `json:"last_updated"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	Refreshable  bool       `json:"refreshable"`
+}
+
+// newAuthListOutput returns the structured output of authorizations
+func newAuthListOutput(userAuthList []types.SlackAuth) authListOutput {
+	output := authListOutput{Authorizations: make([]authOutput, 0, len(userAuthList))}
+	for _, authInfo := range userAuthList {
+		auth := authOutput{
+			TeamDomain:   authInfo.TeamDomain,
+			TeamID:       authInfo.TeamID,
+			EnterpriseID: authInfo.EnterpriseID,
+			UserID:       authInfo.UserID,
+			Profile:      authInfo.Profile,
+			AuthLevel:    authInfo.AuthLevel(),
+			LastUpdated:  authInfo.LastUpdated,
+			Refreshable:  authInfo.CanRotateToken(),
+		}
+		if authInfo.APIHost != nil {
+			auth.APIHost = *authInfo.APIHost
+		}
+		if authInfo.ExpiresAt != 0 {
+			expiry := authInfo.TokenExpiry().UTC()
+			auth.ExpiresAt = &expiry
+		}
+		output.Authorizations = append(output.Authorizations, auth)
+	}
+	return output
+}
+
 // newListLogger creates a logger instance to receive event notifications
 func newListLogger(cmd *cobra.Command, IO iostreams.IOStreamer) *logger.Logger {
 	return logger.New(
@@ -85,8 +140,10 @@ func newListLogger(cmd *cobra.Command, IO iostreams.IOStreamer) *logger.Logger {
 //
 // trashpanda-dev (Team ID: T01TY782HVV)
 // User ID: U01PHTW5JFR
+// Profile: service (optional, only shown for logins saved with a profile)
 // API Host: https://dev.slack.com (optional, only shown for custom API Hosts)
 // Last Updated: 2021-03-12 11:18:00 -0700
+// Token Expires: 2021-03-12 23:18:00 -0700 (in 12h0m0s) (optional, only shown for expiring tokens)
 func printAuthList(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []types.SlackAuth) {
 	ctx := cmd.Context()
 
@@ -105,6 +162,12 @@ func printAuthList(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []t
 			style.Secondary("User ID: %s\n"),
 			authInfo.UserID,
 		)
+		if authInfo.Profile != "" {
+			cmd.Printf(
+				style.Secondary("Profile: %s\n"),
+				authInfo.Profile,
+			)
+		}
 		if authInfo.APIHost != nil {
 			cmd.Printf(
 				style.Secondary("API Host: %s\n"),
@@ -115,6 +178,12 @@ func printAuthList(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []t
 			style.Secondary("Last Updated: %s\n"),
 			authInfo.LastUpdated.Format(timeFormat),
 		)
+		if authInfo.ExpiresAt != 0 {
+			cmd.Printf(
+				style.Secondary("Token Expires: %s\n"),
+				formatTokenExpiry(authInfo, time.Now(), timeFormat),
+			)
+		}
 		caser := cases.Title(language.English)
 		cmd.Printf(
 			style.Secondary("Authorization Level: %s\n"),
@@ -136,6 +205,20 @@ func printAuthList(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []t
 	IO.PrintTrace(ctx, slacktrace.AuthListCount, fmt.Sprint(len(userAuthList)))
 }
 
+// formatTokenExpiry returns the expiration time of a token with the time that
+// remains or that has passed since
+func formatTokenExpiry(auth types.SlackAuth, now time.Time, timeFormat string) string {
+	expiry := auth.TokenExpiry().In(now.Location())
+	remaining := expiry.Sub(now).Round(time.Minute)
+	if remaining <= 0 {
+		return fmt.Sprintf("%s (expired %s ago)", expiry.Format(timeFormat), -remaining)
+	}
+	if !auth.CanRotateToken() {
+		return fmt.Sprintf("%s (in %s, not refreshable)", expiry.Format(timeFormat), remaining)
+	}
+	return fmt.Sprintf("%s (in %s)", expiry.Format(timeFormat), remaining)
+}
+
 // printAuthListSuccess is displayed at the very end and helps guide the developer toward next steps.
 func printAuthListSuccess(cmd *cobra.Command, IO iostreams.IOStreamer, userAuthList []types.SlackAuth) {
 	ctx := cmd.Context()
@@ -16,7 +16,9 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/shared"
@@ -25,6 +27,7 @@ import (
 	"github.com/toughtackle/slack-cli/test/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Setup a mock for the List package
@@ -61,3 +64,70 @@ func TestListCommand(t *testing.T) {
 
 	listPkgMock.AssertCalled(t, "List")
 }
+
+func Test_formatTokenExpiry(t *testing.T) {
+	now := time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)
+	timeFormat := "2006-01-02 15:04:05 Z07:00"
+	expiresAt := int(now.Add(90 * time.Minute).Unix())
+
+	tests := map[string]struct {
+		auth     types.SlackAuth
+		expected string
+	}{
+		"shows the time until a refreshable token expires": {
+			auth:     types.SlackAuth{ExpiresAt: expiresAt, RefreshToken: "xoxe-1-refresh"},
+			expected: "2025-03-12 12:30:00 Z (in 1h30m0s)",
+		},
+		"notes tokens that cannot be refreshed": {
+			auth:     types.SlackAuth{ExpiresAt: expiresAt},
+			expected: "2025-03-12 12:30:00 Z (in 1h30m0s, not refreshable)",
+		},
+		"shows the time since a token expired": {
+			auth:     types.SlackAuth{ExpiresAt: int(now.Add(-2 * time.Hour).Unix()), RefreshToken: "xoxe-1-refresh"},
+			expected: "2025-03-12 09:00:00 Z (expired 2h0m0s ago)",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatTokenExpiry(tt.auth, now, timeFormat))
+		})
+	}
+}
+
+func Test_newAuthListOutput(t *testing.T) {
+	lastUpdated := time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)
+	apiHost := "https://dev.slack.com"
+	output := newAuthListOutput([]types.SlackAuth{
+		{
+			Token:        "xoxp-secret",
+			RefreshToken: "xoxe-1-refresh",
+			TeamDomain:   "sandbox",
+			TeamID:       "T001",
+			UserID:       "U001",
+			Profile:      "service",
+			APIHost:      &apiHost,
+			LastUpdated:  lastUpdated,
+			ExpiresAt:    int(lastUpdated.Add(12 * time.Hour).Unix()),
+		},
+	})
+	expiresAt := lastUpdated.Add(12 * time.Hour)
+	assert.Equal(t, authListOutput{
+		Authorizations: []authOutput{
+			{
+				TeamDomain:  "sandbox",
+				TeamID:      "T001",
+				UserID:      "U001",
+				Profile:     "service",
+				APIHost:     apiHost,
+				AuthLevel:   types.AuthLevelWorkspace,
+				LastUpdated: lastUpdated,
+				ExpiresAt:   &expiresAt,
+				Refreshable: true,
+			},
+		},
+	}, output)
+	encoded, err := json.Marshal(output)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "xoxp-secret")
+	assert.NotContains(t, string(encoded), "xoxe-1-refresh")
+}
@@ -49,6 +49,7 @@ func NewLoginCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "auth login --no-prompt", Meaning: "Login to a Slack account without prompts, this returns a ticket"},
 			{Command: "auth login --challenge 6d0a31c9 --ticket ISQWLiZT0OtMLO3YWNTJO0...", Meaning: "Complete login using ticket and challenge code"},
 			{Command: "auth login --token xoxp-...", Meaning: "Login with a user token"},
+			{Command: "auth login --profile service", Meaning: "Save another login for a team as a named profile"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			_, err := RunLoginCommand(clients, cmd)
@@ -109,7 +110,7 @@ func RunLoginCommand(clients *shared.ClientFactory, cmd *cobra.Command) (types.S
 			return types.SlackAuth{}, err
 		}
 		if selectedAuth.Token != "" {
-			printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth.Token)
+			printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth)
 			printAuthNextSteps(ctx, clients)
 		}
 		return selectedAuth, err
@@ -119,27 +120,31 @@ func RunLoginCommand(clients *shared.ClientFactory, cmd *cobra.Command) (types.S
 	if err != nil {
 		return types.SlackAuth{}, err
 	} else {
-		printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth.Token)
+		printAuthSuccess(cmd, clients.IO, credentialsPath, selectedAuth)
 		printAuthNextSteps(ctx, clients)
 	}
 
 	return selectedAuth, nil
 }
 
-func printAuthSuccess(cmd *cobra.Command, IO iostreams.IOStreamer, credentialsPath string, token string) {
+func printAuthSuccess(cmd *cobra.Command, IO iostreams.IOStreamer, credentialsPath string, auth types.SlackAuth) {
 	ctx := cmd.Context()
 
 	var secondaryLog string
 	if credentialsPath != "" {
 		secondaryLog = fmt.Sprintf("Authorization data was saved to %s", style.HomePath(credentialsPath))
 	} else if serviceTokenFlag && tokenFlag == "" {
-		secondaryLog = fmt.Sprintf("Service token:\n\n  %s\n\nMake sure to copy the token now and save it safely.", token)
+		secondaryLog = fmt.Sprintf("Service token:\n\n  %s\n\nMake sure to copy the token now and save it safely.", auth.Token)
+	}
+	secondary := []string{secondaryLog}
+	if credentialsPath != "" && auth.Profile != "" {
+		secondary = append(secondary, fmt.Sprintf("Select this login with %s", style.Highlight("--profile "+auth.Profile)))
 	}
 
 	IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
 		Emoji:     "key",
 		Text:      "You've successfully authenticated!",
-		Secondary: []string{secondaryLog},
+		Secondary: secondary,
 	}))
 	IO.PrintTrace(ctx, slacktrace.AuthLoginSuccess)
 }
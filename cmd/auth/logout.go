@@ -40,6 +40,7 @@ func NewLogoutCommand(clients *shared.ClientFactory) *cobra.Command {
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "auth logout", Meaning: "Select a team to log out of"},
 			{Command: "auth logout --all", Meaning: "Log out of all team"},
+			{Command: "auth logout --profile service", Meaning: "Log out of the login saved with a profile"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -135,6 +136,15 @@ func promptUserLogout(ctx context.Context, clients *shared.ClientFactory, cmd *c
 			WithMessage("The argument is missing from the --team flag")
 	}
 
+	// Revoke the auth of a profile without prompting
+	if clients.Config.ProfileFlag != "" && !allFlag {
+		auth, err := clients.AuthInterface().AuthWithProfile(ctx, clients.Config.ProfileFlag)
+		if err != nil {
+			return []types.SlackAuth{}, err
+		}
+		return []types.SlackAuth{auth}, nil
+	}
+
 	// Gather all available auths
 	auths, err := clients.AuthInterface().Auths(ctx)
 	if err != nil {
@@ -147,23 +157,26 @@ func promptUserLogout(ctx context.Context, clients *shared.ClientFactory, cmd *c
 
 	// Create a list of teams for the prompt options
 	authTeamDomains := make([]string, len(auths))
-	authTeamIDs := make([]string, len(auths))
+	authKeys := make([]string, len(auths))
 	authTeamDomainLabels := make([]string, len(auths))
 
-	// Build labels
+	// Build labels with keys that tell apart the profiles saved for a team
 	for ii, auth := range auths {
 		authTeamDomains[ii] = auth.TeamDomain
 		authTeamDomainLabels[ii] = FormatAuthLabel(auth)
-		authTeamIDs[ii] = auth.TeamID
+		authKeys[ii] = auth.TeamID
+		if auth.Profile != "" {
+			authKeys[ii] = auth.Profile
+		}
 	}
 	// Sort options
-	err = prompts.SortAlphaNumeric(authTeamDomainLabels, authTeamDomains, authTeamIDs)
+	err = prompts.SortAlphaNumeric(authTeamDomainLabels, authTeamDomains, authKeys)
 	if err != nil {
 		return []types.SlackAuth{}, err
 	}
 
 	// Collect the team to logout of
-	var selectedTeamID string
+	var selectedKey string
 	var selectedTeamDomain string
 	selection, err := clients.IO.SelectPrompt(ctx, "Select an authorization to revoke", authTeamDomainLabels, iostreams.SelectPromptConfig{
 		Flag:     clients.Config.Flags.Lookup("team"),
@@ -177,13 +190,19 @@ func promptUserLogout(ctx context.Context, clients *shared.ClientFactory, cmd *c
 			selectedTeamDomain = selection.Option
 		} else {
 			selectedTeamDomain = authTeamDomains[selection.Index]
-			selectedTeamID = authTeamIDs[selection.Index]
+			selectedKey = authKeys[selection.Index]
 		}
 	}
 
 	// Find the matching authentication
 	for _, auth := range auths {
-		if auth.TeamID == selectedTeamID || auth.TeamID == clients.Config.TeamFlag || auth.TeamDomain == selectedTeamDomain {
+		if selectedKey != "" {
+			if auth.Profile == selectedKey || (auth.Profile == "" && auth.TeamID == selectedKey) {
+				return []types.SlackAuth{auth}, nil
+			}
+			continue
+		}
+		if auth.TeamID == clients.Config.TeamFlag || auth.TeamDomain == selectedTeamDomain {
 			return []types.SlackAuth{auth}, nil
 		}
 	}
@@ -221,5 +240,8 @@ func printLogoutSuccess(ctx context.Context, clients *shared.ClientFactory, auth
 
 // FormatAuthLabel returns a formatted auth label for user selection during logout
 func FormatAuthLabel(auth types.SlackAuth) string {
+	if auth.Profile != "" {
+		return fmt.Sprintf("%s %s %s", auth.TeamDomain, style.Faint(auth.TeamID), style.Faint(fmt.Sprintf("(%s, profile %s)", auth.AuthLevel(), auth.Profile)))
+	}
 	return fmt.Sprintf("%s %s %s", auth.TeamDomain, style.Faint(auth.TeamID), style.Faint(fmt.Sprintf("(%s)", auth.AuthLevel())))
 }
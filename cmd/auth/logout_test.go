@@ -158,6 +158,50 @@ func TestLogoutCommand(t *testing.T) {
 				clients.IO.AssertCalled(t, "SelectPrompt", mock.Anything, "Select an authorization to revoke", mock.Anything, mock.Anything)
 			},
 		},
+		"logout of the login saved with a profile": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				profileAuth := fakeAuthsByTeamSlice[0]
+				profileAuth.Profile = "service"
+				clients.Config.ProfileFlag = "service"
+				clientsMock.AuthInterface.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("api.slack.com")
+				clientsMock.AuthInterface.On("ResolveLogstashHost", mock.Anything, mock.Anything, mock.Anything).Return("logstash.slack.com")
+				clientsMock.AuthInterface.On("AuthWithProfile", mock.Anything, "service").Return(profileAuth, nil)
+				clientsMock.AuthInterface.On("RevokeToken", mock.Anything, mock.Anything).Return(nil)
+				clientsMock.AuthInterface.On("DeleteAuth", mock.Anything, profileAuth).Return(types.SlackAuth{}, nil)
+			},
+			ExpectedOutputs: []string{"Authorization successfully revoked for team1"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.AuthInterface.AssertCalled(t, "DeleteAuth", mock.Anything, mock.MatchedBy(func(auth types.SlackAuth) bool {
+					return auth.Profile == "service"
+				}))
+				clients.AuthInterface.AssertNotCalled(t, "Auths", mock.Anything)
+				clients.IO.AssertNotCalled(t, "SelectPrompt", mock.Anything, "Select an authorization to revoke", mock.Anything, mock.Anything)
+			},
+		},
+		"logout of the selected profile of a team by prompt": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				profileAuth := fakeAuthsByTeamSlice[0]
+				profileAuth.Profile = "service"
+				profileAuth.Token = "xoxp-service"
+				clientsMock.AuthInterface.On("ResolveAPIHost", mock.Anything, mock.Anything, mock.Anything).Return("api.slack.com")
+				clientsMock.AuthInterface.On("ResolveLogstashHost", mock.Anything, mock.Anything, mock.Anything).Return("logstash.slack.com")
+				clientsMock.AuthInterface.On("Auths", mock.Anything).Return([]types.SlackAuth{fakeAuthsByTeamSlice[0], profileAuth}, nil)
+				clientsMock.IO.On("SelectPrompt", mock.Anything, "Select an authorization to revoke", mock.Anything, mock.Anything).Return(iostreams.SelectPromptResponse{
+					Prompt: true,
+					Option: FormatAuthLabel(profileAuth),
+					Index:  1,
+				}, nil)
+				clientsMock.AuthInterface.On("RevokeToken", mock.Anything, mock.Anything).Return(nil)
+				clientsMock.AuthInterface.On("DeleteAuth", mock.Anything, profileAuth).Return(types.SlackAuth{}, nil)
+			},
+			ExpectedOutputs: []string{"Authorization successfully revoked for team1"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, clients *shared.ClientsMock) {
+				clients.AuthInterface.AssertCalled(t, "RevokeToken", mock.Anything, "xoxp-service")
+				clients.AuthInterface.AssertNotCalled(t, "RevokeToken", mock.Anything, fakeAuthsByTeamSlice[0].Token)
+			},
+		},
 		"automatically logout of the only available workspace available": {
 			CmdArgs: []string{},
 			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
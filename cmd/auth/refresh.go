@@ -0,0 +1,199 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/auth"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// refreshWatchRetry is the wait before retrying a failed rotation
+	refreshWatchRetry = time.Minute
+	// refreshWatchMinWait keeps short-lived tokens from refreshing in a loop
+	refreshWatchMinWait = 30 * time.Second
+	// refreshWatchMaxWait rereads saved auths to find new logins
+	refreshWatchMaxWait = time.Hour
+)
+
+type refreshCmdFlags struct {
+	watch bool
+	ahead time.Duration
+}
+
+var refreshFlags refreshCmdFlags
+
+// refreshTimer waits between refreshes and is replaced in tests
+var refreshTimer = func(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewRefreshCommand creates the Cobra command for refreshing saved tokens
+func NewRefreshCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh [flags]",
+		Short: "Refresh expiring authentication tokens",
+		Long: fmt.Sprintf("%s\n\n%s",
+			"Rotate the tokens of all authorized accounts that can be refreshed.",
+			"Keep tokens from expiring on long-lived machines with the --watch flag, which refreshes each token before it expires until interrupted.",
+		),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "auth refresh", Meaning: "Refresh all tokens that can be rotated"},
+			{Command: "auth refresh --watch", Meaning: "Keep refreshing tokens before these expire"},
+			{Command: "auth refresh --watch --ahead 30m", Meaning: "Refresh tokens 30 minutes before these expire"},
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRefreshCommand(cmd.Context(), clients)
+		},
+	}
+
+	cmd.Flags().BoolVar(&refreshFlags.watch, "watch", false, "keep running and refresh tokens before these expire")
+	cmd.Flags().DurationVar(&refreshFlags.ahead, "ahead", 10*time.Minute, "refresh watched tokens this long before expiry or at half the lifetime of shorter tokens")
+
+	return cmd
+}
+
+// runRefreshCommand refreshes tokens once or until the command is interrupted
+func runRefreshCommand(ctx context.Context, clients *shared.ClientFactory) error {
+	if refreshFlags.ahead <= 0 {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --ahead flag must be a positive duration").
+			WithRemediation("Use a duration such as \"10m\" or \"1h\"")
+	}
+	if !refreshFlags.watch {
+		refreshes, err := clients.AuthInterface().RefreshTokens(ctx, 0)
+		if err != nil && len(refreshes) == 0 {
+			return err
+		}
+		// Tokens refreshed before an error are saved and still listed
+		printTokenRefreshes(ctx, clients, refreshes, true)
+		if err != nil {
+			return err
+		}
+		return refreshFailures(refreshes)
+	}
+
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "hourglass_flowing_sand",
+		Text:  "Watching for tokens that expire",
+		Secondary: []string{
+			fmt.Sprintf("Tokens are refreshed %s before these expire", refreshFlags.ahead),
+			"Press CTRL+C to stop",
+		},
+	}))
+	for {
+		refreshes, err := clients.AuthInterface().RefreshTokens(ctx, refreshFlags.ahead)
+		if err != nil {
+			return err
+		}
+		printTokenRefreshes(ctx, clients, refreshes, false)
+		wait := nextRefreshWait(refreshes, refreshFlags.ahead, time.Now())
+		clients.IO.PrintDebug(ctx, "checking tokens again in %s", wait)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-refreshTimer(wait):
+		}
+	}
+}
+
+// nextRefreshWait returns the time until the next token should be refreshed.
+// Tokens that live for less than ahead are refreshed at half of the lifetime.
+func nextRefreshWait(refreshes []auth.TokenRefresh, ahead time.Duration, now time.Time) time.Duration {
+	wait := refreshWatchMaxWait
+	for _, refresh := range refreshes {
+		next := refresh.Auth.TokenExpiry().Add(-refresh.Auth.RefreshAhead(ahead)).Sub(now)
+		if refresh.Err != nil {
+			next = refreshWatchRetry
+		}
+		wait = min(wait, next)
+	}
+	return max(wait, refreshWatchMinWait)
+}
+
+// printTokenRefreshes outputs the refreshed tokens and failures for each team.
+// Tokens that were not refreshed are listed if all is true and failures are
+// left for the returned error.
+func printTokenRefreshes(ctx context.Context, clients *shared.ClientFactory, refreshes []auth.TokenRefresh, all bool) {
+	timeFormat := "2006-01-02 15:04:05 Z07:00"
+	for _, refresh := range refreshes {
+		team := refresh.Auth.TeamDomain
+		if refresh.Auth.Profile != "" {
+			team = fmt.Sprintf("%s (%s)", team, refresh.Auth.Profile)
+		}
+		switch {
+		case refresh.Err != nil:
+			// Failures are returned as an error unless watching
+			if !all {
+				clients.IO.PrintWarning(ctx, "%s", refresh.Err.Error())
+			}
+		case refresh.Refreshed:
+			clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+				Emoji:     "arrows_counterclockwise",
+				Text:      fmt.Sprintf("Refreshed the token for %s", style.Highlight(team)),
+				Secondary: []string{fmt.Sprintf("Expires %s", formatTokenExpiry(refresh.Auth, time.Now(), timeFormat))},
+			}))
+		case all:
+			clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+				Emoji:     "white_check_mark",
+				Text:      fmt.Sprintf("The token for %s is current", style.Highlight(team)),
+				Secondary: []string{fmt.Sprintf("Expires %s", formatTokenExpiry(refresh.Auth, time.Now(), timeFormat))},
+			}))
+		}
+	}
+	if all && len(refreshes) == 0 {
+		clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+			Emoji: "information_source",
+			Text:  "No saved tokens can be refreshed",
+			Secondary: []string{
+				fmt.Sprintf("Tokens that expire are saved with %s", style.Commandf("login", false)),
+			},
+		}))
+	}
+}
+
+// refreshFailures returns an error with details of each failed rotation
+func refreshFailures(refreshes []auth.TokenRefresh) error {
+	details := slackerror.ErrorDetails{}
+	for _, refresh := range refreshes {
+		if refresh.Err == nil {
+			continue
+		}
+		rotationErr := slackerror.ToSlackError(refresh.Err)
+		detail := slackerror.ErrorDetail{
+			Message:     rotationErr.Message,
+			Remediation: rotationErr.Remediation,
+		}
+		if len(rotationErr.Details) > 0 {
+			detail.Message = fmt.Sprintf("%s: %s", detail.Message, rotationErr.Details[0].Message)
+			detail.Code = rotationErr.Details[0].Code
+		}
+		details = append(details, detail)
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return slackerror.New(slackerror.ErrTokenRotation).
+		WithMessage("Failed to refresh %d of %d tokens", len(details), len(refreshes)).
+		WithDetails(details)
+}
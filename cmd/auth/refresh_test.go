@@ -0,0 +1,147 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/auth"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRefreshCommand(t *testing.T) {
+	expiresAt := int(time.Now().Add(12 * time.Hour).Unix())
+	refreshed := types.SlackAuth{TeamDomain: "team1", TeamID: "T1", RefreshToken: "refresh", ExpiresAt: expiresAt}
+	failed := types.SlackAuth{TeamDomain: "team2", TeamID: "T2", RefreshToken: "refresh", ExpiresAt: expiresAt}
+	rotationErr := slackerror.New(slackerror.ErrTokenRotation).
+		WithMessage("Failed to refresh the token for team2").
+		WithDetails(slackerror.ErrorDetails{
+			slackerror.ErrorDetail{Message: "The refresh token is invalid", Code: "invalid_refresh_token"},
+		})
+
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"refreshes every token once": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AuthInterface.On("RefreshTokens", mock.Anything, time.Duration(0)).Return([]auth.TokenRefresh{
+					{Auth: refreshed, Refreshed: true},
+				}, nil)
+			},
+			ExpectedOutputs: []string{"Refreshed the token for", "team1"},
+		},
+		"errors with the teams that failed to refresh": {
+			CmdArgs: []string{},
+			Setup: func(t *testing.T, ctx context.Context, clientsMock *shared.ClientsMock, clients *shared.ClientFactory) {
+				clientsMock.AuthInterface.On("RefreshTokens", mock.Anything, time.Duration(0)).Return([]auth.TokenRefresh{
+					{Auth: refreshed, Refreshed: true},
+					{Auth: failed, Err: rotationErr},
+				}, nil)
+			},
+			ExpectedOutputs:      []string{"Refreshed the token for"},
+			ExpectedErrorStrings: []string{"Failed to refresh 1 of 2 tokens", "Failed to refresh the token for team2: The refresh token is invalid", "invalid_refresh_token"},
+		},
+		"errors if the ahead duration is not positive": {
+			CmdArgs:              []string{"--watch", "--ahead", "0s"},
+			ExpectedErrorStrings: []string{"The --ahead flag must be a positive duration"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewRefreshCommand(clients)
+	})
+}
+
+func TestRefreshCommand_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(slackcontext.MockContext(t.Context()))
+	defer cancel()
+	clientsMock := shared.NewClientsMock()
+	expiresAt := int(time.Now().Add(12 * time.Hour).Unix())
+	clientsMock.AuthInterface.On("RefreshTokens", mock.Anything, 30*time.Minute).Return([]auth.TokenRefresh{
+		{Auth: types.SlackAuth{TeamDomain: "team1", RefreshToken: "refresh", ExpiresAt: expiresAt}, Refreshed: true},
+		{Auth: types.SlackAuth{TeamDomain: "team2", RefreshToken: "refresh", ExpiresAt: expiresAt}, Err: slackerror.New(slackerror.ErrTokenRotation)},
+	}, nil)
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+	waits := []time.Duration{}
+	refreshTimer = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		if len(waits) == 2 {
+			cancel()
+		}
+		timer := make(chan time.Time)
+		if len(waits) < 2 {
+			close(timer)
+		}
+		return timer
+	}
+	defer func() {
+		refreshTimer = func(d time.Duration) <-chan time.Time { return time.After(d) }
+	}()
+	refreshFlags = refreshCmdFlags{watch: true, ahead: 30 * time.Minute}
+	defer func() { refreshFlags = refreshCmdFlags{} }()
+
+	err := runRefreshCommand(ctx, clients)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{refreshWatchRetry, refreshWatchRetry}, waits)
+	clientsMock.AuthInterface.AssertNumberOfCalls(t, "RefreshTokens", 2)
+	clientsMock.IO.AssertCalled(t, "PrintWarning", mock.Anything, "%s", mock.Anything)
+}
+
+func Test_nextRefreshWait(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	ahead := 10 * time.Minute
+	expiring := func(d time.Duration) auth.TokenRefresh {
+		return auth.TokenRefresh{Auth: types.SlackAuth{RefreshToken: "refresh", ExpiresAt: int(now.Add(d).Unix())}}
+	}
+
+	tests := map[string]struct {
+		refreshes []auth.TokenRefresh
+		expected  time.Duration
+	}{
+		"waits the longest without tokens": {
+			refreshes: []auth.TokenRefresh{},
+			expected:  refreshWatchMaxWait,
+		},
+		"waits until the first token is ahead of expiry": {
+			refreshes: []auth.TokenRefresh{expiring(50 * time.Minute), expiring(40 * time.Minute)},
+			expected:  30 * time.Minute,
+		},
+		"retries failed rotations": {
+			refreshes: []auth.TokenRefresh{expiring(50 * time.Minute), {Auth: types.SlackAuth{}, Err: slackerror.New(slackerror.ErrTokenRotation)}},
+			expected:  refreshWatchRetry,
+		},
+		"waits half the lifetime of tokens that live shorter than ahead": {
+			refreshes: []auth.TokenRefresh{{Auth: types.SlackAuth{RefreshToken: "refresh", ExpiresAt: int(now.Add(4 * time.Minute).Unix()), LastUpdated: now}, Refreshed: true}},
+			expected:  2 * time.Minute,
+		},
+		"waits a minimum for short-lived tokens": {
+			refreshes: []auth.TokenRefresh{expiring(5 * time.Minute)},
+			expected:  refreshWatchMinWait,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, nextRefreshWait(tt.refreshes, ahead, now))
+		})
+	}
+}
@@ -39,6 +39,7 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 		Long:    "List all collaborators of an app",
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "collaborator list", Meaning: "List all of the collaborators"},
+			{Command: "collaborator list --output json", Meaning: "List all of the collaborators as JSON"},
 		}),
 		Args: cobra.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -71,10 +72,45 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 		return slackerror.Wrap(err, "Error listing collaborators")
 	}
 	sortCollaboratorsList(collaborators)
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, newCollaboratorsListOutput(app.AppID, collaborators))
+	}
 	printCollaboratorsListSuccess(ctx, clients, app.AppID, collaborators)
 	return nil
 }
 
+// collaboratorsListOutput is the structured output of the collaborators list
+// command
+type collaboratorsListOutput struct {
+	AppID         string               `json:"app_id"`
+	Collaborators []collaboratorOutput `json:"collaborators"`
+}
+
+// collaboratorOutput describes a collaborator of an app
+type collaboratorOutput struct {
+	UserID         string `json:"user_id"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	PermissionType string `json:"permission_type"`
+}
+
+// newCollaboratorsListOutput returns the structured output of collaborators
+func newCollaboratorsListOutput(appID string, collaborators []types.SlackUser) collaboratorsListOutput {
+	output := collaboratorsListOutput{
+		AppID:         appID,
+		Collaborators: make([]collaboratorOutput, 0, len(collaborators)),
+	}
+	for _, collaborator := range collaborators {
+		output.Collaborators = append(output.Collaborators, collaboratorOutput{
+			UserID:         collaborator.ID,
+			Username:       collaborator.UserName,
+			Email:          collaborator.Email,
+			PermissionType: string(collaborator.PermissionType),
+		})
+	}
+	return output
+}
+
 // sortCollaboratorsList orders collaborators by permission then username
 func sortCollaboratorsList(collaborators []types.SlackUser) {
 	slices.SortFunc(collaborators, func(a types.SlackUser, b types.SlackUser) int {
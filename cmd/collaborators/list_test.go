@@ -15,6 +15,7 @@
 package collaborators
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -28,6 +29,36 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestListCommand_StructuredOutput(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	appSelectMock := prompts.NewAppSelectMock()
+	teamAppSelectPromptFunc = appSelectMock.TeamAppSelectPrompt
+	appSelectMock.On("TeamAppSelectPrompt").Return(prompts.SelectedApp{App: types.App{AppID: "A003"}, Auth: types.SlackAuth{}}, nil)
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clientsMock.Config.OutputFlag = "json"
+	clientsMock.APIInterface.On("ListCollaborators", mock.Anything, mock.Anything, mock.Anything).
+		Return([]types.SlackUser{
+			{ID: "U00READER", UserName: "bookworm", Email: "reader@slack.com", PermissionType: types.READER},
+			{ID: "USLACKBOT", UserName: "slackbot", Email: "bots@slack.com", PermissionType: types.OWNER},
+		}, nil)
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+		clients.SDKConfig = hooks.NewSDKConfigMock()
+	})
+
+	err := NewListCommand(clients).ExecuteContext(ctx)
+	require.NoError(t, err)
+	var output collaboratorsListOutput
+	require.NoError(t, json.Unmarshal([]byte(clientsMock.GetStdoutOutput()), &output))
+	require.Equal(t, collaboratorsListOutput{
+		AppID: "A003",
+		Collaborators: []collaboratorOutput{
+			{UserID: "USLACKBOT", Username: "slackbot", Email: "bots@slack.com", PermissionType: string(types.OWNER)},
+			{UserID: "U00READER", Username: "bookworm", Email: "reader@slack.com", PermissionType: string(types.READER)},
+		},
+	}, output)
+}
+
 func TestListCommand(t *testing.T) {
 	tests := map[string]struct {
 		app             types.App
@@ -23,7 +23,6 @@ import (
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -73,7 +72,7 @@ func NewBulkDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the app auth selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -100,6 +99,7 @@ func NewBulkDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 
 	return cmd
 }
@@ -112,6 +112,9 @@ func preRunBulkDeleteCommandFunc(ctx context.Context, clients *shared.ClientFact
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
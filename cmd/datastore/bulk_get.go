@@ -24,7 +24,6 @@ import (
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -75,7 +74,7 @@ func NewBulkGetCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the app auth selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -100,9 +99,9 @@ func NewBulkGetCommand(clients *shared.ClientFactory) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
-	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 
 	return cmd
 }
@@ -115,6 +114,9 @@ func preRunBulkGetCommandFunc(ctx context.Context, clients *shared.ClientFactory
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -146,19 +148,21 @@ func printBulkGetResult(clients *shared.ClientFactory, cmd *cobra.Command, reque
 	var datastore = getResult.Datastore
 	var items = getResult.Items
 
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(cmd.Context(), getResult)
+	}
+
 	missingIDsMessage := ""
 	if len(request.IDs) != len(items)+len(getResult.FailedItems) {
 		missingIDsMessage = " Not all IDs were found"
 	}
 
-	if outputFlag == "text" {
-		cmd.Printf(
-			style.Bold("%s Get from Datastore: %s.%s\n\n"),
-			style.Emoji("tada"),
-			datastore,
-			missingIDsMessage,
-		)
-	}
+	cmd.Printf(
+		style.Bold("%s Get from Datastore: %s.%s\n\n"),
+		style.Emoji("tada"),
+		datastore,
+		missingIDsMessage,
+	)
 
 	b, err := json.MarshalIndent(items, "", "  ")
 	if err != nil {
@@ -15,20 +15,19 @@
 package datastore
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"path/filepath"
-	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
 	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/datastore/format"
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -39,11 +38,22 @@ import (
 
 const (
 	maxImportBulkSize = 25
-	maxImportItems    = 5000
 )
 
 var readFromFileFlag string
-var readFromFileUsage = "store multiple items from a file of JSON Lines"
+var readFromFileUsage = "store multiple items from a file of JSON Lines, CSV, or columnar data"
+
+var importResumeFlag bool
+var importResumeUsage = "continue an import from the last saved checkpoint"
+
+var importWorkersFlag int
+var importWorkersUsage = "number of requests sent at once while importing"
+
+// importRequestInterval is the least time between requests of an import
+var importRequestInterval = 50 * time.Millisecond
+
+// importRetryDelay is the first delay before items are sent again
+var importRetryDelay = time.Second
 
 var BulkPut = datastore.BulkPut
 var importProgressSpinner *style.Spinner
@@ -67,6 +77,14 @@ func NewBulkPutCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Create or replace two new entries in the datastore with an expression",
 				Command: `datastore bulk-put '{"datastore": "tasks", "items": [{"id": "12", "description": "Create a PR", "status": "Done"}, {"id": "42", "description": "Approve a PR", "status": "Pending"}]}'`,
 			},
+			{
+				Meaning: "Import items to the datastore from a spreadsheet",
+				Command: `datastore bulk-put --datastore tasks '{}' --from-file tasks.csv`,
+			},
+			{
+				Meaning: "Continue an import that stopped",
+				Command: `datastore bulk-put --datastore tasks '{}' --from-file tasks.jsonl --resume`,
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -88,7 +106,7 @@ func NewBulkPutCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -102,6 +120,10 @@ func NewBulkPutCommand(clients *shared.ClientFactory) *cobra.Command {
 				return printDatastoreExpressionMarshal(ctx, clients, query)
 			}
 
+			if importResumeFlag && readFromFileFlag == "" {
+				return slackerror.New(slackerror.ErrMismatchedFlags).
+					WithMessage("The --resume flag requires the --from-file flag")
+			}
 			if readFromFileFlag != "" {
 				if len(query.Items) != 0 {
 					return slackerror.New(slackerror.ErrMismatchedFlags).
@@ -113,7 +135,15 @@ func NewBulkPutCommand(clients *shared.ClientFactory) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				return startBulkPutImport(ctx, clients, cmd, query, primaryKey)
+				fileFormat, err := format.Detect(fileFormatFlag, readFromFileFlag)
+				if err != nil {
+					return err
+				}
+				columns, err := getFileColumns(ctx, clients, selection.App, selection.Auth, query.Datastore, fileFormat)
+				if err != nil {
+					return err
+				}
+				return startBulkPutImport(ctx, clients, cmd, query, primaryKey, fileFormat, columns)
 			}
 
 			// Perform the put
@@ -129,7 +159,11 @@ func NewBulkPutCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 	cmd.Flags().StringVar(&readFromFileFlag, "from-file", "", readFromFileUsage)
+	cmd.Flags().StringVar(&fileFormatFlag, "format", "", fileFormatUsage)
+	cmd.Flags().BoolVar(&importResumeFlag, "resume", false, importResumeUsage)
+	cmd.Flags().IntVar(&importWorkersFlag, "workers", 4, importWorkersUsage)
 
 	return cmd
 }
@@ -142,6 +176,9 @@ func preRunBulkPutCommandFunc(ctx context.Context, clients *shared.ClientFactory
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -206,18 +243,49 @@ func printDatastoreBulkPutSuccess(cmd *cobra.Command, event *logger.LogEvent) {
 	}
 }
 
-func startBulkPutImport(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, query types.AppDatastoreBulkPut, primaryKey string) error {
-	currentBatch := []map[string]interface{}{}
-	totalSuccessfulItems := 0
-	totalFailedItems := 0
-	totalPutItems := 0
+// startBulkPutImport stores items read from a file in the format. Attributes
+// from columns of CSV and columnar files are converted to the types of the
+// datastore schema. Items are read until the end of the file and progress is
+// saved to a checkpoint so an import that stops can continue with the --resume
+// flag.
+func startBulkPutImport(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	query types.AppDatastoreBulkPut,
+	primaryKey string,
+	fileFormat format.Format,
+	columns []format.Column,
+) error {
+	if importWorkersFlag < 1 {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --workers flag must be at least 1")
+	}
 
 	itemsFile, err := clients.Fs.Open(readFromFileFlag)
 	if err != nil {
 		return err
 	}
 	defer itemsFile.Close()
-	scanner := bufio.NewScanner(itemsFile)
+	itemsFileInfo, err := itemsFile.Stat()
+	if err != nil {
+		return err
+	}
+	itemsFilePath, err := filepath.Abs(itemsFile.Name())
+	if err != nil {
+		return err
+	}
+
+	checkpointPath := datastore.ImportCheckpointPath(clients, query.Datastore, itemsFilePath)
+	checkpoint, err := getBulkPutImportCheckpoint(clients, checkpointPath, query, itemsFilePath, itemsFileInfo.Size(), fileFormat)
+	if err != nil {
+		return err
+	}
+
+	itemsReader, err := format.NewReader(fileFormat, itemsFile, columns)
+	if err != nil {
+		return err
+	}
 
 	logFolder, err := clients.Config.SystemConfig.LogsDir(ctx)
 	if err != nil {
@@ -232,112 +300,65 @@ func startBulkPutImport(ctx context.Context, clients *shared.ClientFactory, cmd
 	}
 	defer errorLogFile.Close()
 
-	itemsFilePath, err := filepath.Abs(itemsFile.Name())
-	if err != nil {
-		return err
+	secondary := []string{
+		fmt.Sprintf("Items will be read from %s", style.HomePath(itemsFilePath)),
+	}
+	if importResumeFlag {
+		secondary = append(secondary, fmt.Sprintf("Continuing after the first %d items of the file", checkpoint.Offset))
 	}
-
-	token := config.GetContextToken(ctx)
-
 	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
-		Emoji: "file_cabinet",
-		Text:  "Importing datastore items from a file",
-		Secondary: []string{
-			fmt.Sprintf("Items will be read from %s", style.HomePath(itemsFilePath)),
-		},
+		Emoji:     "file_cabinet",
+		Text:      "Importing datastore items from a file",
+		Secondary: secondary,
 	}))
 
 	importProgressSpinner = style.NewSpinner(cmd.OutOrStdout())
 	defer importProgressSpinner.Stop()
-
-	for {
-		totalPutItems = totalSuccessfulItems + totalFailedItems
-		update := fmt.Sprintf("Imported (%d) items. So far (%d) items failed to be imported. Total processed items is (%d).", totalSuccessfulItems, totalFailedItems, totalPutItems)
-
+	var spinnerMutex sync.Mutex
+	updateProgress := func(progress datastore.ImportProgress) {
+		spinnerMutex.Lock()
+		defer spinnerMutex.Unlock()
+		update := fmt.Sprintf("Imported (%d) items. So far (%d) items failed to be imported. Total processed items is (%d).", progress.Imported, progress.Failed, progress.Processed())
 		importProgressSpinner.Update(update, "").Start()
-
-		maxItemsToRead := min(maxImportBulkSize, maxImportItems-totalPutItems)
-		if len(currentBatch) < maxItemsToRead && scanner.Scan() {
-			nextItem := scanner.Text()
-			if nextItem == "" {
-				continue
-			}
-			var parsedNextItem map[string]interface{}
-			err = goutils.JSONUnmarshal([]byte(nextItem), &parsedNextItem)
-			if err != nil {
-				err = logBulkPutImportError(errorLogFile, nextItem, "item couldn't be parsed as JSON")
-				if err != nil {
-					return err
-				}
-				totalFailedItems++
-				continue
-			}
-			if _, exists := parsedNextItem[primaryKey]; !exists {
-				err = logBulkPutImportError(errorLogFile, nextItem, "primary key not found")
-				if err != nil {
-					return err
-				}
-				totalFailedItems++
-				continue
-			}
-
-			idx := slices.IndexFunc(currentBatch, func(item map[string]interface{}) bool { return item[primaryKey] == parsedNextItem[primaryKey] })
-			if idx != -1 {
-				err = logBulkPutImportError(errorLogFile, nextItem, "item with the same primary key already exists")
-				if err != nil {
-					return err
-				}
-				totalFailedItems++
-				continue
-			}
-
-			currentBatch = append(currentBatch, parsedNextItem)
-			continue
-		}
-
-		if len(currentBatch) == 0 {
-			update = fmt.Sprintf("Successfully imported (%d) items! (%d) items failed to be imported. Total processed items is (%d)", totalSuccessfulItems, totalFailedItems, totalPutItems)
-			importProgressSpinner.Update(update, "tada").Stop()
-			break
-		}
-
-		query.Items = currentBatch
-		bulkPutResult, err := clients.APIInterface().AppsDatastoreBulkPut(ctx, token, query)
-		if err != nil {
-			if len(err.(*slackerror.Error).Details) == 0 {
-				return err
-			}
-			for _, errorDetail := range err.(*slackerror.Error).Details {
-				idx := slices.IndexFunc(currentBatch, func(item map[string]interface{}) bool { return item[primaryKey] == errorDetail.Item[primaryKey] })
-				if idx == -1 {
-					return err //defensive coding, this shouldn't happen
-				}
-				currentBatch = slices.Delete(currentBatch, idx, idx+1)
-
-				stringItem, err := goutils.JSONMarshalUnescaped(errorDetail.Item)
-				if err != nil {
-					return err
-				}
-				err = logBulkPutImportError(errorLogFile, stringItem, errorDetail.Message)
-				if err != nil {
-					return err
-				}
-				totalFailedItems++
-			}
-			continue
+	}
+	updateProgress(datastore.ImportProgress{Imported: checkpoint.Imported, Failed: checkpoint.Failed})
+
+	job := datastore.Import{
+		Request:         query,
+		PrimaryKey:      primaryKey,
+		Reader:          itemsReader,
+		Checkpoint:      checkpoint,
+		CheckpointPath:  checkpointPath,
+		BatchSize:       maxImportBulkSize,
+		Workers:         importWorkersFlag,
+		RequestInterval: importRequestInterval,
+		RetryDelay:      importRetryDelay,
+		OnFailure: func(text string, reason string) error {
+			return logBulkPutImportError(errorLogFile, text, reason)
+		},
+		OnProgress: updateProgress,
+	}
+	result, err := job.Run(ctx, clients)
+	if err != nil {
+		importProgressSpinner.Stop()
+		slackErr := slackerror.ToSlackError(err)
+		if slackErr.Remediation == "" {
+			slackErr = slackErr.WithRemediation("Continue the import after the first %d items of the file with %s", result.Checkpoint.Offset, bulkPutResumeCommand(query))
 		}
-		totalSuccessfulItems += len(currentBatch) - len(bulkPutResult.FailedItems)
-		currentBatch = bulkPutResult.FailedItems
+		return slackErr
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
-	}
+	update := fmt.Sprintf("Successfully imported (%d) items! (%d) items failed to be imported. Total processed items is (%d)", result.Progress.Imported, result.Progress.Failed, result.Progress.Processed())
+	importProgressSpinner.Update(update, "tada").Stop()
 
-	if totalPutItems >= maxImportItems {
-		clients.IO.PrintInfo(ctx, false, "%sImport will be limited to the first %d items in the file.", style.Emoji("warning"), maxImportItems)
+	if !result.Finished {
+		clients.IO.PrintInfo(ctx, false, "%sImport stopped after the first %d items in the file. Continue the import with %s", style.Emoji("warning"), result.Checkpoint.Offset, bulkPutResumeCommand(query))
+	} else if len(result.Checkpoint.FailedItems) > 0 {
+		clients.IO.PrintInfo(ctx, false, "%s%d items were not stored after retries and are saved to %s. Retry these items with %s", style.Emoji("warning"), len(result.Checkpoint.FailedItems), style.HomePath(checkpointPath), bulkPutResumeCommand(query))
+	} else if err := datastore.RemoveImportCheckpoint(clients.Fs, checkpointPath); err != nil {
+		return err
 	}
-	if totalFailedItems != 0 {
+	if result.Progress.Failed != 0 {
 		clients.IO.PrintInfo(ctx, false, "%sSome items failed to be imported. Check %s for more details.", style.Emoji("warning"), errorLogFilePath)
 	} else {
 		if err = clients.Fs.Remove(errorLogFilePath); err != nil {
@@ -349,6 +370,67 @@ func startBulkPutImport(ctx context.Context, clients *shared.ClientFactory, cmd
 	return nil
 }
 
+// bulkPutResumeCommand returns the command that continues an import of the
+// file from the saved checkpoint
+func bulkPutResumeCommand(query types.AppDatastoreBulkPut) string {
+	args := []string{"datastore bulk-put", "--datastore " + shellQuote(query.Datastore)}
+	if emulatorFlag {
+		args = append(args, "--emulator")
+	} else if query.App != "" {
+		args = append(args, "--app "+query.App)
+	}
+	args = append(args, "'{}'", "--from-file "+shellQuote(readFromFileFlag))
+	if fileFormatFlag != "" {
+		args = append(args, "--format "+fileFormatFlag)
+	}
+	args = append(args, "--resume")
+	return style.Commandf(strings.Join(args, " "), false)
+}
+
+// shellQuote wraps a value in single quotes if it contains characters that a
+// shell would change
+func shellQuote(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t'\"\\$`!*?&|;<>()[]{}#~") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// getBulkPutImportCheckpoint loads the saved checkpoint of an import to resume
+// or starts a new checkpoint for the file
+func getBulkPutImportCheckpoint(
+	clients *shared.ClientFactory,
+	checkpointPath string,
+	query types.AppDatastoreBulkPut,
+	itemsFilePath string,
+	itemsFileSize int64,
+	fileFormat format.Format,
+) (datastore.ImportCheckpoint, error) {
+	checkpoint := datastore.ImportCheckpoint{
+		Datastore: query.Datastore,
+		App:       query.App,
+		File:      itemsFilePath,
+		FileSize:  itemsFileSize,
+		Format:    string(fileFormat),
+	}
+	if !importResumeFlag {
+		return checkpoint, nil
+	}
+	saved, err := datastore.LoadImportCheckpoint(clients.Fs, checkpointPath)
+	if err != nil {
+		return datastore.ImportCheckpoint{}, err
+	}
+	if saved == nil {
+		return datastore.ImportCheckpoint{}, slackerror.New(slackerror.ErrDatastoreImportCheckpoint).
+			WithMessage("No import of %s to the \"%s\" datastore was started", style.HomePath(itemsFilePath), query.Datastore)
+	}
+	if saved.App != checkpoint.App || saved.FileSize != checkpoint.FileSize || saved.Format != checkpoint.Format {
+		return datastore.ImportCheckpoint{}, slackerror.New(slackerror.ErrDatastoreImportCheckpoint).
+			WithMessage("The file or app changed since the import was started")
+	}
+	return *saved, nil
+}
+
 // logBulkPutImportError saves failed item to file along with reason. Failed items are saved as strings rather than json
 // because the item might not be a valid json after all
 func logBulkPutImportError(file afero.File, item string, reason string) error {
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
@@ -300,12 +302,11 @@ func TestBulkPutCommandImport(t *testing.T) {
 				assert.Equal(t, len(errorLogs), 5)
 			},
 		},
-		"import the first 5000 items only": {
+		"import every item of a large file": {
 			CmdArgs: []string{
 				`{"datastore":"Todos"}`,
 				`--from-file=my-file`,
 			},
-			ExpectedOutputs: []string{"Import will be limited to the first 5000 items in the file"},
 			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
 				*cm = *setupDatastoreMocks()
 				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
@@ -321,7 +322,54 @@ func TestBulkPutCommandImport(t *testing.T) {
 			},
 			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
 				status, _ := importProgressSpinner.Status()
-				assert.Contains(t, status, "Successfully imported (5000) items! (0) items failed to be imported. Total processed items is (5000)")
+				assert.Contains(t, status, "Successfully imported (5050) items! (0) items failed to be imported. Total processed items is (5050)")
+				_, err := cm.Fs.Stat(mockImportCheckpointPath(t, cm, "my-file"))
+				assert.True(t, os.IsNotExist(err), "the checkpoint is removed after the import finishes")
+			},
+		},
+		"import items from a csv file with columns from the manifest": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--from-file=my-file.csv`,
+			},
+			ExpectedOutputs: []string{"Some items failed to be imported"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+
+				err := afero.WriteFile(cm.Fs, "my-file.csv", []byte("task_id,task,status\n0001,counting,\n0002,counting,done,extra\n"), 0644)
+				assert.NoError(t, err)
+
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				status, _ := importProgressSpinner.Status()
+				assert.Contains(t, status, "Successfully imported (1) items! (1) items failed to be imported. Total processed items is (2)")
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, types.AppDatastoreBulkPut{
+					Datastore: "Todos",
+					App:       "A0123456",
+					Items: []map[string]interface{}{
+						{"task_id": "0001", "task": "counting", "status": ""},
+					},
+				})
+			},
+		},
+		"errors if a csv column is not an attribute of the datastore": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--from-file=my-file.csv`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				err := afero.WriteFile(cm.Fs, "my-file.csv", []byte("task_id,priority\n0001,high\n"), 0644)
+				assert.NoError(t, err)
+
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{"The column \"priority\" is not an attribute of the datastore"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
 			},
 		},
 		"import retries on failures": {
@@ -368,13 +416,109 @@ func TestBulkPutCommandImport(t *testing.T) {
 				})
 			},
 		},
+		"resume an import from the saved checkpoint": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--from-file=my-file`,
+				`--resume`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+
+				itemsFile, err := cm.Fs.Create("my-file")
+				assert.NoError(t, err)
+				items, err := prepareImportMockData(itemsFile, 28, 0)
+				assert.NoError(t, err)
+				info, err := itemsFile.Stat()
+				assert.NoError(t, err)
+
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+				itemsFilePath, err := filepath.Abs("my-file")
+				assert.NoError(t, err)
+				err = datastore.SaveImportCheckpoint(cm.Fs, mockImportCheckpointPath(t, cm, "my-file"), datastore.ImportCheckpoint{
+					Datastore:   "Todos",
+					App:         "A0123456",
+					File:        itemsFilePath,
+					FileSize:    info.Size(),
+					Format:      "jsonl",
+					Offset:      25,
+					Imported:    24,
+					FailedItems: items[:1],
+				})
+				assert.NoError(t, err)
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				status, _ := importProgressSpinner.Status()
+				assert.Contains(t, status, "Successfully imported (28) items! (0) items failed to be imported. Total processed items is (28)")
+				cm.APIInterface.AssertNumberOfCalls(t, "AppsDatastoreBulkPut", 2)
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, types.AppDatastoreBulkPut{
+					Datastore: "Todos",
+					App:       "A0123456",
+					Items: []map[string]interface{}{
+						{"task_id": "0001", "task": "counting", "status": "ongoing"},
+					},
+				})
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, types.AppDatastoreBulkPut{
+					Datastore: "Todos",
+					App:       "A0123456",
+					Items: []map[string]interface{}{
+						{"task_id": "0026", "task": "counting", "status": "ongoing"},
+						{"task_id": "0027", "task": "counting", "status": "ongoing"},
+						{"task_id": "0028", "task": "counting", "status": "ongoing"},
+					},
+				})
+				exists, err := afero.Exists(cm.Fs, mockImportCheckpointPath(t, cm, "my-file"))
+				assert.NoError(t, err)
+				assert.False(t, exists)
+			},
+		},
+		"errors when resuming an import that was not started": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--from-file=my-file`,
+				`--resume`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				err := afero.WriteFile(cm.Fs, "my-file", []byte(`{"task_id":"0001"}`), 0644)
+				assert.NoError(t, err)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{"No import of", "datastore_import_checkpoint_error"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors when resuming without a file": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--resume`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{"The --resume flag requires the --from-file flag"},
+		},
 	}, func(cf *shared.ClientFactory) *cobra.Command {
+		importRequestInterval = 0
+		importRetryDelay = 0
 		cmd := NewBulkPutCommand(cf)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
 		return cmd
 	})
 }
 
+// mockImportCheckpointPath returns the checkpoint path of importing a file to
+// the "Todos" datastore
+func mockImportCheckpointPath(t *testing.T, cm *shared.ClientsMock, file string) string {
+	itemsFilePath, err := filepath.Abs(file)
+	assert.NoError(t, err)
+	return datastore.ImportCheckpointPath(shared.NewClientFactory(cm.MockClientFactory()), "Todos", itemsFilePath)
+}
+
 func prepareImportMockData(file afero.File, numberOfValidRows int, numberOfInvalidRows int) ([]map[string]interface{}, error) {
 	data := []map[string]interface{}{}
 	for i := 1; i <= numberOfValidRows+numberOfInvalidRows; i++ {
@@ -399,3 +543,40 @@ func prepareImportMockData(file afero.File, numberOfValidRows int, numberOfInval
 	}
 	return data, nil
 }
+
+func Test_bulkPutResumeCommand(t *testing.T) {
+	tests := map[string]struct {
+		query    types.AppDatastoreBulkPut
+		fromFile string
+		format   string
+		emulator bool
+		expected string
+	}{
+		"includes the app, datastore, and file": {
+			query:    types.AppDatastoreBulkPut{App: mockAppID, Datastore: "Todos"},
+			fromFile: "tasks.jsonl",
+			expected: fmt.Sprintf("datastore bulk-put --datastore Todos --app %s '{}' --from-file tasks.jsonl --resume", mockAppID),
+		},
+		"quotes paths with spaces and keeps the format": {
+			query:    types.AppDatastoreBulkPut{App: mockAppID, Datastore: "Todos"},
+			fromFile: "my tasks.txt",
+			format:   "csv",
+			expected: fmt.Sprintf("datastore bulk-put --datastore Todos --app %s '{}' --from-file 'my tasks.txt' --format csv --resume", mockAppID),
+		},
+		"uses the emulator instead of an app": {
+			query:    types.AppDatastoreBulkPut{Datastore: "Todos"},
+			fromFile: "tasks.jsonl",
+			emulator: true,
+			expected: "datastore bulk-put --datastore Todos --emulator '{}' --from-file tasks.jsonl --resume",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			readFromFileFlag, fileFormatFlag, emulatorFlag = tt.fromFile, tt.format, tt.emulator
+			defer func() {
+				readFromFileFlag, fileFormatFlag, emulatorFlag = "", "", false
+			}()
+			assert.Contains(t, bulkPutResumeCommand(tt.query), tt.expected)
+		})
+	}
+}
@@ -21,8 +21,9 @@ import (
 	"strings"
 
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/datastore/expression"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
-	"github.com/toughtackle/slack-cli/internal/prompts"
+	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -65,6 +66,7 @@ func NewCountCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 	cmd.Flags().StringVar(&attributeFlag, "attributes", "", attributeUsage)
 
 	cmd.Flag("attributes").Hidden = true // Hide while unstable is present
@@ -80,6 +82,9 @@ func preRunCountCommandFunc(ctx context.Context, clients *shared.ClientFactory,
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -113,7 +118,7 @@ func runCountCommandFunc(
 	}
 
 	// Get the app from the flag or prompt
-	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+	selection, err := datastoreAppSelectPrompt(ctx, clients)
 	if err != nil {
 		return err
 	}
@@ -129,6 +134,15 @@ func runCountCommandFunc(
 	// Set the app ID from the selected workspace
 	count.App = selection.App.AppID
 
+	err = validateDatastoreExpression(ctx, clients, selection.App, selection.Auth, count.Datastore, expression.Query{
+		Expression: count.Expression,
+		Attributes: count.ExpressionAttributes,
+		Values:     count.ExpressionValues,
+	})
+	if err != nil {
+		return err
+	}
+
 	// Optionally display the JSON expression and exit
 	if showExpressionFlag {
 		return printDatastoreExpressionMarshal(ctx, clients, count)
@@ -174,7 +188,7 @@ func promptDatastoreCountRequest(
 	var count types.AppDatastoreCount
 
 	// Collect datastore information from the manifest
-	yaml, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	yaml, err := getDatastoreManifest(ctx, clients, app, auth)
 	if err != nil {
 		return types.AppDatastoreCount{}, err
 	}
@@ -173,6 +173,9 @@ func TestCountCommand(t *testing.T) {
 				`{"datastore":"tasks","expression":"#task_id < :num","expression_attributes":{"#task_id":"task_id"},"expression_values":{":num":"3"}}`,
 			},
 			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{}, nil)
+				cm.AppClient.Manifest = manifestMock
 				cm.APIInterface.On("AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything).
 					Return(types.AppDatastoreCountResult{Datastore: "tasks", Count: 12}, nil)
 			},
@@ -204,6 +207,9 @@ func TestCountCommand(t *testing.T) {
 				`{"datastore":"Todos","app":"A001","expression":"#task_id < :num AND #status = :progress","expression_attributes":{"#task_id":"task_id","#status":"status"},"expression_values":{":num":"3",":progress":"wip"}}`,
 			},
 			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{}, nil)
+				cm.AppClient.Manifest = manifestMock
 				cm.APIInterface.On("AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything).
 					Return(types.AppDatastoreCountResult{Datastore: "tasks", Count: 12}, nil)
 			},
@@ -232,6 +238,34 @@ func TestCountCommand(t *testing.T) {
 				)
 			},
 		},
+		"errors for an invalid expression before counting": {
+			CmdArgs: []string{
+				`{"datastore":"tasks","expression":"#task_id < :num","expression_attributes":{"#task_id":"task_id"},"expression_values":{":num":"three"}}`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+					AppManifest: types.AppManifest{
+						Datastores: map[string]types.ManifestDatastore{
+							"tasks": {
+								PrimaryKey: "task_id",
+								Attributes: map[string]types.ManifestAttribute{
+									"task_id": {Type: "number"},
+								},
+							},
+						},
+					},
+				}, nil)
+				cm.AppClient.Manifest = manifestMock
+			},
+			ExpectedErrorStrings: []string{
+				slackerror.ErrInvalidDatastoreExpression,
+				"Cannot compare #task_id of type number with type string at position 12",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreCount", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 		"pass an empty expression through prompts": {
 			CmdArgs: []string{"--unstable"},
 			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
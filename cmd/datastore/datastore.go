@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/toughtackle/slack-cli/internal/datastore/expression"
+	"github.com/toughtackle/slack-cli/internal/datastore/format"
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
@@ -28,14 +30,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// maxBulkAttempts is the number of times items that fail are sent with bulk
+// requests of datastore commands
+const maxBulkAttempts = 3
+
 // appSelectPromptFunc is a handle to the AppSelectPrompt that can be mocked in tests
 var appSelectPromptFunc = prompts.AppSelectPrompt
 
 var datastoreFlag string
 var datastoreUsage = "the datastore used to store items"
 
-var outputFlag string
-var outputUsage = "output format: text, json"
 
 var showExpressionFlag bool
 var showExpressionUsage = "only construct a JSON expression"
@@ -43,6 +47,12 @@ var showExpressionUsage = "only construct a JSON expression"
 var unstableFlag bool
 var unstableUsage = "kick the tires of experimental features"
 
+var emulatorFlag bool
+var emulatorUsage = "use a local datastore emulator instead of the Slack API"
+
+var fileFormatFlag string
+var fileFormatUsage = "format of items in a file: jsonl, csv, columnar"
+
 func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "datastore <subcommand> <expression> [flags]",
@@ -53,6 +63,10 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 			"This command is supported for apps deployed to Slack managed infrastructure but",
 			"other apps can attempt to run the command with the --force flag.",
 			"",
+			"Items can be stored in a local emulator of the datastores in the app manifest",
+			"with the --emulator flag. Emulated items are saved in the .slack/datastores",
+			"directory and commands using the emulator do not require a workspace.",
+			"",
 			`Discover the datastores: {{LinkText "https://tools.slack.dev/deno-slack-sdk/guides/using-datastores"}}`,
 		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
@@ -92,6 +106,18 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Count number of items in datastore",
 				Command: `datastore count --datastore tasks`,
 			},
+			{
+				Meaning: "Rename an attribute of stored items after changing the manifest",
+				Command: `datastore migrate --datastore tasks --rename status=state`,
+			},
+			{
+				Meaning: "Copy items of the deployed app to the local app",
+				Command: `datastore sync --datastore tasks --source-app deployed --target-app local`,
+			},
+			{
+				Meaning: "Query items saved to the local datastore emulator",
+				Command: `datastore query --datastore tasks '{"limit": 8}' --emulator`,
+			},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
@@ -106,6 +132,9 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.AddCommand(NewBulkDeleteCommand(clients))
 	cmd.AddCommand(NewQueryCommand(clients))
 	cmd.AddCommand(NewCountCommand(clients))
+	cmd.AddCommand(NewMigrateCommand(clients))
+	cmd.AddCommand(NewDiffCommand(clients))
+	cmd.AddCommand(NewSyncCommand(clients))
 
 	return cmd
 }
@@ -181,17 +210,230 @@ func getPrimaryKey(
 	string,
 	error,
 ) {
-	yaml, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	datastore, err := getDatastoreDefinition(ctx, clients, app, auth, datastoreName)
 	if err != nil {
 		return "", err
 	}
+	if datastore.PrimaryKey == "" {
+		return "", slackerror.New(slackerror.ErrDatastoreMissingPrimaryKey)
+	}
+	return datastore.PrimaryKey, nil
+}
 
+// getDatastoreDefinition returns the attributes of a datastore from the manifest
+// of an installed app
+func getDatastoreDefinition(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	app types.App,
+	auth types.SlackAuth,
+	datastoreName string,
+) (
+	types.ManifestDatastore,
+	error,
+) {
+	yaml, err := getDatastoreManifest(ctx, clients, app, auth)
+	if err != nil {
+		return types.ManifestDatastore{}, err
+	}
 	datastore, exists := yaml.Datastores[datastoreName]
 	if !exists {
-		return "", slackerror.New(slackerror.ErrDatastoreNotFound)
+		return types.ManifestDatastore{}, slackerror.New(slackerror.ErrDatastoreNotFound)
 	}
-	if datastore.PrimaryKey == "" {
-		return "", slackerror.New(slackerror.ErrDatastoreMissingPrimaryKey)
+	return datastore, nil
+}
+
+// getFileColumns returns the columns of a file format from the datastore schema.
+// JSON Lines files do not use columns.
+func getFileColumns(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	app types.App,
+	auth types.SlackAuth,
+	datastoreName string,
+	fileFormat format.Format,
+) (
+	[]format.Column,
+	error,
+) {
+	if fileFormat == format.JSONL {
+		return nil, nil
 	}
-	return datastore.PrimaryKey, nil
+	datastore, err := getDatastoreDefinition(ctx, clients, app, auth, datastoreName)
+	if err != nil {
+		return nil, err
+	}
+	return format.Columns(datastore)
+}
+
+// errDatastorePromptRequired returns the error for changes that are confirmed
+// with a prompt when the prompt cannot be shown and the flag is not set
+func errDatastorePromptRequired(action string, flag string) error {
+	return slackerror.New(slackerror.ErrPrompt).
+		WithMessage("Confirmation is required to %s", action).
+		WithDetails(slackerror.ErrorDetails{
+			slackerror.ErrorDetail{Message: "The input device is not a TTY or does not support interactivity"},
+		}).
+		WithRemediation("Try running the command with the `%s` flag included", flag)
+}
+
+// datastoreAppSelectPrompt returns the app to use for datastore commands from
+// the flag or prompt. Apps are not selected when using the emulator.
+func datastoreAppSelectPrompt(ctx context.Context, clients *shared.ClientFactory) (prompts.SelectedApp, error) {
+	if emulatorFlag {
+		var selection prompts.SelectedApp
+		if types.IsAppID(clients.Config.AppFlag) {
+			selection.App.AppID = clients.Config.AppFlag
+		}
+		return selection, nil
+	}
+	return appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+}
+
+// getDatastoreManifest returns the manifest with datastores for an app. The
+// local manifest is used with the emulator.
+func getDatastoreManifest(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	app types.App,
+	auth types.SlackAuth,
+) (
+	types.SlackYaml,
+	error,
+) {
+	if emulatorFlag {
+		return clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	}
+	return clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+}
+
+// validateDatastoreExpression parses the expression of a query and checks the
+// attributes and values it references before a request is made. Attribute types
+// are also checked when the manifest of the app can be found.
+func validateDatastoreExpression(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	app types.App,
+	auth types.SlackAuth,
+	datastoreName string,
+	query expression.Query,
+) error {
+	var datastore *types.ManifestDatastore
+	if strings.TrimSpace(query.Expression) != "" {
+		yaml, err := getDatastoreManifest(ctx, clients, app, auth)
+		if err != nil {
+			clients.IO.PrintDebug(ctx, "skipping attribute checks of the expression: %s", err)
+		} else if definition, ok := yaml.Datastores[datastoreName]; ok {
+			datastore = &definition
+		}
+	}
+	_, err := expression.Validate(query, datastore)
+	if err != nil {
+		expressionErr, ok := err.(*expression.Error)
+		if !ok {
+			return err
+		}
+		return slackerror.New(slackerror.ErrInvalidDatastoreExpression).
+			WithMessage("%s", expressionErr.Error()).
+			WithRemediation("%s", style.Secondary(expressionErr.Pointer()))
+	}
+	return nil
+}
+
+// queryDatastoreItems returns every item of a datastore by following the query
+// cursor. The number of items read is passed to progress after each page.
+func queryDatastoreItems(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	token string,
+	query types.AppDatastoreQuery,
+	progress func(count int),
+) (
+	[]map[string]interface{},
+	error,
+) {
+	items := []map[string]interface{}{}
+	query.Limit = maxExportQueryLimit
+	for {
+		result, err := clients.APIInterface().AppsDatastoreQuery(ctx, token, query)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Items...)
+		progress(len(items))
+		if result.NextCursor == "" {
+			return items, nil
+		}
+		query.Cursor = result.NextCursor
+	}
+}
+
+// bulkPutDatastoreItems stores items in batches and sends failed items again a
+// few times. Items that still fail are returned.
+func bulkPutDatastoreItems(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	token string,
+	request types.AppDatastoreBulkPut,
+	items []map[string]interface{},
+	progress func(count int),
+) (
+	[]map[string]interface{},
+	error,
+) {
+	stored := 0
+	failed := []map[string]interface{}{}
+	for start := 0; start < len(items); start += maxImportBulkSize {
+		batch := items[start:min(start+maxImportBulkSize, len(items))]
+		for attempt := 1; len(batch) > 0; attempt++ {
+			request.Items = batch
+			result, err := clients.APIInterface().AppsDatastoreBulkPut(ctx, token, request)
+			if err != nil {
+				return nil, err
+			}
+			stored += len(batch) - len(result.FailedItems)
+			batch = result.FailedItems
+			if attempt >= maxBulkAttempts {
+				failed = append(failed, batch...)
+				break
+			}
+		}
+		progress(stored)
+	}
+	return failed, nil
+}
+
+// bulkDeleteDatastoreItems removes items by primary key in batches and sends
+// failed keys again a few times. Keys that still fail are returned.
+func bulkDeleteDatastoreItems(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	token string,
+	request types.AppDatastoreBulkDelete,
+	ids []string,
+	progress func(count int),
+) (
+	[]string,
+	error,
+) {
+	deleted := 0
+	failed := []string{}
+	for start := 0; start < len(ids); start += maxImportBulkSize {
+		batch := ids[start:min(start+maxImportBulkSize, len(ids))]
+		for attempt := 1; len(batch) > 0; attempt++ {
+			request.IDs = batch
+			result, err := clients.APIInterface().AppsDatastoreBulkDelete(ctx, token, request)
+			if err != nil {
+				return nil, err
+			}
+			deleted += len(batch) - len(result.FailedItems)
+			batch = result.FailedItems
+			if attempt >= maxBulkAttempts {
+				failed = append(failed, batch...)
+				break
+			}
+		}
+		progress(deleted)
+	}
+	return failed, nil
 }
@@ -18,9 +18,11 @@ import (
 	"testing"
 
 	"github.com/toughtackle/slack-cli/internal/app"
+	"github.com/toughtackle/slack-cli/internal/datastore/expression"
 	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
 	"github.com/toughtackle/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
@@ -156,3 +158,107 @@ func TestSetQueryExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestDatastoreAppSelectPrompt(t *testing.T) {
+	tests := map[string]struct {
+		emulator      bool
+		appFlag       string
+		expectedAppID string
+	}{
+		"selects the app from the prompt": {
+			expectedAppID: mockAppID,
+		},
+		"skips the prompt with the emulator": {
+			emulator:      true,
+			expectedAppID: "",
+		},
+		"uses the app flag with the emulator": {
+			emulator:      true,
+			appFlag:       "A0EMULATED",
+			expectedAppID: "A0EMULATED",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := setupDatastoreMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			clients.Config.AppFlag = tt.appFlag
+			emulatorFlag = tt.emulator
+			defer func() { emulatorFlag = false }()
+
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAppID, selection.App.AppID)
+		})
+	}
+}
+
+func TestGetDatastoreManifest(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := setupDatastoreMocks()
+	manifestMock := clientsMock.AppClient.Manifest.(*app.ManifestMockObject)
+	manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+		AppManifest: types.AppManifest{
+			DisplayInformation: types.DisplayInformation{Name: "Emulated"},
+		},
+	}, nil)
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+	manifest, err := getDatastoreManifest(ctx, clients, types.App{AppID: mockAppID}, types.SlackAuth{})
+	require.NoError(t, err)
+	assert.Equal(t, "Datastorage", manifest.DisplayInformation.Name)
+
+	emulatorFlag = true
+	defer func() { emulatorFlag = false }()
+	manifest, err = getDatastoreManifest(ctx, clients, types.App{AppID: mockAppID}, types.SlackAuth{})
+	require.NoError(t, err)
+	assert.Equal(t, "Emulated", manifest.DisplayInformation.Name)
+}
+
+func TestValidateDatastoreExpression(t *testing.T) {
+	tests := map[string]struct {
+		query                expression.Query
+		expectedErrorMessage string
+	}{
+		"passes for an expression that matches the manifest": {
+			query: expression.Query{
+				Expression: "#status = :status",
+				Attributes: map[string]interface{}{"#status": "status"},
+				Values:     map[string]interface{}{":status": "wip"},
+			},
+		},
+		"errors for a syntax error": {
+			query: expression.Query{
+				Expression: "#status == :status",
+				Attributes: map[string]interface{}{"#status": "status"},
+				Values:     map[string]interface{}{":status": "wip"},
+			},
+			expectedErrorMessage: `Expected an attribute or value but found "=" at position 10`,
+		},
+		"errors for an attribute missing from the manifest": {
+			query: expression.Query{
+				Expression: "#owner = :owner",
+				Attributes: map[string]interface{}{"#owner": "owner"},
+				Values:     map[string]interface{}{":owner": "U01"},
+			},
+			expectedErrorMessage: `The attribute "owner" is not defined for the datastore at position 1`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := setupDatastoreMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			err := validateDatastoreExpression(ctx, clients, types.App{AppID: mockAppID}, types.SlackAuth{}, "Todos", tt.query)
+			if tt.expectedErrorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, slackerror.ErrInvalidDatastoreExpression, slackerror.ToSlackError(err).Code)
+			assert.Equal(t, tt.expectedErrorMessage, slackerror.ToSlackError(err).Message)
+		})
+	}
+}
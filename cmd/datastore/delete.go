@@ -25,7 +25,6 @@ import (
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -75,7 +74,7 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the app auth selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -110,6 +109,7 @@ func NewDeleteCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 
 	return cmd
 }
@@ -122,6 +122,9 @@ func preRunDeleteCommandFunc(ctx context.Context, clients *shared.ClientFactory,
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -185,7 +188,7 @@ func promptDatastoreDeleteRequest(
 	var query types.AppDatastoreDelete
 
 	// Collect datastore information from the manifest
-	yaml, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	yaml, err := getDatastoreManifest(ctx, clients, app, auth)
 	if err != nil {
 		return types.AppDatastoreDelete{}, err
 	}
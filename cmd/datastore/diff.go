@@ -0,0 +1,287 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/datastore/diff"
+	"github.com/toughtackle/slack-cli/internal/prompts"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+// maxDiffKeys is the number of primary keys listed for each kind of change
+const maxDiffKeys = 10
+
+var sourceAppFlag string
+var sourceAppUsage = "app ID or environment to copy items from"
+
+var sourceTeamFlag string
+var sourceTeamUsage = "team ID or domain of the source app"
+
+var targetAppFlag string
+var targetAppUsage = "app ID or environment to compare items to"
+
+var targetTeamFlag string
+var targetTeamUsage = "team ID or domain of the target app"
+
+var diffProgressSpinner *style.Spinner
+
+// datastoreDiffApps are the apps with the datastore being compared
+type datastoreDiffApps struct {
+	source prompts.SelectedApp
+	target prompts.SelectedApp
+}
+
+func NewDiffCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [flags]",
+		Short: "Compare the items of a datastore between two apps",
+		Long: strings.Join([]string{
+			"Compare the items of a datastore in a source app with the items of the same",
+			"datastore in a target app.",
+			"",
+			"Items are matched by primary key and reported as added to, removed from, or",
+			"changed in the target to match the source. Apps can be installed to different",
+			"teams and are selected with flags or prompts.",
+			"",
+			"This command is supported for apps deployed to Slack managed infrastructure but",
+			"other apps can attempt to run the command with the --force flag.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Compare items of the deployed app with the local app",
+				Command: "datastore diff --datastore tasks --source-app deployed --target-app local",
+			},
+			{
+				Meaning: "Compare items of apps installed to different teams",
+				Command: "datastore diff --datastore tasks --source-app A0123456 --source-team T0123456 --target-app A0654321 --target-team T0654321 --output json",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			return preRunDiffCommandFunc(ctx, clients, cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			apps, err := selectDatastoreDiffApps(ctx, clients)
+			if err != nil {
+				return err
+			}
+			result, err := compareDatastoreApps(ctx, clients, cmd, apps, datastoreFlag)
+			if err != nil {
+				return err
+			}
+			return printDatastoreDiff(ctx, clients, cmd, apps, result, clients.IO.IsStructuredOutput())
+		},
+	}
+	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
+	addDatastoreDiffFlags(cmd)
+
+	return cmd
+}
+
+// addDatastoreDiffFlags adds the flags to select the source and target apps
+func addDatastoreDiffFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&sourceAppFlag, "source-app", "", sourceAppUsage)
+	cmd.Flags().StringVar(&sourceTeamFlag, "source-team", "", sourceTeamUsage)
+	cmd.Flags().StringVar(&targetAppFlag, "target-app", "", targetAppUsage)
+	cmd.Flags().StringVar(&targetTeamFlag, "target-team", "", targetTeamUsage)
+}
+
+// preRunDiffCommandFunc determines if the command is supported for a project
+// and configures flags
+func preRunDiffCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
+	clients.Config.SetFlags(cmd)
+	err := cmdutil.IsValidProjectDirectory(clients)
+	if err != nil {
+		return err
+	}
+	if clients.Config.ForceFlag {
+		return nil
+	}
+	return cmdutil.IsSlackHostedProject(ctx, clients)
+}
+
+// selectDatastoreDiffApps gathers the source and target apps from the flags of
+// each or prompts
+func selectDatastoreDiffApps(ctx context.Context, clients *shared.ClientFactory) (datastoreDiffApps, error) {
+	appFlag, teamFlag := clients.Config.AppFlag, clients.Config.TeamFlag
+	defer func() {
+		clients.Config.AppFlag, clients.Config.TeamFlag = appFlag, teamFlag
+	}()
+	selectApp := func(side string, app string, team string) (prompts.SelectedApp, error) {
+		if app == "" {
+			clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+				Emoji: "card_index_dividers",
+				Text:  fmt.Sprintf("Select the %s app of the datastore", side),
+			}))
+		}
+		clients.Config.AppFlag, clients.Config.TeamFlag = app, team
+		return appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+	}
+
+	var apps datastoreDiffApps
+	var err error
+	if apps.source, err = selectApp("source", sourceAppFlag, sourceTeamFlag); err != nil {
+		return datastoreDiffApps{}, err
+	}
+	if apps.target, err = selectApp("target", targetAppFlag, targetTeamFlag); err != nil {
+		return datastoreDiffApps{}, err
+	}
+	if apps.source.App.AppID == apps.target.App.AppID && apps.source.Auth.TeamID == apps.target.Auth.TeamID {
+		return datastoreDiffApps{}, slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The source and target are the same app").
+			WithRemediation("Select a different app with the %s or %s flags", style.Highlight("--source-app"), style.Highlight("--target-app"))
+	}
+	return apps, nil
+}
+
+// compareDatastoreApps reads every item of the datastore from both apps and
+// matches items with the primary key of the datastore
+func compareDatastoreApps(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	apps datastoreDiffApps,
+	datastoreName string,
+) (
+	diff.Result,
+	error,
+) {
+	if datastoreName == "" {
+		return diff.Result{}, slackerror.New(slackerror.ErrDatastoreNotFound).
+			WithMessage("No datastore was specified").
+			WithRemediation("Provide a datastore with the %s flag", style.Highlight("--datastore"))
+	}
+	primaryKey, err := getPrimaryKey(ctx, clients, apps.source.App, apps.source.Auth, datastoreName)
+	if err != nil {
+		return diff.Result{}, err
+	}
+	targetPrimaryKey, err := getPrimaryKey(ctx, clients, apps.target.App, apps.target.Auth, datastoreName)
+	if err != nil {
+		return diff.Result{}, err
+	}
+	if primaryKey != targetPrimaryKey {
+		return diff.Result{}, slackerror.New(slackerror.ErrInvalidDatastore).
+			WithMessage("The primary key of the source datastore is %s but the target uses %s", primaryKey, targetPrimaryKey)
+	}
+
+	diffProgressSpinner = style.NewSpinner(cmd.OutOrStdout())
+	defer diffProgressSpinner.Stop()
+	readItems := func(side string, selection prompts.SelectedApp) ([]map[string]interface{}, error) {
+		query := types.AppDatastoreQuery{Datastore: datastoreName, App: selection.App.AppID}
+		return queryDatastoreItems(ctx, clients, selection.Auth.Token, query, func(count int) {
+			diffProgressSpinner.Update(fmt.Sprintf("Read (%d) items of the %s app.", count, side), "").Start()
+		})
+	}
+	sourceItems, err := readItems("source", apps.source)
+	if err != nil {
+		return diff.Result{}, err
+	}
+	targetItems, err := readItems("target", apps.target)
+	if err != nil {
+		return diff.Result{}, err
+	}
+	diffProgressSpinner.Update(fmt.Sprintf("Compared (%d) source items with (%d) target items", len(sourceItems), len(targetItems)), "mag").Stop()
+	return diff.Items(sourceItems, targetItems, primaryKey), nil
+}
+
+// printDatastoreDiff outputs the changes between datastores as text or in the
+// format of the --output flag
+func printDatastoreDiff(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	apps datastoreDiffApps,
+	result diff.Result,
+	structured bool,
+) error {
+	if structured {
+		return clients.IO.PrintStructured(ctx, result)
+	}
+	clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+		Emoji: "card_file_box",
+		Text:  fmt.Sprintf("Changes to the %s datastore of the target app", datastoreFlag),
+		Secondary: []string{
+			fmt.Sprintf("Source: %s", formatDiffApp(apps.source)),
+			fmt.Sprintf("Target: %s", formatDiffApp(apps.target)),
+		},
+	}))
+	if result.Empty() {
+		clients.IO.PrintInfo(ctx, false, "%sThe target has the same %d items as the source\n", style.Emoji("white_check_mark"), result.Unchanged)
+		return nil
+	}
+
+	added := []string{}
+	for _, item := range result.Added {
+		key, _ := diff.Key(item, result.PrimaryKey)
+		added = append(added, key)
+	}
+	removed := []string{}
+	for _, item := range result.Removed {
+		key, _ := diff.Key(item, result.PrimaryKey)
+		removed = append(removed, key)
+	}
+	changed := []string{}
+	for _, item := range result.Changed {
+		changed = append(changed, fmt.Sprintf("%s (%s)", item.Key, strings.Join(item.Attributes, ", ")))
+	}
+	clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+		Emoji:     "heavy_plus_sign",
+		Text:      fmt.Sprintf("%d items added", len(added)),
+		Secondary: truncateDiffKeys(added),
+	}))
+	clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+		Emoji:     "heavy_minus_sign",
+		Text:      fmt.Sprintf("%d items removed", len(removed)),
+		Secondary: truncateDiffKeys(removed),
+	}))
+	clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+		Emoji:     "pencil2",
+		Text:      fmt.Sprintf("%d items changed", len(changed)),
+		Secondary: truncateDiffKeys(changed),
+	}))
+	clients.IO.PrintInfo(ctx, false, "%d items are unchanged", result.Unchanged)
+	if result.Skipped > 0 {
+		clients.IO.PrintInfo(ctx, false, "%s%d items without a primary key were skipped", style.Emoji("warning"), result.Skipped)
+	}
+	return nil
+}
+
+// formatDiffApp describes an app and the team it is installed to
+func formatDiffApp(selection prompts.SelectedApp) string {
+	team := selection.Auth.TeamDomain
+	if team == "" {
+		team = selection.Auth.TeamID
+	}
+	return fmt.Sprintf("%s on %s", selection.App.AppID, team)
+}
+
+// truncateDiffKeys limits the number of keys listed for a change
+func truncateDiffKeys(keys []string) []string {
+	if len(keys) <= maxDiffKeys {
+		return keys
+	}
+	return append(keys[:maxDiffKeys:maxDiffKeys], fmt.Sprintf("and %d more items", len(keys)-maxDiffKeys))
+}
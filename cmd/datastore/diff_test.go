@@ -0,0 +1,124 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/prompts"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupDiffMocks selects a source app then a target app on another team with
+// the items stored in the "Todos" datastore of each
+func setupDiffMocks(cm *shared.ClientsMock, sourceItems []map[string]interface{}, targetItems []map[string]interface{}) {
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectPromptFunc = appSelectMock.AppSelectPrompt
+	appSelectMock.On("AppSelectPrompt").Return(prompts.SelectedApp{
+		App:  types.App{AppID: "A0000000001"},
+		Auth: types.SlackAuth{TeamID: "T0000000001", TeamDomain: "production", Token: "xoxb-source"},
+	}, nil).Once()
+	appSelectMock.On("AppSelectPrompt").Return(prompts.SelectedApp{
+		App:  types.App{AppID: "A0000000002"},
+		Auth: types.SlackAuth{TeamID: "T0000000002", TeamDomain: "sandbox", Token: "xoxb-target"},
+	}, nil).Once()
+	cm.APIInterface.On("AppsDatastoreQuery", mock.Anything, "xoxb-source", mock.Anything).
+		Return(types.AppDatastoreQueryResult{Items: sourceItems}, nil)
+	cm.APIInterface.On("AppsDatastoreQuery", mock.Anything, "xoxb-target", mock.Anything).
+		Return(types.AppDatastoreQueryResult{Items: targetItems}, nil)
+}
+
+func TestDiffCommand(t *testing.T) {
+	sourceItems := []map[string]interface{}{
+		{"task_id": "0001", "task": "counting", "status": "done"},
+		{"task_id": "0002", "task": "reading", "status": "open"},
+		{"task_id": "0003", "task": "writing", "status": "open"},
+	}
+	targetItems := []map[string]interface{}{
+		{"task_id": "0001", "task": "counting", "status": "open"},
+		{"task_id": "0002", "task": "reading", "status": "open"},
+		{"task_id": "0004", "task": "sleeping", "status": "open"},
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"reports items added removed and changed in the target": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{
+				"Source: A0000000001 on production",
+				"Target: A0000000002 on sandbox",
+				"1 items added",
+				"0003",
+				"1 items removed",
+				"0004",
+				"1 items changed",
+				"0001 (status)",
+				"1 items are unchanged",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreQuery", mock.Anything, "xoxb-target", types.AppDatastoreQuery{
+					Datastore: "Todos",
+					App:       "A0000000002",
+					Limit:     maxExportQueryLimit,
+				})
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"outputs the changes as json": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				cm.Config.OutputFlag = "json"
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{
+				`"primary_key": "task_id"`,
+				`"attributes": [`,
+				`"unchanged": 1`,
+			},
+		},
+		"reports when the target matches the source": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, sourceItems)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{"The target has the same 3 items as the source"},
+		},
+		"errors if the source and target are the same app": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags, "The source and target are the same app"},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewDiffCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -77,7 +76,7 @@ func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the app auth selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -105,14 +104,14 @@ func NewGetCommand(clients *shared.ClientFactory) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			printDatastoreGetSuccess(cmd, event)
+			printDatastoreGetSuccess(clients, cmd, event)
 			return nil
 		},
 	}
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
-	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 
 	return cmd
 }
@@ -125,6 +124,9 @@ func preRunGetCommandFunc(ctx context.Context, clients *shared.ClientFactory, cm
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -156,13 +158,14 @@ func printGetResult(clients *shared.ClientFactory, cmd *cobra.Command, getResult
 	var datastore = getResult.Datastore
 	var item = getResult.Item
 
-	if outputFlag == "text" {
-		cmd.Printf(
-			style.Bold("%s Get from Datastore: %s\n\n"),
-			style.Emoji("tada"),
-			datastore,
-		)
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(cmd.Context(), item)
 	}
+	cmd.Printf(
+		style.Bold("%s Get from Datastore: %s\n\n"),
+		style.Emoji("tada"),
+		datastore,
+	)
 
 	var b []byte
 	var err error
@@ -182,8 +185,8 @@ func printGetResult(clients *shared.ClientFactory, cmd *cobra.Command, getResult
 	return nil
 }
 
-func printDatastoreGetSuccess(cmd *cobra.Command, event *logger.LogEvent) {
-	if outputFlag == "text" {
+func printDatastoreGetSuccess(clients *shared.ClientFactory, cmd *cobra.Command, event *logger.LogEvent) {
+	if !clients.IO.IsStructuredOutput() {
 		commandText := style.Commandf("datastore get <expression>", true)
 		if cmd != nil {
 			cmd.Printf(
@@ -207,7 +210,7 @@ func promptDatastoreGetRequest(
 	var query types.AppDatastoreGet
 
 	// Collect datastore information from the manifest
-	yaml, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	yaml, err := getDatastoreManifest(ctx, clients, app, auth)
 	if err != nil {
 		return types.AppDatastoreGet{}, err
 	}
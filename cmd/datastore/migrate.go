@@ -0,0 +1,408 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/datastore/migrate"
+	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+const maxMigrateFailures = 5
+
+var migrateRenameFlag []string
+var migrateRenameUsage = "rename an attribute of items as <old>=<new>"
+
+var migrateDefaultFlag []string
+var migrateDefaultUsage = "set a value for items missing an attribute as <attribute>=<value>"
+
+var migrateDropFlag []string
+var migrateDropUsage = "remove an attribute that is no longer in the manifest from items"
+
+var migrateCoerceFlag []string
+var migrateCoerceUsage = "convert values of an attribute to the manifest type or <attribute>=<type>"
+
+var migrateDryRunFlag bool
+var migrateDryRunUsage = "report the changes to items without saving them"
+
+var migrateNoPromptFlag bool
+var migrateNoPromptUsage = "save migrated items without a confirmation prompt"
+
+var migrateProgressSpinner *style.Spinner
+
+// migrateFailure is an item that a transform could not be applied to
+type migrateFailure struct {
+	key    interface{}
+	reason string
+}
+
+// migratePlan is the result of applying transforms to the scanned items
+type migratePlan struct {
+	scanned  int
+	items    []map[string]interface{}
+	applied  map[string]int
+	failures []migrateFailure
+}
+
+func NewMigrateCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [flags]",
+		Short: "Change stored items to match the datastore in the app manifest",
+		Long: strings.Join([]string{
+			"Change the items stored in a datastore to match the attributes of the datastore",
+			"in the local app manifest.",
+			"",
+			"Attributes of the local manifest are compared to the deployed manifest and the",
+			"transforms provided with flags are applied to each stored item. Changes are",
+			"reported before items are saved and the --dry-run flag only shows this report.",
+			"Saving items is confirmed with a prompt or the --no-prompt flag.",
+			"",
+			"Items are saved to the deployed datastore so attributes that transforms write",
+			"must be deployed first. Preview a migration with the --dry-run flag before the",
+			"manifest changes are deployed, then deploy the app and run the command again",
+			"to save items. Transforms are applied the same way after a deploy.",
+			"",
+			"This command is supported for apps deployed to Slack managed infrastructure but",
+			"other apps can attempt to run the command with the --force flag.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Report changes between the local and deployed datastore",
+				Command: "datastore migrate --datastore tasks --dry-run",
+			},
+			{
+				Meaning: "Rename an attribute and set a default for a new attribute after a deploy",
+				Command: "datastore migrate --datastore tasks --rename status=state --default priority=3",
+			},
+			{
+				Meaning: "Remove an old attribute and convert values to a new type",
+				Command: "datastore migrate --datastore tasks --drop legacy --coerce points=number",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			return preRunMigrateCommandFunc(ctx, clients, cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			return runMigrateCommandFunc(ctx, clients, cmd)
+		},
+	}
+	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
+	cmd.Flags().StringArrayVar(&migrateRenameFlag, "rename", []string{}, migrateRenameUsage)
+	cmd.Flags().StringArrayVar(&migrateDefaultFlag, "default", []string{}, migrateDefaultUsage)
+	cmd.Flags().StringArrayVar(&migrateDropFlag, "drop", []string{}, migrateDropUsage)
+	cmd.Flags().StringArrayVar(&migrateCoerceFlag, "coerce", []string{}, migrateCoerceUsage)
+	cmd.Flags().BoolVar(&migrateDryRunFlag, "dry-run", false, migrateDryRunUsage)
+	cmd.Flags().BoolVar(&migrateNoPromptFlag, "no-prompt", false, migrateNoPromptUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
+
+	return cmd
+}
+
+// preRunMigrateCommandFunc determines if the command is supported for a project
+// and configures flags
+func preRunMigrateCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
+	clients.Config.SetFlags(cmd)
+	err := cmdutil.IsValidProjectDirectory(clients)
+	if err != nil {
+		return err
+	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
+	if clients.Config.ForceFlag {
+		return nil
+	}
+	return cmdutil.IsSlackHostedProject(ctx, clients)
+}
+
+// runMigrateCommandFunc compares the datastore schemas then changes stored
+// items with the transforms of flags
+func runMigrateCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
+	if datastoreFlag == "" {
+		return slackerror.New(slackerror.ErrDatastoreNotFound).
+			WithMessage("No datastore was specified").
+			WithRemediation("Provide a datastore with the %s flag", style.Highlight("--datastore"))
+	}
+
+	selection, err := datastoreAppSelectPrompt(ctx, clients)
+	if err != nil {
+		return err
+	}
+	ctx = config.SetContextToken(ctx, selection.Auth.Token)
+
+	local, deployed, err := getMigrateDatastores(ctx, clients, selection.App, selection.Auth, datastoreFlag)
+	if err != nil {
+		return err
+	}
+	transforms, err := migrate.ParseTransforms(migrate.Options{
+		Renames:  migrateRenameFlag,
+		Defaults: migrateDefaultFlag,
+		Drops:    migrateDropFlag,
+		Coerces:  migrateCoerceFlag,
+	}, local)
+	if err != nil {
+		return err
+	}
+
+	changes := migrate.Compare(deployed, local)
+	printMigrateSchemaChanges(ctx, clients, changes, migrate.Unhandled(changes, transforms))
+	if len(transforms) == 0 {
+		clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+			Emoji: "bulb",
+			Text:  "No transforms were provided so stored items are unchanged",
+			Secondary: []string{
+				fmt.Sprintf("Change items with the %s, %s, %s, or %s flags", style.Highlight("--rename"), style.Highlight("--default"), style.Highlight("--drop"), style.Highlight("--coerce")),
+			},
+		}))
+		return nil
+	}
+	undeployed := migrate.Undeployed(transforms, deployed)
+	if len(undeployed) > 0 && !migrateDryRunFlag {
+		return errMigrateUndeployed(undeployed)
+	}
+
+	migrateProgressSpinner = style.NewSpinner(cmd.OutOrStdout())
+	defer migrateProgressSpinner.Stop()
+
+	plan, err := scanMigrateItems(ctx, clients, types.AppDatastoreQuery{
+		Datastore: datastoreFlag,
+		App:       selection.App.AppID,
+	}, local.PrimaryKey, transforms)
+	if err != nil {
+		return err
+	}
+	printMigratePlan(ctx, clients, plan, transforms)
+
+	if migrateDryRunFlag {
+		if len(undeployed) > 0 {
+			clients.IO.PrintInfo(ctx, false, "\n%sNo items were saved. Deploy the app with %s then run the command without %s to save %d items.\n", style.Emoji("bulb"), style.Commandf("deploy", false), style.Highlight("--dry-run"), len(plan.items))
+			return nil
+		}
+		clients.IO.PrintInfo(ctx, false, "\n%sNo items were saved. Run the command without %s to save %d items.\n", style.Emoji("bulb"), style.Highlight("--dry-run"), len(plan.items))
+		return nil
+	}
+	if len(plan.items) == 0 {
+		return nil
+	}
+	if !migrateNoPromptFlag {
+		if !clients.IO.IsTTY() {
+			return errDatastorePromptRequired("save migrated items", "--no-prompt")
+		}
+		proceed, err := clients.IO.ConfirmPrompt(ctx, fmt.Sprintf("Save %d changed items to the %s datastore?", len(plan.items), datastoreFlag), false)
+		if err != nil {
+			return err
+		} else if !proceed {
+			return nil
+		}
+	}
+	return saveMigrateItems(ctx, clients, types.AppDatastoreBulkPut{
+		Datastore: datastoreFlag,
+		App:       selection.App.AppID,
+	}, local.PrimaryKey, plan.items)
+}
+
+// errMigrateUndeployed errors for transforms that write attributes which are
+// not in the deployed datastore
+func errMigrateUndeployed(undeployed []migrate.Transform) error {
+	details := slackerror.ErrorDetails{}
+	for _, transform := range undeployed {
+		details = append(details, slackerror.ErrorDetail{Message: transform.String()})
+	}
+	return slackerror.New(slackerror.ErrInvalidDatastore).
+		WithMessage("The %s datastore must be deployed with the local attributes before migrated items are saved", datastoreFlag).
+		WithDetails(details).
+		WithRemediation("Preview the migration with %s, deploy the app with %s, then run this command again", style.Highlight("--dry-run"), style.Commandf("deploy", false))
+}
+
+// getMigrateDatastores returns the datastore of the local manifest and of the
+// deployed manifest. The local manifest is used for both with the emulator.
+func getMigrateDatastores(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	app types.App,
+	auth types.SlackAuth,
+	datastoreName string,
+) (
+	types.ManifestDatastore,
+	types.ManifestDatastore,
+	error,
+) {
+	localManifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	if err != nil {
+		return types.ManifestDatastore{}, types.ManifestDatastore{}, err
+	}
+	local, exists := localManifest.Datastores[datastoreName]
+	if !exists {
+		return types.ManifestDatastore{}, types.ManifestDatastore{}, slackerror.New(slackerror.ErrDatastoreNotFound).
+			WithMessage("The datastore \"%s\" is not in the local manifest", datastoreName)
+	}
+	if emulatorFlag {
+		return local, local, nil
+	}
+	deployedManifest, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	if err != nil {
+		return types.ManifestDatastore{}, types.ManifestDatastore{}, err
+	}
+	deployed, exists := deployedManifest.Datastores[datastoreName]
+	if !exists {
+		return types.ManifestDatastore{}, types.ManifestDatastore{}, slackerror.New(slackerror.ErrDatastoreNotFound).
+			WithMessage("The datastore \"%s\" has not been deployed", datastoreName).
+			WithRemediation("Items are only migrated for datastores of the deployed app")
+	}
+	if deployed.PrimaryKey != local.PrimaryKey {
+		return types.ManifestDatastore{}, types.ManifestDatastore{}, slackerror.New(slackerror.ErrInvalidDatastore).
+			WithMessage("The primary key of the \"%s\" datastore changed from %s to %s", datastoreName, deployed.PrimaryKey, local.PrimaryKey).
+			WithRemediation("Items cannot be migrated to a datastore with a different primary key")
+	}
+	return local, deployed, nil
+}
+
+// printMigrateSchemaChanges lists attributes that differ between the manifests
+// and those without a transform
+func printMigrateSchemaChanges(ctx context.Context, clients *shared.ClientFactory, changes []migrate.Change, unhandled []migrate.Change) {
+	if len(changes) == 0 {
+		clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+			Emoji: "card_file_box",
+			Text:  fmt.Sprintf("The %s datastore has no attribute changes", datastoreFlag),
+		}))
+		return
+	}
+	secondary := []string{}
+	for _, change := range changes {
+		secondary = append(secondary, change.String())
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "card_file_box",
+		Text:      fmt.Sprintf("Attributes of the %s datastore changed since the last deploy", datastoreFlag),
+		Secondary: secondary,
+	}))
+	if len(unhandled) > 0 {
+		secondary := []string{}
+		for _, change := range unhandled {
+			secondary = append(secondary, change.String())
+		}
+		clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+			Emoji:     "warning",
+			Text:      "Stored items might not match these changes without a transform",
+			Secondary: secondary,
+		}))
+	}
+}
+
+// scanMigrateItems queries every item of the datastore and collects the items
+// that change with the transforms
+func scanMigrateItems(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	query types.AppDatastoreQuery,
+	primaryKey string,
+	transforms []migrate.Transform,
+) (
+	migratePlan,
+	error,
+) {
+	items, err := queryDatastoreItems(ctx, clients, config.GetContextToken(ctx), query, func(count int) {
+		migrateProgressSpinner.Update(fmt.Sprintf("Scanned (%d) items.", count), "").Start()
+	})
+	if err != nil {
+		return migratePlan{}, err
+	}
+	plan := migratePlan{scanned: len(items), applied: map[string]int{}}
+	for _, item := range items {
+		migrated, applied, err := migrate.Apply(item, transforms)
+		if err != nil {
+			plan.failures = append(plan.failures, migrateFailure{key: item[primaryKey], reason: err.Error()})
+			continue
+		}
+		if len(applied) == 0 {
+			continue
+		}
+		for _, transform := range applied {
+			plan.applied[transform.String()]++
+		}
+		plan.items = append(plan.items, migrated)
+	}
+	migrateProgressSpinner.Update(fmt.Sprintf("Scanned (%d) items of the datastore", plan.scanned), "mag").Stop()
+	return plan, nil
+}
+
+// printMigratePlan reports the number of items each transform changes and the
+// items that cannot be migrated
+func printMigratePlan(ctx context.Context, clients *shared.ClientFactory, plan migratePlan, transforms []migrate.Transform) {
+	secondary := []string{}
+	for _, transform := range transforms {
+		secondary = append(secondary, fmt.Sprintf("%s: %d items", transform.String(), plan.applied[transform.String()]))
+	}
+	clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+		Emoji:     "clipboard",
+		Text:      fmt.Sprintf("Migration changes %d of %d items", len(plan.items), plan.scanned),
+		Secondary: secondary,
+	}))
+	if len(plan.failures) > 0 {
+		secondary := []string{}
+		for _, failure := range plan.failures[:min(len(plan.failures), maxMigrateFailures)] {
+			secondary = append(secondary, fmt.Sprintf("%v: %s", failure.key, failure.reason))
+		}
+		if len(plan.failures) > maxMigrateFailures {
+			secondary = append(secondary, fmt.Sprintf("and %d more items", len(plan.failures)-maxMigrateFailures))
+		}
+		clients.IO.PrintInfo(ctx, false, "%s", style.Sectionf(style.TextSection{
+			Emoji:     "warning",
+			Text:      fmt.Sprintf("%d items cannot be migrated and will be unchanged", len(plan.failures)),
+			Secondary: secondary,
+		}))
+	}
+}
+
+// saveMigrateItems stores the changed items and errors with the keys of items
+// that fail to be stored
+func saveMigrateItems(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	request types.AppDatastoreBulkPut,
+	primaryKey string,
+	items []map[string]interface{},
+) error {
+	failed, err := bulkPutDatastoreItems(ctx, clients, config.GetContextToken(ctx), request, items, func(count int) {
+		migrateProgressSpinner.Update(fmt.Sprintf("Saved (%d) migrated items.", count), "").Start()
+	})
+	if err != nil {
+		return err
+	}
+	migrateProgressSpinner.Update(fmt.Sprintf("Successfully migrated (%d) items!", len(items)-len(failed)), "tada").Stop()
+	if len(failed) > 0 {
+		keys := []string{}
+		for _, item := range failed {
+			keys = append(keys, fmt.Sprintf("%v", item[primaryKey]))
+		}
+		return slackerror.New(slackerror.ErrDatastore).
+			WithMessage("%d items failed to be saved: %s", len(failed), strings.Join(keys, ", ")).
+			WithRemediation("Run the command again to migrate the remaining items")
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/app"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupMigrateMocks adds a local manifest where the "status" attribute of the
+// deployed "Todos" datastore is renamed to "state" and the stored items
+func setupMigrateMocks(cm *shared.ClientsMock, results ...types.AppDatastoreQueryResult) {
+	manifestMock := cm.AppClient.Manifest.(*app.ManifestMockObject)
+	manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+		AppManifest: types.AppManifest{
+			Datastores: map[string]types.ManifestDatastore{
+				"Todos": {
+					PrimaryKey: "task_id",
+					Attributes: map[string]types.ManifestAttribute{
+						"task_id":  {Type: "string"},
+						"task":     {Type: "string"},
+						"state":    {Type: "string"},
+						"priority": {Type: "integer"},
+					},
+				},
+			},
+		},
+	}, nil)
+	for _, result := range results {
+		cm.APIInterface.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything).
+			Return(result, nil).Once()
+	}
+}
+
+// setupMigrateDeployedMocks adds a deployed manifest with the attributes of the
+// local manifest as after a deploy. This must be called before the other mocks.
+func setupMigrateDeployedMocks(cm *shared.ClientsMock) {
+	manifestMock := &app.ManifestMockObject{}
+	manifestMock.On("GetManifestRemote", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+		AppManifest: types.AppManifest{
+			Datastores: map[string]types.ManifestDatastore{
+				"Todos": {
+					PrimaryKey: "task_id",
+					Attributes: map[string]types.ManifestAttribute{
+						"task_id":  {Type: "string"},
+						"task":     {Type: "string"},
+						"state":    {Type: "string"},
+						"priority": {Type: "integer"},
+					},
+				},
+			},
+		},
+	}, nil)
+	cm.AppClient.Manifest = manifestMock
+}
+
+func TestMigrateCommand(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"reports changes to items without saving on a dry run": {
+			CmdArgs: []string{"--datastore", "Todos", "--rename", "status=state", "--dry-run"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateMocks(cm, types.AppDatastoreQueryResult{
+					Items: []map[string]interface{}{
+						{"task_id": "0001", "task": "counting", "status": "done"},
+						{"task_id": "0002", "task": "counting", "state": "open"},
+					},
+				})
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{
+				"priority was added with type integer",
+				"state was added with type string",
+				"status was removed from type string",
+				"Stored items might not match these changes without a transform",
+				"Migration changes 1 of 2 items",
+				"rename status to state: 1 items",
+				"No items were saved. Deploy the app with",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"saves transformed items from each page of the datastore": {
+			CmdArgs: []string{"--datastore", "Todos", "--rename", "status=state", "--default", "priority=2", "--no-prompt"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateDeployedMocks(cm)
+				setupMigrateMocks(cm,
+					types.AppDatastoreQueryResult{
+						Items:      []map[string]interface{}{{"task_id": "0001", "status": "done"}},
+						NextCursor: "next",
+					},
+					types.AppDatastoreQueryResult{
+						Items: []map[string]interface{}{{"task_id": "0002", "state": "open", "priority": float64(1)}},
+					},
+				)
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreQuery", mock.Anything, mock.Anything, types.AppDatastoreQuery{
+					Datastore: "Todos",
+					App:       mockAppID,
+					Limit:     maxExportQueryLimit,
+					Cursor:    "next",
+				})
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, types.AppDatastoreBulkPut{
+					Datastore: "Todos",
+					App:       mockAppID,
+					Items: []map[string]interface{}{
+						{"task_id": "0001", "state": "done", "priority": float64(2)},
+					},
+				})
+				status, _ := migrateProgressSpinner.Status()
+				assert.Contains(t, status, "Successfully migrated (1) items!")
+			},
+		},
+		"errors without the no-prompt flag when prompts are not interactive": {
+			CmdArgs: []string{"--datastore", "Todos", "--rename", "status=state"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateDeployedMocks(cm)
+				setupMigrateMocks(cm, types.AppDatastoreQueryResult{
+					Items: []map[string]interface{}{{"task_id": "0001", "status": "done"}},
+				})
+				cm.Config.ForceFlag = true
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrPrompt, "Confirmation is required to save migrated items", "--no-prompt"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors before saving items with attributes that are not deployed": {
+			CmdArgs: []string{"--datastore", "Todos", "--rename", "status=state", "--default", "priority=2", "--no-prompt"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateMocks(cm)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{
+				slackerror.ErrInvalidDatastore,
+				"must be deployed with the local attributes",
+				"rename status to state",
+				"default priority to 2",
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything)
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"reports items that cannot be migrated": {
+			CmdArgs: []string{"--datastore", "Todos", "--coerce", "priority", "--dry-run"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateMocks(cm, types.AppDatastoreQueryResult{
+					Items: []map[string]interface{}{
+						{"task_id": "0001", "priority": "high"},
+						{"task_id": "0002", "priority": "4"},
+					},
+				})
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{
+				"coerce priority to integer: 1 items",
+				"1 items cannot be migrated and will be unchanged",
+				`0001: attribute priority: "high" is not a number`,
+			},
+		},
+		"does not scan items without transforms": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateMocks(cm)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{"No transforms were provided so stored items are unchanged"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"errors for transforms that do not match the local manifest": {
+			CmdArgs: []string{"--datastore", "Todos", "--drop", "task"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupMigrateMocks(cm)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, `The attribute "task" is still defined in the local manifest`},
+		},
+		"errors without a datastore": {
+			CmdArgs: []string{"--rename", "status=state"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedError: slackerror.New(slackerror.ErrDatastoreNotFound).
+				WithMessage("No datastore was specified").
+				WithRemediation("Provide a datastore with the %s flag", "--datastore"),
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewMigrateCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -76,7 +75,7 @@ func NewPutCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -111,6 +110,7 @@ func NewPutCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 
 	return cmd
 }
@@ -123,6 +123,9 @@ func preRunPutCommandFunc(ctx context.Context, clients *shared.ClientFactory, cm
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -192,7 +195,7 @@ func promptDatastorePutRequest(
 	var query types.AppDatastorePut
 
 	// Collect datastore information from the manifest
-	yaml, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	yaml, err := getDatastoreManifest(ctx, clients, app, auth)
 	if err != nil {
 		return types.AppDatastorePut{}, err
 	}
@@ -25,11 +25,12 @@ import (
 
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
 	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/datastore/expression"
+	"github.com/toughtackle/slack-cli/internal/datastore/format"
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
-	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -46,7 +47,10 @@ var attributeFlag string
 var attributeUsage = "attribute pairings for the expression"
 
 var saveToFileFlag string
-var saveToFileUsage = "save items directly to a file as JSON Lines"
+var saveToFileUsage = "save items directly to a file as JSON Lines, CSV, or columnar data"
+
+var strictExportFlag bool
+var strictExportUsage = "error if items have attributes that are not columns of the file"
 
 var Query = datastore.Query
 var exportProgressSpinner *style.Spinner
@@ -78,6 +82,10 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Query the datastore for specific items with only an expression",
 				Command: `datastore query '{"datastore": "tasks", "expression": "#status = :status", "expression_attributes": {"#status": "status"}, "expression_values": {":status": "In Progress"}}'`,
 			},
+			{
+				Meaning: "Export items of the datastore to a spreadsheet",
+				Command: `datastore query --datastore tasks '{}' --to-file tasks.csv`,
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -99,7 +107,7 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 			}
 
 			// Get the selection from the flag or prompt
-			selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+			selection, err := datastoreAppSelectPrompt(ctx, clients)
 			if err != nil {
 				return err
 			}
@@ -116,13 +124,30 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 			// Set the app ID from the selected workspace
 			query.App = selection.App.AppID
 
+			err = validateDatastoreExpression(ctx, clients, selection.App, selection.Auth, query.Datastore, expression.Query{
+				Expression: query.Expression,
+				Attributes: query.ExpressionAttributes,
+				Values:     query.ExpressionValues,
+			})
+			if err != nil {
+				return err
+			}
+
 			// Optionally display the JSON expression and exit
 			if showExpressionFlag {
 				return printDatastoreExpressionMarshal(ctx, clients, query)
 			}
 
 			if saveToFileFlag != "" {
-				return startQueryExport(ctx, clients, cmd, query)
+				fileFormat, err := format.Detect(fileFormatFlag, saveToFileFlag)
+				if err != nil {
+					return err
+				}
+				columns, err := getFileColumns(ctx, clients, selection.App, selection.Auth, query.Datastore, fileFormat)
+				if err != nil {
+					return err
+				}
+				return startQueryExport(ctx, clients, cmd, query, fileFormat, columns)
 			}
 
 			// Perform the query
@@ -131,18 +156,20 @@ func NewQueryCommand(clients *shared.ClientFactory) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			printDatastoreQuerySuccess(cmd, event)
+			printDatastoreQuerySuccess(clients, cmd, event)
 			return nil
 		},
 	}
-	cmd.Flags().StringVar(&outputFlag, "output", "text", outputUsage)
 	cmd.Flags().BoolVar(&showExpressionFlag, "show", false, showExpressionUsage)
 
 	cmd.Flags().BoolVar(&unstableFlag, "unstable", false, unstableUsage)
+	cmd.Flags().BoolVar(&emulatorFlag, "emulator", false, emulatorUsage)
 	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
 	cmd.Flags().StringVar(&attributeFlag, "attributes", "", attributeUsage)
 
 	cmd.Flags().StringVar(&saveToFileFlag, "to-file", "", saveToFileUsage)
+	cmd.Flags().StringVar(&fileFormatFlag, "format", "", fileFormatUsage)
+	cmd.Flags().BoolVar(&strictExportFlag, "strict", false, strictExportUsage)
 
 	cmd.Flag("attributes").Hidden = true // Hide while unstable is present
 
@@ -162,6 +189,9 @@ func preRunQueryCommandFunc(ctx context.Context, clients *shared.ClientFactory,
 	if err != nil {
 		return err
 	}
+	if emulatorFlag {
+		return datastore.UseEmulator(ctx, clients)
+	}
 	if clients.Config.ForceFlag {
 		return nil
 	}
@@ -191,27 +221,18 @@ func newQueryLogger(clients *shared.ClientFactory, cmd *cobra.Command) *logger.L
 
 func printQueryResult(clients *shared.ClientFactory, cmd *cobra.Command, queryResult types.AppDatastoreQueryResult) error {
 	var datastore = queryResult.Datastore
-	switch outputFlag {
-	case "text":
-		var items = queryResult.Items
-		cmd.Printf(
-			style.Bold("%s Retrieved %d items from datastore: %s\n\n"),
-			style.Emoji("tada"),
-			len(items),
-			datastore,
-		)
-		for _, item := range items {
-			b, err := goutils.JSONMarshalUnescapedIndent(item)
-			if err != nil {
-				return slackerror.New("Error during output indentation").WithRootCause(err)
-			}
-			cmd.Printf(
-				"%s\n",
-				string(b),
-			)
-		}
-	case "json":
-		b, err := goutils.JSONMarshalUnescapedIndent(queryResult)
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(cmd.Context(), queryResult)
+	}
+	var items = queryResult.Items
+	cmd.Printf(
+		style.Bold("%s Retrieved %d items from datastore: %s\n\n"),
+		style.Emoji("tada"),
+		len(items),
+		datastore,
+	)
+	for _, item := range items {
+		b, err := goutils.JSONMarshalUnescapedIndent(item)
 		if err != nil {
 			return slackerror.New("Error during output indentation").WithRootCause(err)
 		}
@@ -223,8 +244,8 @@ func printQueryResult(clients *shared.ClientFactory, cmd *cobra.Command, queryRe
 	return nil
 }
 
-func printDatastoreQuerySuccess(cmd *cobra.Command, event *logger.LogEvent) {
-	if outputFlag == "text" {
+func printDatastoreQuerySuccess(clients *shared.ClientFactory, cmd *cobra.Command, event *logger.LogEvent) {
+	if !clients.IO.IsStructuredOutput() {
 		commandText := style.Commandf("datastore put <expression>", true)
 		if cmd != nil {
 			cmd.Printf(
@@ -248,7 +269,7 @@ func promptDatastoreQueryRequest(
 	var query types.AppDatastoreQuery
 
 	// Collect datastore information from the manifest
-	yaml, err := clients.AppClient().Manifest.GetManifestRemote(ctx, auth.Token, app.AppID)
+	yaml, err := getDatastoreManifest(ctx, clients, app, auth)
 	if err != nil {
 		return types.AppDatastoreQuery{}, err
 	}
@@ -370,8 +391,20 @@ func getExpressionPatterns(expression string) ([]string, []string) {
 	return expressionAttributes, expressionValues
 }
 
-func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command, query types.AppDatastoreQuery) error {
+// startQueryExport saves items matching the query to a file in the format.
+// Columns from the datastore are used for formats other than JSON Lines and
+// attributes missing from these columns are reported after the export or
+// error with the strict flag.
+func startQueryExport(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	query types.AppDatastoreQuery,
+	fileFormat format.Format,
+	columns []format.Column,
+) error {
 	totalExportedItems := 0
+	undeclaredAttributes := map[string]bool{}
 	userPreferredLimit := maxExportQueryLimit
 	if query.Limit > 0 {
 		userPreferredLimit = query.Limit
@@ -382,6 +415,7 @@ func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *c
 		return err
 	}
 	defer itemsFile.Close()
+	itemsWriter := format.NewWriter(fileFormat, itemsFile, columns)
 
 	itemsFilePath, err := filepath.Abs(itemsFile.Name())
 	if err != nil {
@@ -415,11 +449,18 @@ func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *c
 		}
 
 		for _, element := range queryResult.Items {
-			stringItem, err := goutils.JSONMarshalUnescaped(element)
-			if err != nil {
-				return err
+			if columns != nil {
+				undeclared := format.Undeclared(element, columns)
+				if len(undeclared) > 0 && strictExportFlag {
+					return slackerror.New(slackerror.ErrInvalidArguments).
+						WithMessage("Attributes of an item are not declared in the app manifest: %s", strings.Join(undeclared, ", ")).
+						WithRemediation("Add the attributes to the datastore in the app manifest or export the items as JSON Lines")
+				}
+				for _, name := range undeclared {
+					undeclaredAttributes[name] = true
+				}
 			}
-			_, err = itemsFile.WriteString(stringItem)
+			err = itemsWriter.Write(element)
 			if err != nil {
 				return err
 			}
@@ -436,12 +477,23 @@ func startQueryExport(ctx context.Context, clients *shared.ClientFactory, cmd *c
 		query.Cursor = queryResult.NextCursor
 
 	}
+	if err := itemsWriter.Close(); err != nil {
+		return err
+	}
 
 	exportProgressSpinner.Update(fmt.Sprintf("Successfully exported (%d) items!", totalExportedItems), "tada").Stop()
 
 	if totalExportedItems >= maxExportItems {
 		clients.IO.PrintInfo(ctx, false, "%sExport will be limited to the first %d items in the datastore", style.Emoji("warning"), maxExportItems)
 	}
+	if len(undeclaredAttributes) > 0 {
+		names := make([]string, 0, len(undeclaredAttributes))
+		for name := range undeclaredAttributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		clients.IO.PrintWarning(ctx, "Attributes not declared in the app manifest were left out of the file: %s", strings.Join(names, ", "))
+	}
 	cmd.Println()
 
 	return nil
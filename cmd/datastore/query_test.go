@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/toughtackle/slack-cli/internal/app"
@@ -30,9 +31,11 @@ import (
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
 	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type QueryDatastorePkgMock struct {
@@ -137,6 +140,8 @@ func TestQueryCommandPreRun(t *testing.T) {
 				cf.SDKConfig.WorkingDirectory = tt.mockWorkingDirectory
 			})
 			cmd := NewQueryCommand(clients)
+			clientsMock.Config.InitializeGlobalFlags(cmd)
+			require.NoError(t, cmd.ParseFlags(nil))
 			clients.Config.SetFlags(cmd)
 			if tt.mockFlagOutput != "" {
 				clients.Config.Flags.Lookup("output").Changed = true
@@ -418,6 +423,87 @@ func TestQueryCommandExport(t *testing.T) {
 				assert.Equal(t, len(items), 10)
 			},
 		},
+		"export items to a csv file with columns from the manifest": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--to-file=my-file.csv`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				_, err := prepareExportMockData(cm, 3, 2)
+				assert.NoError(t, err)
+
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				status, _ := exportProgressSpinner.Status()
+				assert.Contains(t, status, "Successfully exported (3) items!")
+
+				contents, err := afero.ReadFile(cm.Fs, "my-file.csv")
+				assert.NoError(t, err)
+				assert.Equal(t, strings.Join([]string{
+					"task_id,status,task",
+					"0001,ongoing,counting",
+					"0002,ongoing,counting",
+					"0003,ongoing,counting",
+					"",
+				}, "\n"), string(contents))
+			},
+		},
+		"warns when exported items have attributes missing from the manifest": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--to-file=my-file.csv`,
+			},
+			ExpectedOutputs: []string{"Attributes not declared in the app manifest were left out of the file: priority"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				cm.APIInterface.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreQueryResult{
+						Items: []map[string]interface{}{{"task_id": "0001", "task": "counting", "priority": "high"}},
+					}, nil).Once()
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				contents, err := afero.ReadFile(cm.Fs, "my-file.csv")
+				assert.NoError(t, err)
+				assert.Equal(t, "task_id,status,task\n0001,,counting\n", string(contents))
+			},
+		},
+		"errors for attributes missing from the manifest with the strict flag": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--to-file=my-file.csv`,
+				`--strict`,
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidArguments, "Attributes of an item are not declared in the app manifest: priority"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				cm.APIInterface.On("AppsDatastoreQuery", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreQueryResult{
+						Items: []map[string]interface{}{{"task_id": "0001", "task": "counting", "priority": "high"}},
+					}, nil).Once()
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			Teardown: func() {
+				strictExportFlag = false
+			},
+		},
+		"errors for an unknown file format": {
+			CmdArgs: []string{
+				`{"datastore":"Todos"}`,
+				`--to-file=my-file`,
+				`--format=parquet`,
+			},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrInvalidFlag, "The file format \"parquet\" is not supported"},
+			Teardown: func() {
+				fileFormatFlag = ""
+			},
+		},
 		"exports a max of 10000 items only": {
 			CmdArgs: []string{
 				`{"datastore":"Todos"}`,
@@ -468,3 +554,39 @@ func prepareExportMockData(cm *shared.ClientsMock, numberOfItems int, maxItemsTo
 	}
 	return data, nil
 }
+
+func Test_printQueryResult(t *testing.T) {
+	tests := map[string]struct {
+		outputFlag      string
+		expectedOutputs []string
+	}{
+		"prints the items as text": {
+			outputFlag:      "text",
+			expectedOutputs: []string{"Retrieved 1 items from datastore: Todos", `"task_id": "0001"`},
+		},
+		"prints the result in the format of the output flag": {
+			outputFlag:      "yaml",
+			expectedOutputs: []string{"datastore: Todos", `- task_id: "0001"`},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clientsMock.Config.OutputFlag = tt.outputFlag
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			cmd := &cobra.Command{}
+			cmd.SetContext(ctx)
+			testutil.MockCmdIO(clients.IO, cmd)
+			err := printQueryResult(clients, cmd, types.AppDatastoreQueryResult{
+				Datastore: "Todos",
+				Items:     []map[string]interface{}{{"task_id": "0001"}},
+			})
+			require.NoError(t, err)
+			for _, expected := range tt.expectedOutputs {
+				assert.Contains(t, clientsMock.GetStdoutOutput(), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/datastore/diff"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+var syncDryRunFlag bool
+var syncDryRunUsage = "report the changes to items without saving them"
+
+var syncNoPromptFlag bool
+var syncNoPromptUsage = "save changes to items without a confirmation prompt"
+
+var syncProgressSpinner *style.Spinner
+
+func NewSyncCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync [flags]",
+		Short: "Copy the items of a datastore from one app to another",
+		Long: strings.Join([]string{
+			"Change the items of a datastore in a target app to match the items of the same",
+			"datastore in a source app.",
+			"",
+			"Items added to or changed in the source are stored in the target and items not",
+			"in the source are removed from the target. Changes are reported and confirmed",
+			"with a prompt or the --no-prompt flag before items are saved. The --dry-run flag",
+			"only shows this report.",
+			"",
+			"This command is supported for apps deployed to Slack managed infrastructure but",
+			"other apps can attempt to run the command with the --force flag.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{
+				Meaning: "Seed the local app with the items of the deployed app",
+				Command: "datastore sync --datastore tasks --source-app deployed --target-app local",
+			},
+			{
+				Meaning: "Report the changes to the local app without saving items",
+				Command: "datastore sync --datastore tasks --source-app deployed --target-app local --dry-run",
+			},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			return preRunDiffCommandFunc(ctx, clients, cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			return runSyncCommandFunc(ctx, clients, cmd)
+		},
+	}
+	cmd.Flags().StringVar(&datastoreFlag, "datastore", "", datastoreUsage)
+	addDatastoreDiffFlags(cmd)
+	cmd.Flags().BoolVar(&syncDryRunFlag, "dry-run", false, syncDryRunUsage)
+	cmd.Flags().BoolVar(&syncNoPromptFlag, "no-prompt", false, syncNoPromptUsage)
+
+	return cmd
+}
+
+// runSyncCommandFunc compares the datastore of both apps then stores and
+// removes items of the target app after the changes are confirmed
+func runSyncCommandFunc(ctx context.Context, clients *shared.ClientFactory, cmd *cobra.Command) error {
+	apps, err := selectDatastoreDiffApps(ctx, clients)
+	if err != nil {
+		return err
+	}
+	result, err := compareDatastoreApps(ctx, clients, cmd, apps, datastoreFlag)
+	if err != nil {
+		return err
+	}
+	if err := printDatastoreDiff(ctx, clients, cmd, apps, result, false); err != nil {
+		return err
+	}
+	if result.Empty() {
+		return nil
+	}
+	if syncDryRunFlag {
+		clients.IO.PrintInfo(ctx, false, "\n%sNo items were saved. Run the command without %s to store %d items and remove %d items.\n", style.Emoji("bulb"), style.Highlight("--dry-run"), len(result.Added)+len(result.Changed), len(result.Removed))
+		return nil
+	}
+	if !syncNoPromptFlag {
+		if !clients.IO.IsTTY() {
+			return errDatastorePromptRequired("sync items to the target app", "--no-prompt")
+		}
+		message := fmt.Sprintf("Store %d items and remove %d items of the target app?", len(result.Added)+len(result.Changed), len(result.Removed))
+		proceed, err := clients.IO.ConfirmPrompt(ctx, message, false)
+		if err != nil {
+			return err
+		} else if !proceed {
+			return nil
+		}
+	}
+	return applyDatastoreDiff(ctx, clients, cmd, apps, result)
+}
+
+// applyDatastoreDiff stores added and changed items then removes items of the
+// target app that are not in the source
+func applyDatastoreDiff(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	cmd *cobra.Command,
+	apps datastoreDiffApps,
+	result diff.Result,
+) error {
+	token := apps.target.Auth.Token
+	items := append([]map[string]interface{}{}, result.Added...)
+	for _, changed := range result.Changed {
+		items = append(items, changed.Source)
+	}
+	ids := []string{}
+	for _, item := range result.Removed {
+		key, _ := diff.Key(item, result.PrimaryKey)
+		ids = append(ids, key)
+	}
+
+	syncProgressSpinner = style.NewSpinner(cmd.OutOrStdout())
+	defer syncProgressSpinner.Stop()
+
+	failedItems, err := bulkPutDatastoreItems(ctx, clients, token, types.AppDatastoreBulkPut{
+		Datastore: datastoreFlag,
+		App:       apps.target.App.AppID,
+	}, items, func(count int) {
+		syncProgressSpinner.Update(fmt.Sprintf("Stored (%d) items.", count), "").Start()
+	})
+	if err != nil {
+		return err
+	}
+	failedIDs, err := bulkDeleteDatastoreItems(ctx, clients, token, types.AppDatastoreBulkDelete{
+		Datastore: datastoreFlag,
+		App:       apps.target.App.AppID,
+	}, ids, func(count int) {
+		syncProgressSpinner.Update(fmt.Sprintf("Removed (%d) items.", count), "").Start()
+	})
+	if err != nil {
+		return err
+	}
+	syncProgressSpinner.Update(fmt.Sprintf("Successfully stored (%d) items and removed (%d) items!", len(items)-len(failedItems), len(ids)-len(failedIDs)), "tada").Stop()
+
+	for _, item := range failedItems {
+		key, _ := diff.Key(item, result.PrimaryKey)
+		failedIDs = append(failedIDs, key)
+	}
+	if len(failedIDs) > 0 {
+		return slackerror.New(slackerror.ErrDatastore).
+			WithMessage("%d items failed to be synced: %s", len(failedIDs), strings.Join(failedIDs, ", ")).
+			WithRemediation("Run the command again to sync the remaining items")
+	}
+	return nil
+}
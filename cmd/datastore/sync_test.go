@@ -0,0 +1,135 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncCommand(t *testing.T) {
+	sourceItems := []map[string]interface{}{
+		{"task_id": "0001", "task": "counting", "status": "done"},
+		{"task_id": "0003", "task": "writing", "status": "open"},
+	}
+	targetItems := []map[string]interface{}{
+		{"task_id": "0001", "task": "counting", "status": "open"},
+		{"task_id": "0004", "task": "sleeping", "status": "open"},
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"stores and removes items of the target app": {
+			CmdArgs: []string{"--datastore", "Todos", "--no-prompt"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+				cm.APIInterface.On("AppsDatastoreBulkDelete", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkDeleteResult{}, nil)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreBulkPut", mock.Anything, "xoxb-target", types.AppDatastoreBulkPut{
+					Datastore: "Todos",
+					App:       "A0000000002",
+					Items: []map[string]interface{}{
+						{"task_id": "0003", "task": "writing", "status": "open"},
+						{"task_id": "0001", "task": "counting", "status": "done"},
+					},
+				})
+				cm.APIInterface.AssertCalled(t, "AppsDatastoreBulkDelete", mock.Anything, "xoxb-target", types.AppDatastoreBulkDelete{
+					Datastore: "Todos",
+					App:       "A0000000002",
+					IDs:       []string{"0004"},
+				})
+				status, _ := syncProgressSpinner.Status()
+				assert.Contains(t, status, "Successfully stored (2) items and removed (1) items!")
+			},
+		},
+		"errors with the keys of items that fail to sync": {
+			CmdArgs: []string{"--datastore", "Todos", "--no-prompt"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{FailedItems: sourceItems[1:]}, nil)
+				cm.APIInterface.On("AppsDatastoreBulkDelete", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkDeleteResult{}, nil)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrDatastore, "1 items failed to be synced: 0003"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNumberOfCalls(t, "AppsDatastoreBulkPut", maxBulkAttempts)
+			},
+		},
+		"reports changes without saving items on a dry run": {
+			CmdArgs: []string{"--datastore", "Todos", "--dry-run"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedOutputs: []string{"No items were saved", "store 2 items and remove 1 items"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkDelete", mock.Anything, mock.Anything, mock.Anything)
+			},
+			Teardown: func() {
+				syncDryRunFlag = false
+			},
+		},
+		"errors without the no-prompt flag when prompts are not interactive": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				cm.Config.ForceFlag = true
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedErrorStrings: []string{slackerror.ErrPrompt, "Confirmation is required to sync items to the target app", "--no-prompt"},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		"skips the changes when the prompt is declined": {
+			CmdArgs: []string{"--datastore", "Todos"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				*cm = *setupDatastoreMocks()
+				setupDiffMocks(cm, sourceItems, targetItems)
+				cm.IO.On("IsTTY").Unset()
+				cm.IO.On("IsTTY").Return(true)
+				cm.IO.On("ConfirmPrompt", mock.Anything, "Store 2 items and remove 1 items of the target app?", false).
+					Return(false, nil)
+				*cf = *shared.NewClientFactory(cm.MockClientFactory())
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				cm.IO.AssertCalled(t, "ConfirmPrompt", mock.Anything, mock.Anything, false)
+				cm.APIInterface.AssertNotCalled(t, "AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewSyncCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
@@ -62,6 +62,7 @@ func NewDoctorCommand(clients *shared.ClientFactory) *cobra.Command {
 		}, "\n"),
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "doctor", Meaning: "Create a status report of system dependencies"},
+			{Command: "doctor --output json", Meaning: "Create a status report for scripts to read"},
 		}),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -69,6 +70,9 @@ func NewDoctorCommand(clients *shared.ClientFactory) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if clients.IO.IsStructuredOutput() {
+				return clients.IO.PrintStructured(ctx, newDoctorOutput(report))
+			}
 			err = style.PrintTemplate(cmd.OutOrStdout(), string(embedDocTmpl), report)
 			if err != nil {
 				return err
@@ -96,6 +100,56 @@ func (d DoctorReport) TotalErrors() int {
 	return totalErrors
 }
 
+// doctorOutput is the structured output of the doctor command
+type doctorOutput struct {
+	Sections []doctorSectionOutput `json:"sections"`
+	Errors   int                   `json:"errors"`
+}
+
+// doctorSectionOutput is a section of the report with nested sections
+type doctorSectionOutput struct {
+	Label    string                `json:"label"`
+	Value    string                `json:"value"`
+	Sections []doctorSectionOutput `json:"sections"`
+	Errors   []doctorErrorOutput   `json:"errors"`
+}
+
+// doctorErrorOutput is a problem found with a section of the report
+type doctorErrorOutput struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// newDoctorOutput returns the structured output of a report
+func newDoctorOutput(report DoctorReport) doctorOutput {
+	return doctorOutput{
+		Sections: newDoctorSectionOutputs(report.Sections),
+		Errors:   report.TotalErrors(),
+	}
+}
+
+func newDoctorSectionOutputs(sections []Section) []doctorSectionOutput {
+	outputs := make([]doctorSectionOutput, 0, len(sections))
+	for _, section := range sections {
+		errs := make([]doctorErrorOutput, 0, len(section.Errors))
+		for _, err := range section.Errors {
+			errs = append(errs, doctorErrorOutput{
+				Code:        err.Code,
+				Message:     err.Message,
+				Remediation: err.Remediation,
+			})
+		}
+		outputs = append(outputs, doctorSectionOutput{
+			Label:    section.Label,
+			Value:    section.Value,
+			Sections: newDoctorSectionOutputs(section.Subsections),
+			Errors:   errs,
+		})
+	}
+	return outputs
+}
+
 // performChecks runs a series of checks for relevant dependencies.
 
 // If successful, a report containing the details of each
@@ -418,3 +418,29 @@ func TestDoctorHook(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDoctorOutput(t *testing.T) {
+	report := DoctorReport{
+		Sections: []Section{
+			{
+				Label: "Runtime",
+				Subsections: []Section{
+					{Label: "deno", Value: "1.0.0"},
+				},
+				Errors: []slackerror.Error{
+					*slackerror.New(slackerror.ErrRuntimeNotSupported).WithRemediation("Update the runtime"),
+				},
+			},
+		},
+	}
+	output := newDoctorOutput(report)
+	assert.Equal(t, 1, output.Errors)
+	require.Len(t, output.Sections, 1)
+	assert.Equal(t, "Runtime", output.Sections[0].Label)
+	assert.Equal(t, []doctorSectionOutput{
+		{Label: "deno", Value: "1.0.0", Sections: []doctorSectionOutput{}, Errors: []doctorErrorOutput{}},
+	}, output.Sections[0].Sections)
+	require.Len(t, output.Sections[0].Errors, 1)
+	assert.Equal(t, slackerror.ErrRuntimeNotSupported, output.Sections[0].Errors[0].Code)
+	assert.Equal(t, "Update the runtime", output.Sections[0].Errors[0].Remediation)
+}
@@ -45,6 +45,10 @@ func NewEnvListCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "List all environment variables",
 				Command: "env list",
 			},
+			{
+				Meaning: "List the names of environment variables as JSON",
+				Command: "env list --output json",
+			},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -71,6 +75,13 @@ func preRunEnvListCommandFunc(ctx context.Context, clients *shared.ClientFactory
 	return cmdutil.IsSlackHostedProject(ctx, clients)
 }
 
+// envListOutput is the structured output of the env list command. Values of
+// variables are never included.
+type envListOutput struct {
+	AppID     string   `json:"app_id"`
+	Variables []string `json:"variables"`
+}
+
 // runEnvListCommandFunc outputs environment variables for a selected app
 func runEnvListCommandFunc(
 	clients *shared.ClientFactory,
@@ -97,6 +108,14 @@ func runEnvListCommandFunc(
 		return err
 	}
 
+	if clients.IO.IsStructuredOutput() {
+		sort.Strings(variableNames)
+		return clients.IO.PrintStructured(ctx, envListOutput{
+			AppID:     selection.App.AppID,
+			Variables: append([]string{}, variableNames...),
+		})
+	}
+
 	count := len(variableNames)
 	clients.IO.PrintTrace(ctx, slacktrace.EnvListCount, strconv.Itoa(count))
 	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
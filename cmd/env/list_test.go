@@ -179,6 +179,21 @@ func Test_Env_ListCommand(t *testing.T) {
 				)
 			},
 		},
+		"list variable names in the --output format": {
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.APIInterface.On("ListVariables", mock.Anything, mock.Anything, mock.Anything).
+					Return([]string{"EXAMPLE_VARIABLE_002", "EXAMPLE_VARIABLE_001"}, nil)
+				appSelectMock := prompts.NewAppSelectMock()
+				teamAppSelectPromptFunc = appSelectMock.TeamAppSelectPrompt
+				appSelectMock.On("TeamAppSelectPrompt").Return(prompts.SelectedApp{
+					App: types.App{AppID: "A001"},
+				}, nil)
+				cm.Config.OutputFlag = "yaml"
+			},
+			ExpectedStdoutOutputs: []string{
+				"app_id: A001\nvariables:\n- EXAMPLE_VARIABLE_001\n- EXAMPLE_VARIABLE_002\n",
+			},
+		},
 	}, func(cf *shared.ClientFactory) *cobra.Command {
 		cmd := NewEnvListCommand(cf)
 		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
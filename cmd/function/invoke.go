@@ -0,0 +1,123 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/pkg/function"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type invokeFlagSet struct {
+	inputs string
+	replay string
+}
+
+var invokeFlags invokeFlagSet
+
+// Create handle to the function for testing
+var invokeFunc = function.Invoke
+
+func NewInvokeCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invoke <callback_id> [flags]",
+		Short: "Invoke a function of the app with inputs",
+		Long: strings.Join([]string{
+			"Invoke a custom function of the app on this machine.",
+			"",
+			`A {{ToBold "function_executed"}} event is piped through the "start" hook in the same way`,
+			"as a local run. The inputs and the outputs of the function are checked against",
+			"the parameters of the function in the app manifest.",
+			"",
+			`Events recorded with {{ToBold "run --record"}} can be replayed with the {{ToBold "--replay"}} flag.`,
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "function invoke greet --inputs inputs.json", Meaning: "Invoke a function with inputs from a file"},
+			{Command: "function invoke greet --replay .slack/fixtures/greet-1700000000.json", Meaning: "Replay an event recorded during a local run"},
+		}),
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInvokeCommand(cmd, clients, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&invokeFlags.inputs, "inputs", "", "path to a JSON file of function inputs")
+	cmd.Flags().StringVar(&invokeFlags.replay, "replay", "", "path to a recorded event to replay")
+
+	return cmd
+}
+
+// runInvokeCommand invokes the function and outputs the result
+func runInvokeCommand(cmd *cobra.Command, clients *shared.ClientFactory, callbackID string) error {
+	ctx := cmd.Context()
+	if invokeFlags.inputs != "" && invokeFlags.replay != "" {
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The --inputs and --replay flags cannot be used together")
+	}
+	args := function.InvokeArgs{CallbackID: callbackID}
+	if invokeFlags.inputs != "" {
+		data, err := afero.ReadFile(clients.Fs, invokeFlags.inputs)
+		if err != nil {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --inputs file could not be read").
+				WithRootCause(err)
+		}
+		if err := json.Unmarshal(data, &args.Inputs); err != nil {
+			return slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The --inputs file is not a valid JSON object").
+				WithRootCause(err)
+		}
+	}
+	if invokeFlags.replay != "" {
+		fixture, err := function.ReadFixture(clients.Fs, invokeFlags.replay)
+		if err != nil {
+			return err
+		}
+		args.Fixture = &fixture
+	}
+	result, err := invokeFunc(ctx, clients, args)
+	if err != nil {
+		return err
+	}
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, result)
+	}
+	outputs, err := json.MarshalIndent(result.Outputs, "", "  ")
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	secondary := []string{}
+	if len(result.Methods) > 0 {
+		secondary = append(secondary, fmt.Sprintf("Called %s", strings.Join(result.Methods, ", ")))
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "zap",
+		Text:      fmt.Sprintf("Function '%s' completed with outputs matching the app manifest", result.CallbackID),
+		Secondary: secondary,
+	}))
+	clients.IO.PrintInfo(ctx, false, "%s", string(outputs))
+	return nil
+}
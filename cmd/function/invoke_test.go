@@ -0,0 +1,96 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/pkg/function"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctionInvokeCommand(t *testing.T) {
+	var received function.InvokeArgs
+	mockInvoke := func(ctx context.Context, clients *shared.ClientFactory, args function.InvokeArgs) (function.InvokeResult, error) {
+		received = args
+		return function.InvokeResult{
+			CallbackID: args.CallbackID,
+			Inputs:     args.Inputs,
+			Outputs:    map[string]interface{}{"greeting": "Hello Ada"},
+			Methods:    []string{"chat.postMessage", "functions.completeSuccess"},
+		}, nil
+	}
+	setupProject := func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+		received = function.InvokeArgs{}
+		cf.SDKConfig.WorkingDirectory = "."
+		invokeFunc = mockInvoke
+		_ = afero.WriteFile(cf.Fs, "inputs.json", []byte(`{"name": "Ada"}`), 0600)
+		_ = afero.WriteFile(cf.Fs, "greet.json", []byte(`{"type": "events_api", "payload": {"event": {"type": "function_executed"}}}`), 0600)
+	}
+	teardown := func() {
+		invokeFunc = function.Invoke
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"invokes the function with inputs from a file": {
+			CmdArgs:  []string{"greet", "--inputs", "inputs.json"},
+			Setup:    setupProject,
+			Teardown: teardown,
+			ExpectedOutputs: []string{
+				"Function 'greet' completed with outputs matching the app manifest",
+				"Called chat.postMessage, functions.completeSuccess",
+				`"greeting": "Hello Ada"`,
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, "greet", received.CallbackID)
+				assert.Equal(t, map[string]interface{}{"name": "Ada"}, received.Inputs)
+				assert.Nil(t, received.Fixture)
+			},
+		},
+		"replays a recorded event": {
+			CmdArgs:  []string{"greet", "--replay", "greet.json"},
+			Setup:    setupProject,
+			Teardown: teardown,
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				require.NotNil(t, received.Fixture)
+				assert.Equal(t, "events_api", received.Fixture.Type)
+			},
+		},
+		"errors if both inputs and a replay are provided": {
+			CmdArgs:              []string{"greet", "--inputs", "inputs.json", "--replay", "greet.json"},
+			Setup:                setupProject,
+			Teardown:             teardown,
+			ExpectedErrorStrings: []string{"The --inputs and --replay flags cannot be used together"},
+		},
+		"errors if the inputs file is missing": {
+			CmdArgs:              []string{"greet", "--inputs", "missing.json"},
+			Setup:                setupProject,
+			Teardown:             teardown,
+			ExpectedErrorStrings: []string{"The --inputs file could not be read"},
+		},
+		"errors if the command is not run in a project": {
+			CmdArgs:       []string{"greet"},
+			ExpectedError: slackerror.New(slackerror.ErrInvalidAppDirectory),
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewInvokeCommand(clients)
+	})
+}
@@ -90,6 +90,9 @@ func runInfoCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 	if err != nil {
 		return err
 	}
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, info)
+	}
 	manifest, err := json.MarshalIndent(info, "", "  ")
 	if err != nil {
 		return err
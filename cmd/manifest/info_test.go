@@ -113,6 +113,25 @@ func TestInfoCommand(t *testing.T) {
 				assert.Equal(t, string(manifest)+"\n", cm.GetStdoutOutput())
 			},
 		},
+		"prints the manifest in the --output format": {
+			CmdArgs: []string{"--source", "local"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				manifestMock := &app.ManifestMockObject{}
+				manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{
+					AppManifest: types.AppManifest{
+						DisplayInformation: types.DisplayInformation{
+							Name: "app003",
+						},
+					},
+				}, nil)
+				cf.AppClient().Manifest = manifestMock
+				cf.SDKConfig = hooks.NewSDKConfigMock()
+				cm.Config.OutputFlag = "template={{.display_information.name}}"
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, "app003\n", cm.GetStdoutOutput())
+			},
+		},
 		"gathers manifest.source from project configurations with the bolt experiment": {
 			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
 				appSelectMock := prompts.NewAppSelectMock()
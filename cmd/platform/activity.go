@@ -16,7 +16,10 @@ package platform
 
 import (
 	"fmt"
+	"path/filepath"
 
+	"github.com/toughtackle/slack-cli/internal/activityalert"
+	"github.com/toughtackle/slack-cli/internal/activitylog"
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/logger"
@@ -34,6 +37,10 @@ var tailArg bool
 var pollingIntervalS int
 var idleTimeoutM int
 var browser bool
+var groupByTrace bool
+var save bool
+var alerts string
+var otlpTarget string
 
 // Filters
 var limit int
@@ -46,6 +53,9 @@ var componentID string
 var source string
 var traceID string
 
+// alertsDefault is the path of the alert rules file in a project
+var alertsDefault = filepath.Join(config.ProjectConfigDirName, activityalert.Filename)
+
 // Create handle to the function for testing
 // TODO - Stopgap until we learn the correct way to structure our code for testing.
 var activityFunc = platform.Activity
@@ -61,6 +71,13 @@ func NewActivityCommand(clients *shared.ClientFactory) *cobra.Command {
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "platform activity", Meaning: "Display app activity logs for an app"},
 			{Command: "platform activity -t", Meaning: "Continuously poll for new activity logs"},
+			{Command: "platform activity -t --output json", Meaning: "Stream activity logs as a JSON object per line"},
+			{Command: "platform activity -t --group-by-trace", Meaning: "Show each workflow execution as a tree once it finishes"},
+			{Command: "platform activity trace Tr0123456789", Meaning: "Show the execution tree of a single trace"},
+			{Command: "platform activity -t --save", Meaning: "Save activity logs to the project while polling"},
+			{Command: "platform activity search --level error", Meaning: "Search saved activity logs for errors"},
+			{Command: "platform activity -t --alerts", Meaning: "Run the actions of alert rules that match new logs"},
+			{Command: "platform activity -t --otlp http://localhost:4318", Meaning: "Export workflow executions as OpenTelemetry spans"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			// Verify command is run in a project directory
@@ -75,6 +92,11 @@ func NewActivityCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().IntVarP(&pollingIntervalS, "interval", "i", platform.ActivityPollingIntervalDefault, "polling interval in seconds")
 	cmd.Flags().IntVarP(&idleTimeoutM, "idle", "", platform.ActivityIdleTimeoutDefault, "time to poll without results before exiting\n  in minutes")
 	cmd.Flags().BoolVarP(&browser, "browser", "", false, "open the default web browser to the log activity")
+	cmd.Flags().BoolVar(&save, "save", false, fmt.Sprintf("save fetched logs to \"%s\" to search\n  later with \"activity search\"", filepath.Join(config.ProjectConfigDirName, activitylog.DirName)))
+	cmd.Flags().StringVar(&alerts, "alerts", "", "run commands or post to local webhooks for logs\n  that match the rules in a file")
+	cmd.Flags().Lookup("alerts").NoOptDefVal = alertsDefault
+	cmd.Flags().StringVar(&otlpTarget, "otlp", "", "export workflow executions as OpenTelemetry spans\n  to an OTLP/HTTP endpoint or a file")
+	cmd.Flags().BoolVar(&groupByTrace, "group-by-trace", false, "group workflow and function logs into an\n  execution tree for each trace")
 	cmd.Flags().StringVar(&minLevel, "level", "", fmt.Sprintf("minimum log level to display (default \"%s\")\n  (trace, debug, info, warn, error, fatal)", platform.ActivityMinLevelDefault))
 	cmd.Flags().IntVar(&limit, "limit", platform.ActivityLimitDefault, "limit the amount of logs retrieved")
 	cmd.Flags().Int64Var(&minDateCreated, "min-date-created", 0, "minimum timestamp to filter\n  (unix timestamp in microseconds)")
@@ -85,6 +107,10 @@ func NewActivityCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&source, "source", "", "source (slack or developer) to filter")
 	cmd.Flags().StringVar(&traceID, "trace-id", "", "trace id to filter")
 
+	// Add child commands
+	cmd.AddCommand(NewActivitySearchCommand(clients))
+	cmd.AddCommand(NewActivityTraceCommand(clients))
+
 	// Hidden flags
 	_ = cmd.Flags().MarkHidden("browser") // Hide until editing apps from the App Config UI is available
 
@@ -128,6 +154,10 @@ func runActivityCommand(clients *shared.ClientFactory, cmd *cobra.Command, args
 		ComponentID:       componentID,
 		Source:            source,
 		TraceID:           traceID,
+		GroupByTrace:      groupByTrace,
+		Save:              save,
+		Alerts:            alerts,
+		OTLP:              otlpTarget,
 	}
 
 	log := newActivityLogger(cmd)
@@ -0,0 +1,123 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/activitylog"
+	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/pkg/platform"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+type searchCmdFlags struct {
+	limit          int
+	since          time.Duration
+	minDateCreated int64
+	maxDateCreated int64
+	minLevel       string
+	eventType      string
+	componentType  string
+	componentID    string
+	source         string
+	traceID        string
+}
+
+var searchFlags searchCmdFlags
+
+// Create handle to the function for testing
+var activitySearchFunc = platform.ActivitySearch
+
+// NewActivitySearchCommand creates the command to search saved activity logs
+func NewActivitySearchCommand(clients *shared.ClientFactory) *cobra.Command {
+	dir := filepath.Join(config.ProjectConfigDirName, activitylog.DirName)
+	cmd := &cobra.Command{
+		Use:   "search [flags]",
+		Short: "Search activity logs saved in the project",
+		Long: strings.Join([]string{
+			fmt.Sprintf("Search activity logs saved to \"%s\" without calling the Slack API.", dir),
+			"",
+			"Logs are saved while running \"activity --save\" and are kept after the",
+			"platform no longer keeps them. Saved logs rotate once the saved files grow",
+			"large, so the oldest logs are eventually removed.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "platform activity search --level error --since 72h", Meaning: "Find errors from the last three days"},
+			{Command: "platform activity search --trace-id Tr0123456789", Meaning: "Find the saved logs of a trace"},
+			{Command: "activity search --component-id Fn0123456789 --limit 20", Meaning: "Find the latest logs of a function"},
+		}),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Verify command is run in a project directory
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runActivitySearchCommand(clients, cmd)
+		},
+	}
+
+	cmd.Flags().StringVar(&searchFlags.minLevel, "level", "", "minimum log level to display\n  (trace, debug, info, warn, error, fatal)")
+	cmd.Flags().IntVar(&searchFlags.limit, "limit", 0, "limit the amount of the latest logs shown")
+	cmd.Flags().DurationVar(&searchFlags.since, "since", 0, "only show logs created within this duration\n  (for example 90m or 72h)")
+	cmd.Flags().Int64Var(&searchFlags.minDateCreated, "min-date-created", 0, "minimum timestamp to filter\n  (unix timestamp in microseconds)")
+	cmd.Flags().Int64Var(&searchFlags.maxDateCreated, "max-date-created", 0, "maximum timestamp to filter\n  (unix timestamp in microseconds)")
+	cmd.Flags().StringVar(&searchFlags.eventType, "event", "", "event type to filter")
+	cmd.Flags().StringVar(&searchFlags.componentType, "component", "", "component type to filter")
+	cmd.Flags().StringVar(&searchFlags.componentID, "component-id", "", "component id to filter\n  (either a function id or workflow id)")
+	cmd.Flags().StringVar(&searchFlags.source, "source", "", "source (slack or developer) to filter")
+	cmd.Flags().StringVar(&searchFlags.traceID, "trace-id", "", "trace id to filter")
+
+	return cmd
+}
+
+// runActivitySearchCommand searches saved activity logs. Logs of every app are
+// searched unless an app ID is passed with --app.
+func runActivitySearchCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	if searchFlags.since != 0 && searchFlags.minDateCreated != 0 {
+		return slackerror.New(slackerror.ErrMismatchedFlags).WithMessage("--since can not be used with --min-date-created")
+	}
+	minDateCreated := searchFlags.minDateCreated
+	if searchFlags.since > 0 {
+		minDateCreated = time.Now().Add(-searchFlags.since).UnixMicro()
+	}
+	appID := ""
+	if types.IsAppID(clients.Config.AppFlag) {
+		appID = clients.Config.AppFlag
+	}
+
+	return activitySearchFunc(ctx, clients, types.ActivityArgs{
+		AppID:          appID,
+		Limit:          searchFlags.limit,
+		MinDateCreated: minDateCreated,
+		MaxDateCreated: searchFlags.maxDateCreated,
+		MinLevel:       searchFlags.minLevel,
+		EventType:      searchFlags.eventType,
+		ComponentType:  searchFlags.componentType,
+		ComponentID:    searchFlags.componentID,
+		Source:         searchFlags.source,
+		TraceID:        searchFlags.traceID,
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivitySearchCommand(t *testing.T) {
+	var searched types.ActivityArgs
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"searches saved logs with the filters of flags": {
+			CmdArgs: []string{"--level", "error", "--trace-id", "Tr001", "--limit", "5"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				cm.Config.AppFlag = "A0123456789"
+				activitySearchFunc = func(ctx context.Context, clients *shared.ClientFactory, args types.ActivityArgs) error {
+					searched = args
+					return nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, types.ActivityArgs{
+					AppID:    "A0123456789",
+					MinLevel: "error",
+					TraceID:  "Tr001",
+					Limit:    5,
+				}, searched)
+			},
+			Teardown: func() {
+				searchFlags = searchCmdFlags{}
+			},
+		},
+		"searches logs created since a duration ago": {
+			CmdArgs: []string{"--since", "2h"},
+			Setup: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+				activitySearchFunc = func(ctx context.Context, clients *shared.ClientFactory, args types.ActivityArgs) error {
+					searched = args
+					return nil
+				}
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Empty(t, searched.AppID)
+				assert.InDelta(t, time.Now().Add(-2*time.Hour).UnixMicro(), searched.MinDateCreated, float64(time.Minute.Microseconds()))
+			},
+			Teardown: func() {
+				searchFlags = searchCmdFlags{}
+			},
+		},
+		"errors if both --since and --min-date-created are used": {
+			CmdArgs:              []string{"--since", "2h", "--min-date-created", "1"},
+			ExpectedErrorStrings: []string{slackerror.ErrMismatchedFlags},
+			Teardown: func() {
+				searchFlags = searchCmdFlags{}
+			},
+		},
+	}, func(cf *shared.ClientFactory) *cobra.Command {
+		cmd := NewActivitySearchCommand(cf)
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+		return cmd
+	})
+}
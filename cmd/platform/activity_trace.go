@@ -0,0 +1,78 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/pkg/platform"
+	"github.com/toughtackle/slack-cli/internal/prompts"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/cobra"
+)
+
+// Create handle to the function for testing
+var activityTraceFunc = platform.ActivityTrace
+
+// NewActivityTraceCommand creates the command to show the execution tree of a
+// trace
+func NewActivityTraceCommand(clients *shared.ClientFactory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trace <trace-id>",
+		Short: "Show the workflow execution tree of a trace",
+		Long: strings.Join([]string{
+			"Show the workflow execution tree of a trace.",
+			"",
+			"Activity logs that share a trace ID are assembled into the workflow, the steps",
+			"of the workflow, and the functions each step calls along with the duration and",
+			"status of each. The step that failed is highlighted.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "platform activity trace Tr0123456789", Meaning: "Show the execution tree of a trace"},
+			{Command: "activity trace Tr0123456789 --output json", Meaning: "Output the execution tree as JSON"},
+		}),
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Verify command is run in a project directory
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runActivityTraceCommand(clients, cmd, args)
+		},
+	}
+}
+
+// runActivityTraceCommand shows the execution tree of the trace argument
+func runActivityTraceCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	selection, err := appSelectPromptFunc(ctx, clients, prompts.ShowInstalledAppsOnly)
+	if err != nil {
+		return err
+	}
+	ctx = config.SetContextToken(ctx, selection.Auth.Token)
+
+	return activityTraceFunc(ctx, clients, types.ActivityArgs{
+		TeamID:   selection.Auth.TeamID,
+		AppID:    selection.App.AppID,
+		Limit:    platform.ActivityLimitDefault,
+		MinLevel: "trace",
+		TraceID:  args[0],
+	})
+}
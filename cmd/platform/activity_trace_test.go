@@ -0,0 +1,62 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/prompts"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityTrace_Command(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory(), func(clients *shared.ClientFactory) {
+		clients.SDKConfig = hooks.NewSDKConfigMock()
+	})
+
+	cmd := NewActivityCommand(clients)
+	cmd.SetArgs([]string{"trace", "Tr001"})
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	var traced types.ActivityArgs
+	activityTraceFunc = func(ctx context.Context, clients *shared.ClientFactory, args types.ActivityArgs) error {
+		traced = args
+		return nil
+	}
+	appSelectMock := prompts.NewAppSelectMock()
+	appSelectPromptFunc = appSelectMock.AppSelectPrompt
+	appSelectMock.On("AppSelectPrompt").Return(prompts.SelectedApp{App: types.App{AppID: "A001"}}, nil)
+
+	err := cmd.ExecuteContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "A001", traced.AppID)
+	assert.Equal(t, "Tr001", traced.TraceID)
+	assert.Equal(t, "trace", traced.MinLevel)
+}
+
+func TestActivityTrace_Args(t *testing.T) {
+	cmd := NewActivityTraceCommand(&shared.ClientFactory{})
+	assert.Error(t, cmd.Args(cmd, []string{}))
+	assert.NoError(t, cmd.Args(cmd, []string{"Tr001"}))
+}
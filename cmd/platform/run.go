@@ -16,12 +16,15 @@ package platform
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/toughtackle/slack-cli/cmd/help"
 	"github.com/toughtackle/slack-cli/cmd/triggers"
 	internalapp "github.com/toughtackle/slack-cli/internal/app"
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/pkg/function"
 	"github.com/toughtackle/slack-cli/internal/pkg/platform"
 	"github.com/toughtackle/slack-cli/internal/prompts"
 	"github.com/toughtackle/slack-cli/internal/shared"
@@ -35,15 +38,23 @@ type runCmdFlags struct {
 	activityLevel       string
 	noActivity          bool
 	cleanup             bool
+	datastoreEmulator   bool
 	hideTriggers        bool
 	orgGrantWorkspaceID string
+	record              string
+	recordSession       string
+	replay              string
 }
 
 var runFlags runCmdFlags
 
+// recordDefault is the directory of recorded fixtures in a project
+var recordDefault = filepath.Join(config.ProjectConfigDirName, function.FixturesDirName)
+
 // Create handle to the function for testing
 // TODO - Stopgap until we learn the correct way to structure our code for testing.
 var runFunc = platform.Run
+var replayFunc = platform.Replay
 var runRunCommandFunc = RunRunCommand
 var runTeamAppSelectPromptFunc = prompts.TeamAppSelectPrompt
 
@@ -57,6 +68,10 @@ func NewRunCommand(clients *shared.ClientFactory) *cobra.Command {
 			{Command: "platform run", Meaning: "Start a local development server"},
 			{Command: "platform run --activity-level debug", Meaning: "Run a local development server with debug activity"},
 			{Command: "platform run --cleanup", Meaning: "Run a local development server with cleanup"},
+			{Command: "platform run --datastore-emulator", Meaning: "Run a local development server with emulated datastores"},
+			{Command: "platform run --record", Meaning: "Run a local development server and record events as fixtures"},
+			{Command: "platform run --record-session session.jsonl", Meaning: "Record socket traffic of a local run to a session file"},
+			{Command: "platform run --replay session.jsonl", Meaning: "Replay a recorded session offline and compare responses"},
 		}),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			// Verify command is run in a project directory
@@ -71,8 +86,13 @@ func NewRunCommand(clients *shared.ClientFactory) *cobra.Command {
 	cmd.Flags().StringVar(&runFlags.activityLevel, "activity-level", platform.ActivityMinLevelDefault, "activity level to display")
 	cmd.Flags().BoolVar(&runFlags.noActivity, "no-activity", false, "hide Slack Platform log activity")
 	cmd.Flags().BoolVar(&runFlags.cleanup, "cleanup", false, "uninstall the local app after exiting")
+	cmd.Flags().BoolVar(&runFlags.datastoreEmulator, "datastore-emulator", false, "store datastore items in a local emulator")
 	cmd.Flags().StringVar(&runFlags.orgGrantWorkspaceID, cmdutil.OrgGrantWorkspaceFlag, "", cmdutil.OrgGrantWorkspaceDescription())
 	cmd.Flags().BoolVar(&runFlags.hideTriggers, "hide-triggers", false, "do not list triggers and skip trigger creation prompts")
+	cmd.Flags().StringVar(&runFlags.record, "record", "", "save incoming events to a directory as fixtures\n  that \"function invoke\" can replay")
+	cmd.Flags().Lookup("record").NoOptDefVal = recordDefault
+	cmd.Flags().StringVar(&runFlags.recordSession, "record-session", "", "save socket envelopes and app responses to a\n  session file")
+	cmd.Flags().StringVar(&runFlags.replay, "replay", "", "replay a session file through the app without\n  connecting to Slack and compare responses")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		style.ToggleStyles(clients.IO.IsTTY() && !clients.Config.NoColor)
@@ -98,6 +118,15 @@ func RunRunCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []str
 	}
 	ctx := cmd.Context()
 
+	// Replay a recorded session offline without selecting an app
+	if runFlags.replay != "" {
+		if runFlags.record != "" || runFlags.recordSession != "" {
+			return slackerror.New(slackerror.ErrMismatchedFlags).
+				WithMessage("The --replay flag cannot be used with flags that record")
+		}
+		return runReplayCommand(clients, cmd)
+	}
+
 	// Get the workspace from the flag or prompt
 	selection, err := runTeamAppSelectPromptFunc(ctx, clients, prompts.ShowLocalOnly, prompts.ShowAllApps)
 	if err != nil {
@@ -140,8 +169,11 @@ func RunRunCommand(clients *shared.ClientFactory, cmd *cobra.Command, args []str
 		App:                 selection.App,
 		Auth:                selection.Auth,
 		Cleanup:             runFlags.cleanup,
+		DatastoreEmulator:   runFlags.datastoreEmulator,
 		ShowTriggers:        triggers.ShowTriggers(clients, runFlags.hideTriggers),
 		OrgGrantWorkspaceID: runFlags.orgGrantWorkspaceID,
+		RecordDir:           runFlags.record,
+		RecordSession:       runFlags.recordSession,
 	}
 
 	log := newRunLogger(clients, cmd)
@@ -166,6 +198,12 @@ func newRunLogger(clients *shared.ClientFactory, cmd *cobra.Command) *logger.Log
 					`Updating local app install for "%s"`,
 					event.DataToString("teamName"),
 				)))
+			case "on_datastore_emulator_started":
+				cmd.Println(style.Secondary(fmt.Sprintf(
+					"Emulating datastores at %s with items saved to %s",
+					event.DataToString("datastoreEmulatorURL"),
+					style.HomePath(event.DataToString("datastoreEmulatorDir")),
+				)))
 			case "on_cloud_run_connection_connected":
 				clients.IO.PrintTrace(ctx, slacktrace.PlatformRunReady)
 				cmd.Println(style.Secondary("Connected, awaiting events"))
@@ -180,9 +218,15 @@ func newRunLogger(clients *shared.ClientFactory, cmd *cobra.Command) *logger.Log
 				clients.IO.PrintError(ctx, "Error: %s", message)
 			case "on_cloud_run_watch_file_change":
 				path := event.DataToString("cloud_run_watch_file_change")
-				cmd.Println(style.Secondary(fmt.Sprintf("File change detected: %s, reinstalling app...", path)))
+				cmd.Println(style.Secondary(fmt.Sprintf("File change detected: %s", path)))
+			case "on_cloud_run_watch_manifest_change":
+				cmd.Println(style.Secondary("App manifest changed, reinstalling app..."))
 			case "on_cloud_run_watch_file_change_reinstalled":
 				cmd.Println(style.Secondary("App successfully reinstalled"))
+			case "on_cloud_run_watch_app_restart":
+				cmd.Println(style.Secondary("Restarting app with the changes"))
+			case "on_cloud_run_watch_code_change":
+				cmd.Println(style.Secondary("App manifest unchanged, changes apply to the next event"))
 			case "on_cleanup_app_install_done":
 				cmd.Println(style.Secondary(fmt.Sprintf(
 					`Cleaned up local app install for "%s".`,
@@ -203,3 +247,49 @@ func newRunLogger(clients *shared.ClientFactory, cmd *cobra.Command) *logger.Log
 		},
 	)
 }
+
+// runReplayCommand replays the envelopes of a session file and outputs how
+// each response compares with the recorded response
+func runReplayCommand(clients *shared.ClientFactory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	log := newRunLogger(clients, cmd)
+	results, err := replayFunc(ctx, clients, log, platform.ReplayArgs{Session: runFlags.replay})
+	if err != nil {
+		return err
+	}
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, results)
+	}
+	differ := 0
+	lines := []string{}
+	for _, result := range results {
+		outcome := "matched"
+		if !result.Matched() {
+			outcome = "differs"
+			differ++
+		}
+		lines = append(lines, fmt.Sprintf(
+			"%s %s %s in %dms (recorded %dms)",
+			result.Type,
+			result.EnvelopeID,
+			outcome,
+			result.LatencyMs,
+			result.RecordedLatencyMs,
+		))
+		for _, line := range result.Diff {
+			lines = append(lines, "  "+line)
+		}
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji: "repeat",
+		Text: fmt.Sprintf(
+			"Replayed %d %s with %d %s",
+			len(results),
+			style.Pluralize("envelope", "envelopes", len(results)),
+			differ,
+			style.Pluralize("different response", "different responses", differ),
+		),
+		Secondary: lines,
+	}))
+	return nil
+}
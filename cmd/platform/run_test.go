@@ -16,6 +16,7 @@ package platform
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
@@ -177,6 +178,51 @@ func TestRunCommand_Flags(t *testing.T) {
 				ShowTriggers: true,
 			},
 		},
+		"Pass the datastore emulator flag to the run arguments": {
+			cmdArgs: []string{"--datastore-emulator"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.App{AppID: "A123", IsDev: true},
+				Auth: types.SlackAuth{TeamID: "T123"},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:          true,
+				ActivityLevel:     "info",
+				Auth:              types.SlackAuth{TeamID: "T123"},
+				App:               types.App{AppID: "A123", IsDev: true},
+				DatastoreEmulator: true,
+				ShowTriggers:      true,
+			},
+		},
+		"Record events to the project fixtures by default": {
+			cmdArgs: []string{"--record"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.App{AppID: "A123", IsDev: true},
+				Auth: types.SlackAuth{TeamID: "T123"},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:      true,
+				ActivityLevel: "info",
+				Auth:          types.SlackAuth{TeamID: "T123"},
+				App:           types.App{AppID: "A123", IsDev: true},
+				ShowTriggers:  true,
+				RecordDir:     filepath.Join(".slack", "fixtures"),
+			},
+		},
+		"Record events to the directory of the record flag": {
+			cmdArgs: []string{"--record", "testdata"},
+			selectedAppAuth: prompts.SelectedApp{
+				App:  types.App{AppID: "A123", IsDev: true},
+				Auth: types.SlackAuth{TeamID: "T123"},
+			},
+			expectedRunArgs: platform.RunArgs{
+				Activity:      true,
+				ActivityLevel: "info",
+				Auth:          types.SlackAuth{TeamID: "T123"},
+				App:           types.App{AppID: "A123", IsDev: true},
+				ShowTriggers:  true,
+				RecordDir:     "testdata",
+			},
+		},
 		"Error if interrupted during app selection": {
 			selectedAppErr: slackerror.New(slackerror.ErrProcessInterrupted),
 			expectedRunArgs: platform.RunArgs{
@@ -264,3 +310,46 @@ func TestRunCommand_Help(t *testing.T) {
 
 	assert.Contains(t, clientsMock.GetStdoutOutput(), "activity level to display (default \"info\")")
 }
+
+func TestRunCommand_Replay(t *testing.T) {
+	var replayed platform.ReplayArgs
+	mockReplay := func(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger, args platform.ReplayArgs) ([]platform.ReplayResult, error) {
+		replayed = args
+		return []platform.ReplayResult{
+			{Type: "events_api", EnvelopeID: "E1", LatencyMs: 4, RecordedLatencyMs: 5, Diff: []string{}},
+			{Type: "events_api", EnvelopeID: "E2", LatencyMs: 6, RecordedLatencyMs: 2, Diff: []string{`- text: "hello"`, `+ text: "bye"`}},
+		}, nil
+	}
+	setup := func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+		replayed = platform.ReplayArgs{}
+		cf.SDKConfig.WorkingDirectory = "."
+		replayFunc = mockReplay
+	}
+	teardown := func() {
+		replayFunc = platform.Replay
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"replays the session without selecting an app": {
+			CmdArgs:  []string{"--replay", "session.jsonl"},
+			Setup:    setup,
+			Teardown: teardown,
+			ExpectedOutputs: []string{
+				"Replayed 2 envelopes with 1 different response",
+				"events_api E1 matched in 4ms (recorded 5ms)",
+				"events_api E2 differs in 6ms (recorded 2ms)",
+				`+ text: "bye"`,
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, "session.jsonl", replayed.Session)
+			},
+		},
+		"errors if the session is replayed while recording": {
+			CmdArgs:              []string{"--replay", "session.jsonl", "--record-session", "next.jsonl"},
+			Setup:                setup,
+			Teardown:             teardown,
+			ExpectedErrorStrings: []string{"The --replay flag cannot be used with flags that record"},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewRunCommand(clients)
+	})
+}
@@ -17,6 +17,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"strings"
@@ -41,6 +42,7 @@ import (
 	"github.com/toughtackle/slack-cli/cmd/triggers"
 	"github.com/toughtackle/slack-cli/cmd/upgrade"
 	versioncmd "github.com/toughtackle/slack-cli/cmd/version"
+	"github.com/toughtackle/slack-cli/cmd/workflow"
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
@@ -172,6 +174,7 @@ func Init(ctx context.Context) (*cobra.Command, *shared.ClientFactory) {
 		triggers.NewCommand(clients),
 		upgrade.NewCommand(clients),
 		versioncmd.NewCommand(clients),
+		workflow.NewCommand(clients),
 	}
 
 	for _, subCommand := range subCommands {
@@ -242,9 +245,15 @@ func InitConfig(ctx context.Context, clients *shared.ClientFactory, rootCmd *cob
 		clients.Config.SystemConfig.SetCustomConfigDirPath(clients.Config.ConfigDirFlag)
 	}
 
-	// Init color and formatting
-	style.ToggleStyles(clients.IO.IsTTY() && !clients.Config.NoColor)
-	style.ToggleSpinner(clients.IO.IsTTY() && !clients.Config.NoColor && !clients.Config.DebugEnabled)
+	// Check the output format before commands write outputs
+	if _, _, err := iostreams.ParseOutputFormat(clients.Config.OutputFlag); err != nil {
+		return err
+	}
+
+	// Init color and formatting, which structured outputs do without
+	structured := clients.IO.IsStructuredOutput()
+	style.ToggleStyles(clients.IO.IsTTY() && !clients.Config.NoColor && !structured)
+	style.ToggleSpinner(clients.IO.IsTTY() && !clients.Config.NoColor && !clients.Config.DebugEnabled && !structured)
 
 	// Find and replace deprecated flags
 	if err := clients.Config.DeprecatedFlagSubstitutions(rootCmd); err != nil {
@@ -409,4 +418,8 @@ func cleanup(ctx context.Context, clients *shared.ClientFactory) {
 	if _, sdkConfigExists := clients.SDKConfig.Exists(); sdkConfigExists {
 		clients.AppClient().CleanUp()
 	}
+	// stop long-lived hook servers started by the hook executor
+	if closer, ok := clients.HookExecutor.(io.Closer); ok {
+		_ = closer.Close()
+	}
 }
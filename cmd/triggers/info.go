@@ -43,6 +43,7 @@ func NewInfoCommand(clients *shared.ClientFactory) *cobra.Command {
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "trigger info --trigger-id Ft01234ABCD", Meaning: "Get details for a specific trigger in a selected workspace"},
 			{Command: "trigger info --trigger-id Ft01234ABCD --app A0123456", Meaning: "Get details for a specific trigger"},
+			{Command: "trigger info --trigger-id Ft01234ABCD --output json", Meaning: "Get details for a specific trigger as JSON"},
 		}),
 		Aliases: []string{"information", "show"},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -94,6 +95,19 @@ func runInfoCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 		return err
 	}
 
+	if clients.IO.IsStructuredOutput() {
+		accessType, entities, err := clients.APIInterface().TriggerPermissionsList(ctx, token, requestedTrigger.ID)
+		if err != nil {
+			return err
+		}
+		output := newTriggerOutput(requestedTrigger)
+		output.Access = &triggerAccessOutput{
+			Type:     string(accessType),
+			Entities: append([]string{}, entities...),
+		}
+		return clients.IO.PrintStructured(ctx, output)
+	}
+
 	cmd.Printf("\n%s", style.Sectionf(style.TextSection{
 		Emoji: "zap",
 		Text:  "Trigger Info",
@@ -48,6 +48,7 @@ func NewListCommand(clients *shared.ClientFactory) *cobra.Command {
 		Example: style.ExampleCommandsf([]style.ExampleCommand{
 			{Command: "trigger list", Meaning: "List details for all existing triggers"},
 			{Command: "trigger list --team T0123456 --app local", Meaning: "List triggers for a specific app"},
+			{Command: "trigger list --limit 100 --output json", Meaning: "List details for up to 100 triggers as JSON"},
 		}),
 		Aliases: []string{"all"},
 		Args:    cobra.NoArgs,
@@ -110,9 +111,33 @@ func runListCommand(cmd *cobra.Command, clients *shared.ClientFactory) error {
 		}
 	}
 
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, newTriggersListOutput(app, triggers, cursor))
+	}
 	return outputTriggersList(ctx, triggers, cmd, clients, app, cursor, listFlags.triggerType)
 }
 
+// triggersListOutput is the structured output of the trigger list command. More
+// triggers exist past the limit if has_more is true.
+type triggersListOutput struct {
+	AppID    string          `json:"app_id"`
+	Triggers []triggerOutput `json:"triggers"`
+	HasMore  bool            `json:"has_more"`
+}
+
+// newTriggersListOutput returns the structured output of listed triggers
+func newTriggersListOutput(app types.App, triggers []types.DeployedTrigger, cursor string) triggersListOutput {
+	output := triggersListOutput{
+		AppID:    app.AppID,
+		Triggers: make([]triggerOutput, 0, len(triggers)),
+		HasMore:  cursor != "",
+	}
+	for _, t := range triggers {
+		output.Triggers = append(output.Triggers, newTriggerOutput(t))
+	}
+	return output
+}
+
 func outputTriggersList(ctx context.Context, triggers []types.DeployedTrigger, cmd *cobra.Command, clients *shared.ClientFactory, app types.App, cursor string, triggerType string) error {
 	var triggersList = []string{}
 
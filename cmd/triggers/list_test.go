@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/api"
 	"github.com/toughtackle/slack-cli/internal/prompts"
@@ -25,6 +26,7 @@ import (
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/test/testutil"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -188,3 +190,41 @@ func setupMockListAppSelection(selectedApp prompts.SelectedApp) func() {
 		listAppSelectPromptFunc = originalPromptFunc
 	}
 }
+
+func TestTriggersListOutput(t *testing.T) {
+	trigger := types.DeployedTrigger{
+		ID:          "Ft001",
+		Name:        "Greet",
+		Type:        "shortcut",
+		DateCreated: 1700000000,
+		DateUpdated: 1700000060,
+		ShortcutURL: "https://slack.com/shortcuts/Ft001",
+		Workflow: types.TriggerWorkflow{
+			ID:         "Wf001",
+			CallbackID: "greeting_workflow",
+			Title:      "Greeting",
+			AppID:      "A001",
+		},
+	}
+	output := newTriggersListOutput(types.App{AppID: "A001"}, []types.DeployedTrigger{trigger}, "cursor")
+	assert.Equal(t, triggersListOutput{
+		AppID: "A001",
+		Triggers: []triggerOutput{
+			{
+				ID:   "Ft001",
+				Name: "Greet",
+				Type: "shortcut",
+				Workflow: triggerWorkflowOutput{
+					ID:         "Wf001",
+					CallbackID: "greeting_workflow",
+					Title:      "Greeting",
+					AppID:      "A001",
+				},
+				ShortcutURL: "https://slack.com/shortcuts/Ft001",
+				DateCreated: time.Unix(1700000000, 0).UTC(),
+				DateUpdated: time.Unix(1700000060, 0).UTC(),
+			},
+		},
+		HasMore: true,
+	}, output)
+}
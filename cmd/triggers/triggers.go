@@ -69,6 +69,54 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 	return cmd
 }
 
+// triggerOutput is the structured output of a trigger
+type triggerOutput struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Type        string                `json:"type"`
+	Description string                `json:"description"`
+	Workflow    triggerWorkflowOutput `json:"workflow"`
+	ShortcutURL string                `json:"shortcut_url,omitempty"`
+	WebhookURL  string                `json:"webhook_url,omitempty"`
+	DateCreated time.Time             `json:"date_created"`
+	DateUpdated time.Time             `json:"date_updated"`
+	Access      *triggerAccessOutput  `json:"access,omitempty"`
+}
+
+// triggerWorkflowOutput is the workflow that a trigger starts
+type triggerWorkflowOutput struct {
+	ID         string `json:"id"`
+	CallbackID string `json:"callback_id"`
+	Title      string `json:"title"`
+	AppID      string `json:"app_id"`
+}
+
+// triggerAccessOutput is who can find and use a trigger
+type triggerAccessOutput struct {
+	Type     string   `json:"type"`
+	Entities []string `json:"entities"`
+}
+
+// newTriggerOutput returns the structured output of a trigger
+func newTriggerOutput(t types.DeployedTrigger) triggerOutput {
+	return triggerOutput{
+		ID:          t.ID,
+		Name:        t.Name,
+		Type:        t.Type,
+		Description: t.Description,
+		Workflow: triggerWorkflowOutput{
+			ID:         t.Workflow.ID,
+			CallbackID: t.Workflow.CallbackID,
+			Title:      t.Workflow.Title,
+			AppID:      t.Workflow.AppID,
+		},
+		ShortcutURL: t.ShortcutURL,
+		WebhookURL:  t.Webhook,
+		DateCreated: time.Unix(int64(t.DateCreated), 0).UTC(),
+		DateUpdated: time.Unix(int64(t.DateUpdated), 0).UTC(),
+	}
+}
+
 // sprintTrigger converts a trigger into a readable format
 func sprintTrigger(ctx context.Context, t types.DeployedTrigger, clients *shared.ClientFactory, singleTriggerInfo bool, app types.App) ([]string, error) {
 	timeFormat := "2006-01-02 15:04:05 Z07:00"
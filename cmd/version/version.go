@@ -66,19 +66,36 @@ func NewCommand(clients *shared.ClientFactory) *cobra.Command {
 				Meaning: "Print version and skip update check",
 				Command: "--version --skip-update",
 			},
+			{
+				Meaning: "Print version number as JSON",
+				Command: "version --output json",
+			},
 		}),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
-			span, _ := opentracing.StartSpanFromContext(ctx, "cmd.version")
+			span, ctx := opentracing.StartSpanFromContext(ctx, "cmd.version")
 			defer span.Finish()
 
+			if clients.IO.IsStructuredOutput() {
+				return clients.IO.PrintStructured(ctx, versionOutput{
+					Name:    cmdutil.GetProcessName(),
+					Version: version.Get(),
+				})
+			}
 			cmd.Println(Template())
+			return nil
 		},
 	}
 
 	return cmd
 }
 
+// versionOutput is the structured output of the version command
+type versionOutput struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
 func Template() string {
 	processName := cmdutil.GetProcessName()
 	version := version.Get()
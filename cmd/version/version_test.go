@@ -15,12 +15,14 @@
 package version
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/test/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVersionCommand(t *testing.T) {
@@ -44,3 +46,19 @@ func TestVersionCommand(t *testing.T) {
 	cmd.Println(output)
 	assert.True(t, testutil.ContainsSemVer(output), "should contain the version number")
 }
+
+func TestVersionCommand_StructuredOutput(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.Config.OutputFlag = "json"
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	cmd := NewCommand(clients)
+	testutil.MockCmdIO(clients.IO, cmd)
+
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var output versionOutput
+	require.NoError(t, json.Unmarshal([]byte(clientsMock.GetStdoutOutput()), &output))
+	assert.NotEmpty(t, output.Name)
+	assert.True(t, testutil.ContainsSemVer(output.Version), "should contain the version number")
+}
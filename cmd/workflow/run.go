@@ -0,0 +1,130 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/cmdutil"
+	"github.com/toughtackle/slack-cli/internal/pkg/workflow"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type runCmdFlags struct {
+	inputs string
+	mocks  string
+}
+
+var runFlags runCmdFlags
+
+// Create handle to the function for testing
+var runWorkflowFunc = workflow.Run
+
+// NewRunCommand implements the "workflow run" command
+func NewRunCommand(clients *shared.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <callback_id> [flags]",
+		Short: "Run a workflow of the app step by step",
+		Long: strings.Join([]string{
+			"Run a workflow from the app manifest on this machine.",
+			"",
+			"Each step that calls a custom function of the app runs through the \"start\" hook",
+			"with a function_executed event. Step inputs can reference workflow inputs with",
+			"{{inputs.name}} and the outputs of earlier steps with {{steps.<id>.name}}.",
+			"",
+			"Steps that call Slack functions or functions of other apps are not run. These",
+			"steps output the values in the --mocks file, which maps a step ID or function",
+			"ID to outputs, or no outputs otherwise.",
+		}, "\n"),
+		Example: style.ExampleCommandsf([]style.ExampleCommand{
+			{Command: "workflow run greeting_workflow", Meaning: "Run a workflow without inputs"},
+			{Command: "workflow run greeting_workflow --inputs inputs.json", Meaning: "Run a workflow with inputs from a file"},
+			{Command: "workflow run greeting_workflow --inputs inputs.json \\\n    --mocks mocks.json", Meaning: "Mock the outputs of Slack function steps"},
+		}),
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.IsValidProjectDirectory(clients)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRunCommand(cmd, clients, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&runFlags.inputs, "inputs", "", "path to a JSON file of workflow inputs")
+	cmd.Flags().StringVar(&runFlags.mocks, "mocks", "", "path to a JSON file of step outputs to mock")
+	return cmd
+}
+
+// runRunCommand runs the workflow and outputs the result of each step
+func runRunCommand(cmd *cobra.Command, clients *shared.ClientFactory, callbackID string) error {
+	ctx := cmd.Context()
+	args := workflow.RunArgs{CallbackID: callbackID}
+	if err := readJSONFlag(clients, "inputs", runFlags.inputs, &args.Inputs); err != nil {
+		return err
+	}
+	if err := readJSONFlag(clients, "mocks", runFlags.mocks, &args.Mocks); err != nil {
+		return err
+	}
+	result, err := runWorkflowFunc(ctx, clients, args)
+	if err != nil {
+		return err
+	}
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, result)
+	}
+	steps := []string{}
+	for _, step := range result.Steps {
+		line := fmt.Sprintf("Step %s %s", step.ID, step.FunctionID)
+		if step.Mocked {
+			line = fmt.Sprintf("%s %s", line, style.Secondary("(mocked)"))
+		}
+		steps = append(steps, line)
+	}
+	outputs, err := json.MarshalIndent(result.Outputs, "", "  ")
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrWorkflowRun)
+	}
+	clients.IO.PrintInfo(ctx, false, "\n%s", style.Sectionf(style.TextSection{
+		Emoji:     "zap",
+		Text:      fmt.Sprintf("Workflow '%s' ran %d %s", result.Workflow, len(result.Steps), style.Pluralize("step", "steps", len(result.Steps))),
+		Secondary: steps,
+	}))
+	clients.IO.PrintInfo(ctx, false, "%s", string(outputs))
+	return nil
+}
+
+// readJSONFlag decodes the JSON file at the path of a flag into value
+func readJSONFlag(clients *shared.ClientFactory, flag string, path string, value interface{}) error {
+	if path == "" {
+		return nil
+	}
+	data, err := afero.ReadFile(clients.Fs, path)
+	if err != nil {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --%s file could not be read", flag).
+			WithRootCause(err)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --%s file is not a valid JSON object", flag).
+			WithRootCause(err)
+	}
+	return nil
+}
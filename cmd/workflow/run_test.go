@@ -0,0 +1,104 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/pkg/workflow"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/test/testutil"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Workflow_Command(t *testing.T) {
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"shows the help page without commands or arguments or flags": {
+			ExpectedStdoutOutputs: []string{
+				"Run a workflow of the app step by step",
+			},
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewCommand(clients)
+	})
+}
+
+func Test_Workflow_RunCommand(t *testing.T) {
+	var received workflow.RunArgs
+	mockRunWorkflow := func(ctx context.Context, clients *shared.ClientFactory, args workflow.RunArgs) (workflow.RunResult, error) {
+		received = args
+		return workflow.RunResult{
+			Workflow: args.CallbackID,
+			Steps: []workflow.StepResult{
+				{ID: "0", FunctionID: "#/functions/greet", Outputs: map[string]interface{}{"greeting": "Hello Ada"}},
+				{ID: "1", FunctionID: "slack#/functions/send_message", Outputs: map[string]interface{}{}, Mocked: true},
+			},
+			Outputs: map[string]interface{}{"greeting": "Hello Ada"},
+		}, nil
+	}
+	setupProject := func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, cf *shared.ClientFactory) {
+		received = workflow.RunArgs{}
+		cf.SDKConfig.WorkingDirectory = "."
+		runWorkflowFunc = mockRunWorkflow
+		_ = afero.WriteFile(cf.Fs, "inputs.json", []byte(`{"name": "Ada"}`), 0600)
+		_ = afero.WriteFile(cf.Fs, "mocks.json", []byte(`{"slack#/functions/send_message": {"message_ts": "1.0001"}}`), 0600)
+		_ = afero.WriteFile(cf.Fs, "broken.json", []byte(`{"name":`), 0600)
+	}
+	teardown := func() {
+		runWorkflowFunc = workflow.Run
+	}
+	testutil.TableTestCommand(t, testutil.CommandTests{
+		"runs the workflow with inputs and mocks from files": {
+			CmdArgs:  []string{"welcome", "--inputs", "inputs.json", "--mocks", "mocks.json"},
+			Setup:    setupProject,
+			Teardown: teardown,
+			ExpectedOutputs: []string{
+				"Workflow 'welcome' ran 2 steps",
+				"Step 0 #/functions/greet",
+				"Step 1 slack#/functions/send_message (mocked)",
+				`"greeting": "Hello Ada"`,
+			},
+			ExpectedAsserts: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) {
+				assert.Equal(t, "welcome", received.CallbackID)
+				assert.Equal(t, map[string]interface{}{"name": "Ada"}, received.Inputs)
+				assert.Equal(t, map[string]map[string]interface{}{
+					"slack#/functions/send_message": {"message_ts": "1.0001"},
+				}, received.Mocks)
+			},
+		},
+		"errors if the inputs file is missing": {
+			CmdArgs:              []string{"welcome", "--inputs", "missing.json"},
+			Setup:                setupProject,
+			Teardown:             teardown,
+			ExpectedErrorStrings: []string{"The --inputs file could not be read"},
+		},
+		"errors if the mocks file is not JSON": {
+			CmdArgs:              []string{"welcome", "--mocks", "broken.json"},
+			Setup:                setupProject,
+			Teardown:             teardown,
+			ExpectedErrorStrings: []string{"The --mocks file is not a valid JSON object"},
+		},
+		"errors if the command is not run in a project": {
+			CmdArgs:       []string{"welcome"},
+			ExpectedError: slackerror.New(slackerror.ErrInvalidAppDirectory),
+		},
+	}, func(clients *shared.ClientFactory) *cobra.Command {
+		return NewRunCommand(clients)
+	})
+}
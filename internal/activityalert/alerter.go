@@ -0,0 +1,170 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activityalert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+)
+
+// webhookTimeout is how long a webhook is given to respond
+const webhookTimeout = 10 * time.Second
+
+// Alerter runs the actions of rules that match activity logs. Actions run in
+// the background so polling for logs is not delayed.
+type Alerter struct {
+	// OnError is called with the failure of an action
+	OnError func(rule string, err error)
+
+	rules  []Rule
+	exec   hooks.ExecInterface
+	client *http.Client
+	output io.Writer
+	now    func() time.Time
+
+	mu     sync.Mutex
+	fired  map[string][]time.Time
+	active sync.WaitGroup
+}
+
+// NewAlerter returns an alerter for the rules that runs commands with exec and
+// writes command output to output
+func NewAlerter(rules Rules, exec hooks.ExecInterface, output io.Writer) *Alerter {
+	return &Alerter{
+		OnError: func(string, error) {},
+		rules:   rules.Rules,
+		exec:    exec,
+		client:  &http.Client{Timeout: webhookTimeout},
+		output:  output,
+		now:     time.Now,
+		fired:   map[string][]time.Time{},
+	}
+}
+
+// Handle starts the actions of each rule that matches the activity and is not
+// held back by debouncing or rate limits. The names of rules that act are
+// returned.
+func (a *Alerter) Handle(ctx context.Context, activity api.Activity) []string {
+	acted := []string{}
+	for _, rule := range a.rules {
+		if !rule.Match.Matches(activity) || !a.allow(rule) {
+			continue
+		}
+		acted = append(acted, rule.Name)
+		a.active.Add(1)
+		go func(rule Rule) {
+			defer a.active.Done()
+			a.act(ctx, rule, activity)
+		}(rule)
+	}
+	return acted
+}
+
+// Wait blocks until started actions finish
+func (a *Alerter) Wait() {
+	a.active.Wait()
+}
+
+// allow records that the rule acts now unless the last action was within the
+// debounce duration or the rate limit is reached
+func (a *Alerter) allow(rule Rule) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	fired := a.fired[rule.Name]
+	if len(fired) > 0 && rule.Debounce > 0 && now.Sub(fired[len(fired)-1]) < time.Duration(rule.Debounce) {
+		return false
+	}
+	if rule.RateLimit != nil {
+		recent := []time.Time{}
+		for _, at := range fired {
+			if now.Sub(at) < time.Duration(rule.RateLimit.Per) {
+				recent = append(recent, at)
+			}
+		}
+		fired = recent
+		if len(fired) >= rule.RateLimit.Count {
+			a.fired[rule.Name] = fired
+			return false
+		}
+	} else if len(fired) > 0 {
+		fired = fired[len(fired)-1:]
+	}
+	a.fired[rule.Name] = append(fired, now)
+	return true
+}
+
+// act runs the command and posts to the webhook of a rule
+func (a *Alerter) act(ctx context.Context, rule Rule, activity api.Activity) {
+	body, err := json.Marshal(activity)
+	if err != nil {
+		a.OnError(rule.Name, err)
+		return
+	}
+	if rule.Command != "" {
+		if err := a.runCommand(rule, activity, body); err != nil {
+			a.OnError(rule.Name, fmt.Errorf("command failed: %w", err))
+		}
+	}
+	if rule.Webhook != "" {
+		if err := a.postWebhook(ctx, rule, body); err != nil {
+			a.OnError(rule.Name, fmt.Errorf("webhook failed: %w", err))
+		}
+	}
+}
+
+// runCommand runs the command of a rule with the activity JSON as input and
+// details of the activity in the environment
+func (a *Alerter) runCommand(rule Rule, activity api.Activity, body []byte) error {
+	env := append(os.Environ(),
+		"SLACK_ACTIVITY_RULE="+rule.Name,
+		"SLACK_ACTIVITY_LEVEL="+string(activity.Level),
+		"SLACK_ACTIVITY_EVENT_TYPE="+string(activity.EventType),
+		"SLACK_ACTIVITY_COMPONENT_ID="+activity.ComponentID,
+		"SLACK_ACTIVITY_TRACE_ID="+activity.TraceID,
+	)
+	cmd := a.exec.Command(env, a.output, a.output, bytes.NewReader(body), rule.Command)
+	return cmd.Run()
+}
+
+// postWebhook sends the activity JSON to the webhook of a rule
+func (a *Alerter) postWebhook(ctx context.Context, rule Rule, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Slack-Activity-Rule", rule.Name)
+	response, err := a.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with status %d", rule.Webhook, response.StatusCode)
+	}
+	return nil
+}
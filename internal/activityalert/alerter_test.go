@@ -0,0 +1,152 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activityalert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExec records the commands that are run
+type fakeExec struct {
+	mu       sync.Mutex
+	commands []string
+	envs     [][]string
+	inputs   []string
+	err      error
+}
+
+func (e *fakeExec) Command(env []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, name string, arg ...string) hooks.ShellCommand {
+	input, _ := io.ReadAll(stdin)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.commands = append(e.commands, name)
+	e.envs = append(e.envs, env)
+	e.inputs = append(e.inputs, string(input))
+	return &hooks.MockCommand{Err: e.err}
+}
+
+var errorActivity = api.Activity{
+	TraceID:     "Tr001",
+	Level:       types.ERROR,
+	EventType:   types.FunctionExecutionResult,
+	ComponentID: "Fn001",
+	Payload:     map[string]interface{}{"error": "request timeout"},
+}
+
+func TestAlerter_Command(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	exec := &fakeExec{}
+	alerter := NewAlerter(Rules{Rules: []Rule{
+		{Name: "errors", Match: Match{Level: types.ERROR}, Command: "./page.sh"},
+		{Name: "deploys", Match: Match{EventType: types.FunctionDeployment}, Command: "./deployed.sh"},
+	}}, exec, &bytes.Buffer{})
+
+	acted := alerter.Handle(ctx, errorActivity)
+	alerter.Wait()
+	assert.Equal(t, []string{"errors"}, acted)
+	require.Equal(t, []string{"./page.sh"}, exec.commands)
+	assert.Contains(t, exec.envs[0], "SLACK_ACTIVITY_RULE=errors")
+	assert.Contains(t, exec.envs[0], "SLACK_ACTIVITY_TRACE_ID=Tr001")
+	var input api.Activity
+	require.NoError(t, json.Unmarshal([]byte(exec.inputs[0]), &input))
+	assert.Equal(t, "Fn001", input.ComponentID)
+}
+
+func TestAlerter_CommandError(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	exec := &fakeExec{err: errors.New("exit status 1")}
+	alerter := NewAlerter(Rules{Rules: []Rule{
+		{Name: "errors", Match: Match{Level: types.ERROR}, Command: "./page.sh"},
+	}}, exec, &bytes.Buffer{})
+	failures := []string{}
+	alerter.OnError = func(rule string, err error) {
+		failures = append(failures, rule+": "+err.Error())
+	}
+
+	alerter.Handle(ctx, errorActivity)
+	alerter.Wait()
+	assert.Equal(t, []string{"errors: command failed: exit status 1"}, failures)
+}
+
+func TestAlerter_Webhook(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	received := make(chan api.Activity, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "errors", r.Header.Get("X-Slack-Activity-Rule"))
+		var activity api.Activity
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&activity))
+		received <- activity
+	}))
+	defer server.Close()
+
+	alerter := NewAlerter(Rules{Rules: []Rule{
+		{Name: "errors", Match: Match{Payload: map[string]string{"error": "timeout"}}, Webhook: server.URL},
+	}}, &fakeExec{}, &bytes.Buffer{})
+	alerter.Handle(ctx, errorActivity)
+	alerter.Wait()
+	activity := <-received
+	assert.Equal(t, "Tr001", activity.TraceID)
+}
+
+func TestAlerter_Debounce(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	now := time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)
+	alerter := NewAlerter(Rules{Rules: []Rule{
+		{Name: "errors", Match: Match{Level: types.ERROR}, Command: "./page.sh", Debounce: Duration(time.Minute)},
+	}}, &fakeExec{}, &bytes.Buffer{})
+	alerter.now = func() time.Time { return now }
+
+	assert.Len(t, alerter.Handle(ctx, errorActivity), 1)
+	now = now.Add(30 * time.Second)
+	assert.Empty(t, alerter.Handle(ctx, errorActivity), "matches within the debounce are held back")
+	now = now.Add(31 * time.Second)
+	assert.Len(t, alerter.Handle(ctx, errorActivity), 1)
+	alerter.Wait()
+}
+
+func TestAlerter_RateLimit(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	now := time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)
+	alerter := NewAlerter(Rules{Rules: []Rule{
+		{Name: "errors", Match: Match{Level: types.ERROR}, Command: "./page.sh", RateLimit: &RateLimit{Count: 2, Per: Duration(time.Minute)}},
+	}}, &fakeExec{}, &bytes.Buffer{})
+	alerter.now = func() time.Time { return now }
+
+	acted := 0
+	for i := 0; i < 5; i++ {
+		acted += len(alerter.Handle(ctx, errorActivity))
+		now = now.Add(time.Second)
+	}
+	assert.Equal(t, 2, acted)
+	now = now.Add(time.Minute)
+	assert.Len(t, alerter.Handle(ctx, errorActivity), 1, "the limit resets after the period")
+	alerter.Wait()
+}
@@ -0,0 +1,215 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package activityalert reacts to app activity logs that match rules from a
+// project file by running a command or posting the log to a local URL.
+//
+// Rules are written in JSON:
+//
+//	{
+//	  "rules": [
+//	    {
+//	      "name": "function-errors",
+//	      "match": {
+//	        "level": "error",
+//	        "event_type": "function_execution_result",
+//	        "payload": { "error": "timeout" }
+//	      },
+//	      "command": "./scripts/page-oncall.sh",
+//	      "webhook": "http://localhost:9000/alerts",
+//	      "debounce": "30s",
+//	      "rate_limit": { "count": 5, "per": "10m" }
+//	    }
+//	  ]
+//	}
+//
+// The level of a match is the minimum level of a log and payload values match
+// fields that contain the value. An empty payload value matches any log with
+// the field.
+package activityalert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+)
+
+// Filename is the name of the rules file in the project config directory
+const Filename = "alerts.json"
+
+// Rules is the content of the rules file
+type Rules struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule describes the logs to react to and the actions to take
+type Rule struct {
+	Name      string     `json:"name"`
+	Match     Match      `json:"match"`
+	Command   string     `json:"command,omitempty"`
+	Webhook   string     `json:"webhook,omitempty"`
+	Debounce  Duration   `json:"debounce,omitempty"`
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+}
+
+// Match selects logs by value. Empty values match every log.
+type Match struct {
+	Level       types.ActivityLevel `json:"level,omitempty"`
+	EventType   types.EventType     `json:"event_type,omitempty"`
+	ComponentID string              `json:"component_id,omitempty"`
+	Payload     map[string]string   `json:"payload,omitempty"`
+}
+
+// RateLimit is the greatest number of times a rule acts within a period
+type RateLimit struct {
+	Count int      `json:"count"`
+	Per   Duration `json:"per"`
+}
+
+// Duration is a time.Duration written as text such as "30s" or "5m"
+type Duration time.Duration
+
+// UnmarshalJSON decodes a duration from text
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("a duration must be text such as \"30s\" or \"5m\"")
+	}
+	parsed, err := time.ParseDuration(text)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON encodes a duration as text
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadRules reads and checks the rules file at path
+func LoadRules(fs afero.Fs, path string) (Rules, error) {
+	data, err := afero.ReadFile(fs, path)
+	if os.IsNotExist(err) {
+		return Rules{}, slackerror.New(slackerror.ErrActivityAlertRules).
+			WithMessage("No activity alert rules were found at \"%s\"", path).
+			WithRemediation("Write rules to the file or pass the path of a rules file to --alerts")
+	}
+	if err != nil {
+		return Rules{}, slackerror.Wrap(err, slackerror.ErrActivityAlertRules)
+	}
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return Rules{}, slackerror.New(slackerror.ErrActivityAlertRules).
+			WithMessage("The activity alert rules at \"%s\" are not valid JSON", path).
+			WithRootCause(err)
+	}
+	if err := rules.validate(); err != nil {
+		return Rules{}, err
+	}
+	return rules, nil
+}
+
+// validate checks that every rule can act and matches known levels
+func (r Rules) validate() error {
+	if len(r.Rules) == 0 {
+		return ruleError("", "At least one rule must be defined")
+	}
+	names := map[string]bool{}
+	for i, rule := range r.Rules {
+		if strings.TrimSpace(rule.Name) == "" {
+			return ruleError(fmt.Sprintf("%d", i+1), "Each rule needs a name")
+		}
+		if names[rule.Name] {
+			return ruleError(rule.Name, "Rule names must be unique")
+		}
+		names[rule.Name] = true
+		if rule.Command == "" && rule.Webhook == "" {
+			return ruleError(rule.Name, "A rule needs a command, a webhook, or both")
+		}
+		if rule.Match.Level != "" && !rule.Match.Level.IsValid() {
+			return ruleError(rule.Name, fmt.Sprintf("The level \"%s\" is not one of trace, debug, info, warn, error, or fatal", rule.Match.Level))
+		}
+		if problem := webhookProblem(rule.Webhook); problem != "" {
+			return ruleError(rule.Name, problem)
+		}
+		if rule.Debounce < 0 {
+			return ruleError(rule.Name, "The debounce duration cannot be negative")
+		}
+		if rule.RateLimit != nil && (rule.RateLimit.Count <= 0 || rule.RateLimit.Per <= 0) {
+			return ruleError(rule.Name, "A rate limit needs a count and period greater than zero")
+		}
+	}
+	return nil
+}
+
+// webhookProblem describes why a webhook cannot be used or is empty if the
+// webhook posts to this machine
+func webhookProblem(webhook string) string {
+	if webhook == "" {
+		return ""
+	}
+	parsed, err := url.Parse(webhook)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Sprintf("The webhook \"%s\" must be an http or https URL", webhook)
+	}
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return ""
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return ""
+	}
+	return fmt.Sprintf("The webhook \"%s\" must post to localhost", webhook)
+}
+
+func ruleError(name string, remediation string) error {
+	err := slackerror.New(slackerror.ErrActivityAlertRules)
+	if name != "" {
+		err = err.WithMessage("The activity alert rule \"%s\" is not valid", name)
+	}
+	return err.WithRemediation("%s", remediation)
+}
+
+// Matches returns true if the activity is selected by the match
+func (m Match) Matches(activity api.Activity) bool {
+	switch {
+	case m.Level != "" && !activity.Level.AtLeast(m.Level):
+		return false
+	case m.EventType != "" && activity.EventType != m.EventType:
+		return false
+	case m.ComponentID != "" && activity.ComponentID != m.ComponentID:
+		return false
+	}
+	for field, expected := range m.Payload {
+		value, ok := activity.Payload[field]
+		if !ok || value == nil {
+			return false
+		}
+		if expected != "" && !strings.Contains(fmt.Sprint(value), expected) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activityalert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRules(t *testing.T) {
+	tests := map[string]struct {
+		content             string
+		expectedRules       Rules
+		expectedRemediation string
+	}{
+		"loads rules with durations": {
+			content: `{"rules": [{"name": "errors", "match": {"level": "error", "payload": {"error": ""}}, "command": "./page.sh", "debounce": "30s", "rate_limit": {"count": 3, "per": "5m"}}]}`,
+			expectedRules: Rules{Rules: []Rule{
+				{
+					Name:      "errors",
+					Match:     Match{Level: types.ERROR, Payload: map[string]string{"error": ""}},
+					Command:   "./page.sh",
+					Debounce:  Duration(30 * time.Second),
+					RateLimit: &RateLimit{Count: 3, Per: Duration(5 * time.Minute)},
+				},
+			}},
+		},
+		"errors without rules": {
+			content:             `{"rules": []}`,
+			expectedRemediation: "At least one rule must be defined",
+		},
+		"errors without an action": {
+			content:             `{"rules": [{"name": "errors"}]}`,
+			expectedRemediation: "A rule needs a command, a webhook, or both",
+		},
+		"errors with duplicate names": {
+			content:             `{"rules": [{"name": "errors", "command": "a"}, {"name": "errors", "command": "b"}]}`,
+			expectedRemediation: "Rule names must be unique",
+		},
+		"errors with an unknown level": {
+			content:             `{"rules": [{"name": "errors", "match": {"level": "loud"}, "command": "a"}]}`,
+			expectedRemediation: "The level \"loud\" is not one of trace, debug, info, warn, error, or fatal",
+		},
+		"errors with a webhook that is not local": {
+			content:             `{"rules": [{"name": "errors", "webhook": "https://example.com/hook"}]}`,
+			expectedRemediation: "The webhook \"https://example.com/hook\" must post to localhost",
+		},
+		"errors with an incomplete rate limit": {
+			content:             `{"rules": [{"name": "errors", "command": "a", "rate_limit": {"count": 1}}]}`,
+			expectedRemediation: "A rate limit needs a count and period greater than zero",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, Filename, []byte(tt.content), 0o644))
+			rules, err := LoadRules(fs, Filename)
+			if tt.expectedRemediation != "" {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrActivityAlertRules, slackerror.ToSlackError(err).Code)
+				assert.Equal(t, tt.expectedRemediation, slackerror.ToSlackError(err).Remediation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRules, rules)
+		})
+	}
+}
+
+func TestLoadRules_Missing(t *testing.T) {
+	_, err := LoadRules(afero.NewMemMapFs(), Filename)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No activity alert rules were found")
+}
+
+func TestMatch_Matches(t *testing.T) {
+	activity := api.Activity{
+		Level:       types.FATAL,
+		EventType:   types.FunctionExecutionResult,
+		ComponentID: "Fn001",
+		Payload:     map[string]interface{}{"error": "request timeout", "function_name": "greet"},
+	}
+	tests := map[string]struct {
+		match    Match
+		expected bool
+	}{
+		"matches everything when empty":          {match: Match{}, expected: true},
+		"matches levels at or above the minimum": {match: Match{Level: types.ERROR}, expected: true},
+		"matches levels at the minimum":          {match: Match{Level: types.FATAL}, expected: true},
+		"matches the event type":                 {match: Match{EventType: types.FunctionExecutionResult}, expected: true},
+		"skips other event types":                {match: Match{EventType: types.FunctionExecutionStarted}, expected: false},
+		"skips other components":                 {match: Match{ComponentID: "Fn002"}, expected: false},
+		"matches payload text":                   {match: Match{Payload: map[string]string{"error": "timeout"}}, expected: true},
+		"matches payload fields that exist":      {match: Match{Payload: map[string]string{"function_name": ""}}, expected: true},
+		"skips missing payload fields":           {match: Match{Payload: map[string]string{"reason": ""}}, expected: false},
+		"skips payload text that differs":        {match: Match{Payload: map[string]string{"error": "quota"}}, expected: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.match.Matches(activity))
+		})
+	}
+	assert.False(t, Match{Level: types.ERROR}.Matches(api.Activity{Level: types.WARN}))
+}
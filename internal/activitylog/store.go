@@ -0,0 +1,378 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package activitylog saves app activity logs to local files so logs can be
+// searched after the platform no longer keeps them.
+//
+// Logs are appended to JSONL segment files that rotate once a segment reaches
+// a size limit. An index of the time range of each segment is kept beside the
+// segments so searches only read segments that overlap the requested range.
+package activitylog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+)
+
+// DirName is the directory within the project config directory that activity
+// logs are saved to
+const DirName = "activity"
+
+const (
+	// MaxSegmentBytesDefault is the size a segment grows to before a new
+	// segment is started
+	MaxSegmentBytesDefault = 5 * 1024 * 1024
+	// MaxSegmentsDefault is the number of segments kept before the oldest is
+	// removed
+	MaxSegmentsDefault = 10
+
+	indexFilename = "index.json"
+)
+
+// Record is a saved activity log and the app it belongs to
+type Record struct {
+	AppID string `json:"app_id"`
+	api.Activity
+}
+
+// Segment describes a file of saved records
+type Segment struct {
+	Name         string `json:"name"`
+	Count        int    `json:"count"`
+	Bytes        int64  `json:"bytes"`
+	FirstCreated int64  `json:"first_created"`
+	LastCreated  int64  `json:"last_created"`
+}
+
+// overlaps returns true if records of the segment might be within the range.
+// A zero bound is open.
+func (s Segment) overlaps(minCreated int64, maxCreated int64) bool {
+	if s.Count == 0 {
+		return false
+	}
+	if minCreated != 0 && s.LastCreated < minCreated {
+		return false
+	}
+	if maxCreated != 0 && s.FirstCreated > maxCreated {
+		return false
+	}
+	return true
+}
+
+// index lists segments from oldest to newest
+type index struct {
+	Sequence int       `json:"sequence"`
+	Segments []Segment `json:"segments"`
+}
+
+// Store saves activity logs to rotating segment files in a directory
+type Store struct {
+	// MaxSegmentBytes is the size a segment grows to before rotating
+	MaxSegmentBytes int64
+	// MaxSegments is the number of segments that are kept
+	MaxSegments int
+
+	fs  afero.Fs
+	dir string
+}
+
+// NewStore returns a store of activity logs in dir
+func NewStore(fs afero.Fs, dir string) *Store {
+	return &Store{
+		MaxSegmentBytes: MaxSegmentBytesDefault,
+		MaxSegments:     MaxSegmentsDefault,
+		fs:              fs,
+		dir:             dir,
+	}
+}
+
+// Dir returns the directory of saved activity logs
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Append saves activity logs of an app that are not already saved and returns
+// the number of logs that were saved
+func (s *Store) Append(appID string, activities []api.Activity) (int, error) {
+	if len(activities) == 0 {
+		return 0, nil
+	}
+	idx, err := s.readIndex()
+	if err != nil {
+		return 0, err
+	}
+	ordered := append([]api.Activity{}, activities...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Created < ordered[j].Created
+	})
+	saved, err := s.savedKeys(idx, ordered[0].Created, ordered[len(ordered)-1].Created)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.fs.MkdirAll(s.dir, 0o755); err != nil {
+		return 0, slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	count := 0
+	for _, activity := range ordered {
+		record := Record{AppID: appID, Activity: activity}
+		key := recordKey(record)
+		if saved[key] {
+			continue
+		}
+		saved[key] = true
+		line, err := json.Marshal(record)
+		if err != nil {
+			return count, slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+		}
+		line = append(line, '\n')
+		segment := s.activeSegment(&idx, int64(len(line)))
+		if err := s.appendLine(segment.Name, line); err != nil {
+			return count, err
+		}
+		if segment.Count == 0 || activity.Created < segment.FirstCreated {
+			segment.FirstCreated = activity.Created
+		}
+		if activity.Created > segment.LastCreated {
+			segment.LastCreated = activity.Created
+		}
+		segment.Count++
+		segment.Bytes += int64(len(line))
+		count++
+	}
+	if err := s.rotate(&idx); err != nil {
+		return count, err
+	}
+	return count, s.writeIndex(idx)
+}
+
+// activeSegment returns the newest segment or starts a new segment if the
+// line would grow the newest past the size limit
+func (s *Store) activeSegment(idx *index, size int64) *Segment {
+	if len(idx.Segments) > 0 {
+		newest := &idx.Segments[len(idx.Segments)-1]
+		if newest.Count == 0 || newest.Bytes+size <= s.MaxSegmentBytes {
+			return newest
+		}
+	}
+	idx.Sequence++
+	idx.Segments = append(idx.Segments, Segment{
+		Name: fmt.Sprintf("activity-%06d.jsonl", idx.Sequence),
+	})
+	return &idx.Segments[len(idx.Segments)-1]
+}
+
+// rotate removes the oldest segments past the segment limit
+func (s *Store) rotate(idx *index) error {
+	if s.MaxSegments <= 0 || len(idx.Segments) <= s.MaxSegments {
+		return nil
+	}
+	removed := idx.Segments[:len(idx.Segments)-s.MaxSegments]
+	for _, segment := range removed {
+		err := s.fs.Remove(filepath.Join(s.dir, segment.Name))
+		if err != nil && !os.IsNotExist(err) {
+			return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+		}
+	}
+	idx.Segments = append([]Segment{}, idx.Segments[len(removed):]...)
+	return nil
+}
+
+// appendLine writes a line to the end of a segment file
+func (s *Store) appendLine(name string, line []byte) error {
+	file, err := s.fs.OpenFile(filepath.Join(s.dir, name), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	defer file.Close()
+	if _, err := file.Write(line); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	return nil
+}
+
+// savedKeys returns the keys of records saved in segments that overlap the
+// time range
+func (s *Store) savedKeys(idx index, minCreated int64, maxCreated int64) (map[string]bool, error) {
+	keys := map[string]bool{}
+	for _, segment := range idx.Segments {
+		if !segment.overlaps(minCreated, maxCreated) {
+			continue
+		}
+		err := s.readSegment(segment.Name, func(record Record) bool {
+			keys[recordKey(record)] = true
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// recordKey identifies a record to avoid saving the same log twice
+func recordKey(record Record) string {
+	payload, _ := json.Marshal(record.Payload)
+	return fmt.Sprintf("%s|%d|%s|%s|%s|%s|%s", record.AppID, record.Created, record.TraceID, record.EventType, record.ComponentID, record.Level, payload)
+}
+
+// readSegment calls visit with each record of a segment until visit returns
+// false. Lines that cannot be decoded are skipped.
+func (s *Store) readSegment(name string, visit func(Record) bool) error {
+	file, err := s.fs.Open(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			continue
+		}
+		if !visit(record) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	return nil
+}
+
+// readIndex loads the index of segments or an empty index if none is saved
+func (s *Store) readIndex() (index, error) {
+	data, err := afero.ReadFile(s.fs, filepath.Join(s.dir, indexFilename))
+	switch {
+	case os.IsNotExist(err):
+		return index{Segments: []Segment{}}, nil
+	case err != nil:
+		return index{}, slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	var idx index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return index{}, slackerror.New(slackerror.ErrActivityLogStore).
+			WithMessage("The index of saved activity logs is not valid").
+			WithRemediation("Remove the \"%s\" directory to start saving logs again", s.dir).
+			WithRootCause(err)
+	}
+	return idx, nil
+}
+
+// writeIndex saves the index of segments
+func (s *Store) writeIndex(idx index) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	if err := afero.WriteFile(s.fs, filepath.Join(s.dir, indexFilename), data, 0o644); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrActivityLogStore)
+	}
+	return nil
+}
+
+// Filter selects saved records. Empty values match every record.
+type Filter struct {
+	AppID         string
+	MinLevel      types.ActivityLevel
+	EventType     string
+	ComponentType string
+	ComponentID   string
+	Source        string
+	TraceID       string
+	// MinCreated and MaxCreated are unix timestamps in microseconds
+	MinCreated int64
+	MaxCreated int64
+	// Limit is the greatest number of the newest matches returned
+	Limit int
+}
+
+// matches returns true if the record is selected by the filter
+func (f Filter) matches(record Record) bool {
+	switch {
+	case f.AppID != "" && record.AppID != f.AppID:
+		return false
+	case f.MinLevel != "" && !record.Level.AtLeast(f.MinLevel):
+		return false
+	case f.EventType != "" && string(record.EventType) != f.EventType:
+		return false
+	case f.ComponentType != "" && record.ComponentType != f.ComponentType:
+		return false
+	case f.ComponentID != "" && record.ComponentID != f.ComponentID:
+		return false
+	case f.Source != "" && record.Source != f.Source:
+		return false
+	case f.TraceID != "" && record.TraceID != f.TraceID:
+		return false
+	case f.MinCreated != 0 && record.Created < f.MinCreated:
+		return false
+	case f.MaxCreated != 0 && record.Created > f.MaxCreated:
+		return false
+	}
+	return true
+}
+
+// Search returns saved records that match the filter from oldest to newest
+func (s *Store) Search(filter Filter) ([]Record, error) {
+	if filter.MinLevel != "" {
+		if !filter.MinLevel.IsValid() {
+			return nil, slackerror.New(slackerror.ErrInvalidFlag).
+				WithMessage("The log level \"%s\" is not known", filter.MinLevel).
+				WithRemediation("Use a level of trace, debug, info, warn, error, or fatal")
+		}
+	}
+	idx, err := s.readIndex()
+	if err != nil {
+		return nil, err
+	}
+	records := []Record{}
+	for _, segment := range idx.Segments {
+		if !segment.overlaps(filter.MinCreated, filter.MaxCreated) {
+			continue
+		}
+		err := s.readSegment(segment.Name, func(record Record) bool {
+			if filter.matches(record) {
+				records = append(records, record)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Created < records[j].Created
+	})
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[len(records)-filter.Limit:]
+	}
+	return records, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activitylog
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockActivities() []api.Activity {
+	return []api.Activity{
+		{TraceID: "Tr001", Level: types.INFO, EventType: types.FunctionExecutionStarted, ComponentType: "function", ComponentID: "Fn001", Source: "slack", Created: 1000},
+		{TraceID: "Tr001", Level: types.ERROR, EventType: types.FunctionExecutionResult, ComponentType: "function", ComponentID: "Fn001", Source: "slack", Created: 2000},
+		{TraceID: "Tr002", Level: types.DEBUG, EventType: types.FunctionExecutionOutput, ComponentType: "function", ComponentID: "Fn002", Source: "developer", Created: 3000},
+		{TraceID: "Tr003", Level: types.WARN, EventType: types.WorkflowExecutionResult, ComponentType: "workflow", ComponentID: "Wf001", Source: "slack", Created: 4000},
+	}
+}
+
+func TestStore_Append(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, filepath.Join("project", ".slack", DirName))
+
+	count, err := store.Append("A001", mockActivities())
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	count, err = store.Append("A001", mockActivities()[2:])
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "logs that are saved are not saved again")
+
+	count, err = store.Append("A002", mockActivities()[:1])
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "logs of another app are saved")
+
+	records, err := store.Search(Filter{})
+	require.NoError(t, err)
+	assert.Len(t, records, 5)
+	exists, err := afero.Exists(fs, filepath.Join(store.Dir(), "activity-000001.jsonl"))
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestStore_Rotation(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, DirName)
+	store.MaxSegmentBytes = 400
+	store.MaxSegments = 2
+
+	for i := 1; i <= 12; i++ {
+		_, err := store.Append("A001", []api.Activity{
+			{TraceID: fmt.Sprintf("Tr%03d", i), Level: types.INFO, EventType: types.FunctionExecutionStarted, Created: int64(i)},
+		})
+		require.NoError(t, err)
+	}
+	idx, err := store.readIndex()
+	require.NoError(t, err)
+	require.Len(t, idx.Segments, 2)
+	for _, segment := range idx.Segments {
+		assert.LessOrEqual(t, segment.Bytes, int64(400))
+	}
+	exists, err := afero.Exists(fs, filepath.Join(DirName, "activity-000001.jsonl"))
+	require.NoError(t, err)
+	assert.False(t, exists, "the oldest segment is removed")
+
+	records, err := store.Search(Filter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+	assert.Equal(t, "Tr012", records[len(records)-1].TraceID)
+	assert.Greater(t, records[0].Created, int64(1))
+}
+
+func TestStore_Search(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, DirName)
+	_, err := store.Append("A001", mockActivities())
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		filter   Filter
+		expected []string
+	}{
+		"matches every log without a filter": {
+			filter:   Filter{},
+			expected: []string{"Tr001", "Tr001", "Tr002", "Tr003"},
+		},
+		"matches logs at or above the minimum level": {
+			filter:   Filter{MinLevel: types.WARN},
+			expected: []string{"Tr001", "Tr003"},
+		},
+		"matches logs of an event type": {
+			filter:   Filter{EventType: string(types.FunctionExecutionOutput)},
+			expected: []string{"Tr002"},
+		},
+		"matches logs of a component": {
+			filter:   Filter{ComponentType: "function", ComponentID: "Fn001"},
+			expected: []string{"Tr001", "Tr001"},
+		},
+		"matches logs of a trace": {
+			filter:   Filter{TraceID: "Tr003"},
+			expected: []string{"Tr003"},
+		},
+		"matches logs of a source": {
+			filter:   Filter{Source: "developer"},
+			expected: []string{"Tr002"},
+		},
+		"matches logs within a time range": {
+			filter:   Filter{MinCreated: 2000, MaxCreated: 3000},
+			expected: []string{"Tr001", "Tr002"},
+		},
+		"matches the newest logs within the limit": {
+			filter:   Filter{Limit: 2},
+			expected: []string{"Tr002", "Tr003"},
+		},
+		"matches nothing for another app": {
+			filter:   Filter{AppID: "A999"},
+			expected: []string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			records, err := store.Search(tt.filter)
+			require.NoError(t, err)
+			traces := []string{}
+			for _, record := range records {
+				traces = append(traces, record.TraceID)
+			}
+			assert.Equal(t, tt.expected, traces)
+		})
+	}
+}
+
+func TestStore_SearchErrors(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, DirName)
+
+	records, err := store.Search(Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, records, "an empty store has no logs")
+
+	_, err = store.Search(Filter{MinLevel: "loud"})
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(DirName, indexFilename), []byte("{"), 0o644))
+	_, err = store.Search(Filter{})
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrActivityLogStore, slackerror.ToSlackError(err).Code)
+}
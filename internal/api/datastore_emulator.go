@@ -0,0 +1,627 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/datastore/expression"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+// datastoreEmulatorQueryLimit is the default number of items returned from a
+// query when no limit is provided, matching the Slack API
+const datastoreEmulatorQueryLimit = 100
+
+// DatastoreEmulator is a local, file-backed stand-in for the datastore methods
+// of the Slack API. Items are saved as JSON with one file per datastore and are
+// validated against the datastore definitions of the app manifest.
+//
+// Every other method of the APIInterface is passed to the wrapped client.
+type DatastoreEmulator struct {
+	APIInterface
+
+	datastores map[string]types.ManifestDatastore
+	dir        string
+	fs         afero.Fs
+	mu         sync.Mutex
+
+	// now returns the current time and can be mocked to test expired items
+	now func() time.Time
+}
+
+// NewDatastoreEmulator creates an emulator that stores items for the provided
+// datastores within the dir directory
+func NewDatastoreEmulator(client APIInterface, fs afero.Fs, dir string, datastores map[string]types.ManifestDatastore) *DatastoreEmulator {
+	return &DatastoreEmulator{
+		APIInterface: client,
+		datastores:   datastores,
+		dir:          dir,
+		fs:           fs,
+		now:          time.Now,
+	}
+}
+
+// Dir returns the directory that emulated datastore files are saved to
+func (e *DatastoreEmulator) Dir() string {
+	return e.dir
+}
+
+func (e *DatastoreEmulator) AppsDatastorePut(ctx context.Context, token string, request types.AppDatastorePut) (types.AppDatastorePutResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastorePutMethod)
+	if err != nil {
+		return types.AppDatastorePutResult{}, err
+	}
+	if detail, ok := validateEmulatedItem(datastore, request.Item); !ok {
+		return types.AppDatastorePutResult{}, slackerror.NewAPIError(slackerror.ErrInvalidArguments, "", slackerror.ErrorDetails{detail}, appDatastorePutMethod)
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastorePutResult{}, err
+	}
+	items[emulatedItemID(datastore, request.Item)] = request.Item
+	if err := e.save(request.Datastore, items); err != nil {
+		return types.AppDatastorePutResult{}, err
+	}
+	return types.AppDatastorePutResult{
+		Datastore: request.Datastore,
+		Item:      request.Item,
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreBulkPut(ctx context.Context, token string, request types.AppDatastoreBulkPut) (types.AppDatastoreBulkPutResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastoreBulkPutMethod)
+	if err != nil {
+		return types.AppDatastoreBulkPutResult{}, err
+	}
+	var details slackerror.ErrorDetails
+	for _, item := range request.Items {
+		if detail, ok := validateEmulatedItem(datastore, item); !ok {
+			details = append(details, detail)
+		}
+	}
+	if len(details) > 0 {
+		return types.AppDatastoreBulkPutResult{}, slackerror.NewAPIError(slackerror.ErrInvalidArguments, "", details, appDatastoreBulkPutMethod)
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreBulkPutResult{}, err
+	}
+	for _, item := range request.Items {
+		items[emulatedItemID(datastore, item)] = item
+	}
+	if err := e.save(request.Datastore, items); err != nil {
+		return types.AppDatastoreBulkPutResult{}, err
+	}
+	return types.AppDatastoreBulkPutResult{
+		Datastore: request.Datastore,
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreUpdate(ctx context.Context, token string, request types.AppDatastoreUpdate) (types.AppDatastoreUpdateResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastoreUpdateMethod)
+	if err != nil {
+		return types.AppDatastoreUpdateResult{}, err
+	}
+	if detail, ok := validateEmulatedItem(datastore, request.Item); !ok {
+		return types.AppDatastoreUpdateResult{}, slackerror.NewAPIError(slackerror.ErrInvalidArguments, "", slackerror.ErrorDetails{detail}, appDatastoreUpdateMethod)
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreUpdateResult{}, err
+	}
+	// Updates merge attributes into an existing item or create a new item
+	id := emulatedItemID(datastore, request.Item)
+	item, exists := items[id]
+	if !exists {
+		item = map[string]interface{}{}
+	}
+	for attribute, value := range request.Item {
+		item[attribute] = value
+	}
+	items[id] = item
+	if err := e.save(request.Datastore, items); err != nil {
+		return types.AppDatastoreUpdateResult{}, err
+	}
+	return types.AppDatastoreUpdateResult{
+		Datastore: request.Datastore,
+		Item:      item,
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreQuery(ctx context.Context, token string, query types.AppDatastoreQuery) (types.AppDatastoreQueryResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(query.Datastore, appDatastoreQueryMethod)
+	if err != nil {
+		return types.AppDatastoreQueryResult{}, err
+	}
+	items, err := e.load(query.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreQueryResult{}, err
+	}
+	matches, err := filterEmulatedItems(items, datastore, expression.Query{
+		Expression: query.Expression,
+		Attributes: query.ExpressionAttributes,
+		Values:     query.ExpressionValues,
+	}, appDatastoreQueryMethod)
+	if err != nil {
+		return types.AppDatastoreQueryResult{}, err
+	}
+
+	// Pages begin after the item identified by the cursor
+	start := 0
+	if query.Cursor != "" {
+		after, err := base64.RawURLEncoding.DecodeString(query.Cursor)
+		if err != nil {
+			return types.AppDatastoreQueryResult{}, slackerror.NewAPIError(slackerror.ErrInvalidArguments, "The cursor is invalid", nil, appDatastoreQueryMethod)
+		}
+		start = sort.SearchStrings(matches, string(after))
+		if start < len(matches) && matches[start] == string(after) {
+			start++
+		}
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = datastoreEmulatorQueryLimit
+	}
+	end := min(start+limit, len(matches))
+
+	result := types.AppDatastoreQueryResult{
+		Datastore: query.Datastore,
+		Items:     []map[string]interface{}{},
+	}
+	for _, id := range matches[start:end] {
+		result.Items = append(result.Items, items[id])
+	}
+	if end < len(matches) {
+		result.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(matches[end-1]))
+	}
+	return result, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreCount(ctx context.Context, token string, count types.AppDatastoreCount) (types.AppDatastoreCountResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(count.Datastore, appDatastoreCountMethod)
+	if err != nil {
+		return types.AppDatastoreCountResult{}, err
+	}
+	items, err := e.load(count.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreCountResult{}, err
+	}
+	matches, err := filterEmulatedItems(items, datastore, expression.Query{
+		Expression: count.Expression,
+		Attributes: count.ExpressionAttributes,
+		Values:     count.ExpressionValues,
+	}, appDatastoreCountMethod)
+	if err != nil {
+		return types.AppDatastoreCountResult{}, err
+	}
+	return types.AppDatastoreCountResult{
+		Datastore: count.Datastore,
+		Count:     len(matches),
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreDelete(ctx context.Context, token string, request types.AppDatastoreDelete) (types.AppDatastoreDeleteResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastoreDeleteMethod)
+	if err != nil {
+		return types.AppDatastoreDeleteResult{}, err
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreDeleteResult{}, err
+	}
+	delete(items, request.ID)
+	if err := e.save(request.Datastore, items); err != nil {
+		return types.AppDatastoreDeleteResult{}, err
+	}
+	return types.AppDatastoreDeleteResult{
+		Datastore: request.Datastore,
+		ID:        request.ID,
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreBulkDelete(ctx context.Context, token string, request types.AppDatastoreBulkDelete) (types.AppDatastoreBulkDeleteResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastoreBulkDeleteMethod)
+	if err != nil {
+		return types.AppDatastoreBulkDeleteResult{}, err
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreBulkDeleteResult{}, err
+	}
+	for _, id := range request.IDs {
+		delete(items, id)
+	}
+	if err := e.save(request.Datastore, items); err != nil {
+		return types.AppDatastoreBulkDeleteResult{}, err
+	}
+	return types.AppDatastoreBulkDeleteResult{
+		Datastore: request.Datastore,
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreGet(ctx context.Context, token string, request types.AppDatastoreGet) (types.AppDatastoreGetResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastoreGetMethod)
+	if err != nil {
+		return types.AppDatastoreGetResult{}, err
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreGetResult{}, err
+	}
+	// Missing items return an empty result instead of an error like the API
+	return types.AppDatastoreGetResult{
+		Datastore: request.Datastore,
+		Item:      items[request.ID],
+	}, nil
+}
+
+func (e *DatastoreEmulator) AppsDatastoreBulkGet(ctx context.Context, token string, request types.AppDatastoreBulkGet) (types.AppDatastoreBulkGetResult, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	datastore, err := e.definition(request.Datastore, appDatastoreBulkGetMethod)
+	if err != nil {
+		return types.AppDatastoreBulkGetResult{}, err
+	}
+	items, err := e.load(request.Datastore, datastore)
+	if err != nil {
+		return types.AppDatastoreBulkGetResult{}, err
+	}
+	result := types.AppDatastoreBulkGetResult{
+		Datastore: request.Datastore,
+		Items:     []map[string]interface{}{},
+	}
+	for _, id := range request.IDs {
+		if item, ok := items[id]; ok {
+			result.Items = append(result.Items, item)
+		}
+	}
+	return result, nil
+}
+
+// definition returns the manifest definition of a datastore
+func (e *DatastoreEmulator) definition(name string, method string) (types.ManifestDatastore, error) {
+	datastore, ok := e.datastores[name]
+	if !ok {
+		return types.ManifestDatastore{}, slackerror.NewAPIError(slackerror.ErrDatastoreNotFound, "", nil, method).
+			WithMessage("The datastore \"%s\" is not defined in the app manifest", name)
+	}
+	if datastore.PrimaryKey == "" {
+		return types.ManifestDatastore{}, slackerror.NewAPIError(slackerror.ErrDatastoreMissingPrimaryKey, "", nil, method)
+	}
+	return datastore, nil
+}
+
+// path returns the file path that items of a datastore are saved to
+func (e *DatastoreEmulator) path(name string) string {
+	return filepath.Join(e.dir, name+".json")
+}
+
+// load reads the items of a datastore keyed by primary key and drops any items
+// that have expired according to the time to live attribute
+func (e *DatastoreEmulator) load(name string, datastore types.ManifestDatastore) (map[string]map[string]interface{}, error) {
+	items := map[string]map[string]interface{}{}
+	b, err := afero.ReadFile(e.fs, e.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return items, nil
+		}
+		return nil, slackerror.Wrap(err, slackerror.ErrDatastore)
+	}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, slackerror.JSONUnmarshalError(err, b)
+	}
+	if datastore.TimeToLiveAttribute != "" {
+		now := e.now().Unix()
+		for id, item := range items {
+			if expiry, ok := item[datastore.TimeToLiveAttribute].(float64); ok && int64(expiry) <= now {
+				delete(items, id)
+			}
+		}
+	}
+	return items, nil
+}
+
+// save writes the items of a datastore to file
+func (e *DatastoreEmulator) save(name string, items map[string]map[string]interface{}) error {
+	b, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastore)
+	}
+	if err := e.fs.MkdirAll(e.dir, 0755); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastore)
+	}
+	if err := afero.WriteFile(e.fs, e.path(name), b, 0644); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastore)
+	}
+	return nil
+}
+
+// emulatedItemID returns the primary key value of an item as a string
+func emulatedItemID(datastore types.ManifestDatastore, item map[string]interface{}) string {
+	return fmt.Sprint(item[datastore.PrimaryKey])
+}
+
+// validateEmulatedItem checks that an item has a primary key and only contains
+// attributes of the expected types from the manifest
+func validateEmulatedItem(datastore types.ManifestDatastore, item map[string]interface{}) (slackerror.ErrorDetail, bool) {
+	if id, ok := item[datastore.PrimaryKey].(string); !ok || id == "" {
+		return slackerror.ErrorDetail{
+			Code:    slackerror.ErrDatastoreMissingPrimaryKey,
+			Message: fmt.Sprintf("The item is missing a value for the primary key \"%s\"", datastore.PrimaryKey),
+			Pointer: "/item/" + datastore.PrimaryKey,
+			Item:    item,
+		}, false
+	}
+	for name, value := range item {
+		attribute, ok := datastore.Attributes[name]
+		if !ok {
+			return slackerror.ErrorDetail{
+				Code:    slackerror.ErrInvalidArguments,
+				Message: fmt.Sprintf("The attribute \"%s\" is not defined for the datastore", name),
+				Pointer: "/item/" + name,
+				Item:    item,
+			}, false
+		}
+		if value != nil && !isEmulatedAttributeType(attribute.Type, value) {
+			return slackerror.ErrorDetail{
+				Code:    slackerror.ErrInvalidArguments,
+				Message: fmt.Sprintf("The attribute \"%s\" must be of type \"%s\"", name, attribute.Type),
+				Pointer: "/item/" + name,
+				Item:    item,
+			}, false
+		}
+	}
+	return slackerror.ErrorDetail{}, true
+}
+
+// isEmulatedAttributeType checks a decoded JSON value against the primitive
+// types of the manifest. Custom and Slack types are not checked.
+func isEmulatedAttributeType(attributeType string, value interface{}) bool {
+	switch attributeType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == float64(int64(number))
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+// filterEmulatedItems returns the sorted IDs of items matching an expression
+func filterEmulatedItems(
+	items map[string]map[string]interface{},
+	datastore types.ManifestDatastore,
+	query expression.Query,
+	method string,
+) ([]string, error) {
+	condition, err := expression.Validate(query, &datastore)
+	if err != nil {
+		return nil, slackerror.NewAPIError(slackerror.ErrInvalidDatastoreExpression, "", nil, method).
+			WithMessage("%s", err.Error())
+	}
+	ids := []string{}
+	for id, item := range items {
+		if expression.Evaluate(condition, item, query.Attributes, query.Values) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Handler returns an http.Handler that answers datastore methods of the Slack
+// API from the emulator and proxies other requests to the upstream host.
+//
+// Requests made from an app might not include an app ID, so the emulator keeps
+// the same items for every app of a project.
+func (e *DatastoreEmulator) Handler(upstream *url.URL) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(upstream)
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		r.Host = upstream.Host
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := strings.TrimPrefix(r.URL.Path, "/api/")
+		if !strings.HasPrefix(method, "apps.datastore.") {
+			proxy.ServeHTTP(w, r)
+			return
+		}
+		args, err := decodeEmulatorArgs(r)
+		if err != nil {
+			writeEmulatorResponse(w, nil, slackerror.New(slackerror.ErrInvalidArguments).WithRootCause(err))
+			return
+		}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		var result interface{}
+		switch method {
+		case appDatastorePutMethod:
+			result, err = e.AppsDatastorePut(r.Context(), token, types.AppDatastorePut{Datastore: args.Datastore, Item: args.Item})
+		case appDatastoreBulkPutMethod:
+			result, err = e.AppsDatastoreBulkPut(r.Context(), token, types.AppDatastoreBulkPut{Datastore: args.Datastore, Items: args.Items})
+		case appDatastoreUpdateMethod:
+			result, err = e.AppsDatastoreUpdate(r.Context(), token, types.AppDatastoreUpdate{Datastore: args.Datastore, Item: args.Item})
+		case appDatastoreGetMethod:
+			result, err = e.AppsDatastoreGet(r.Context(), token, types.AppDatastoreGet{Datastore: args.Datastore, ID: args.ID})
+		case appDatastoreBulkGetMethod:
+			result, err = e.AppsDatastoreBulkGet(r.Context(), token, types.AppDatastoreBulkGet{Datastore: args.Datastore, IDs: args.IDs})
+		case appDatastoreDeleteMethod:
+			result, err = e.AppsDatastoreDelete(r.Context(), token, types.AppDatastoreDelete{Datastore: args.Datastore, ID: args.ID})
+		case appDatastoreBulkDeleteMethod:
+			result, err = e.AppsDatastoreBulkDelete(r.Context(), token, types.AppDatastoreBulkDelete{Datastore: args.Datastore, IDs: args.IDs})
+		case appDatastoreCountMethod:
+			result, err = e.AppsDatastoreCount(r.Context(), token, types.AppDatastoreCount{
+				Datastore:            args.Datastore,
+				Expression:           args.Expression,
+				ExpressionAttributes: args.ExpressionAttributes,
+				ExpressionValues:     args.ExpressionValues,
+			})
+		case appDatastoreQueryMethod:
+			var queryResult types.AppDatastoreQueryResult
+			queryResult, err = e.AppsDatastoreQuery(r.Context(), token, types.AppDatastoreQuery{
+				Datastore:            args.Datastore,
+				Expression:           args.Expression,
+				ExpressionAttributes: args.ExpressionAttributes,
+				ExpressionValues:     args.ExpressionValues,
+				Limit:                args.Limit,
+				Cursor:               args.Cursor,
+			})
+			result = struct {
+				types.AppDatastoreQueryResult
+				ResponseMetadata responseMetadata `json:"response_metadata,omitempty"`
+			}{
+				queryResult,
+				responseMetadata{NextCursor: queryResult.NextCursor},
+			}
+		default:
+			err = slackerror.New(slackerror.ErrUnknownMethod).
+				WithDetails(slackerror.ErrorDetails{{
+					Code:        slackerror.ErrUnknownMethod,
+					Message:     fmt.Sprintf("The datastore emulator does not support the %s method", method),
+					Remediation: fmt.Sprintf("Check the method name or update to the latest version with %s", style.Commandf("upgrade", false)),
+				}})
+		}
+		writeEmulatorResponse(w, result, err)
+	})
+}
+
+// datastoreEmulatorArgs contains the arguments of every datastore method
+type datastoreEmulatorArgs struct {
+	Datastore            string                   `json:"datastore,omitempty"`
+	ID                   string                   `json:"id,omitempty"`
+	IDs                  []string                 `json:"ids,omitempty"`
+	Item                 map[string]interface{}   `json:"item,omitempty"`
+	Items                []map[string]interface{} `json:"items,omitempty"`
+	Expression           string                   `json:"expression,omitempty"`
+	ExpressionAttributes map[string]interface{}   `json:"expression_attributes,omitempty"`
+	ExpressionValues     map[string]interface{}   `json:"expression_values,omitempty"`
+	Limit                int                      `json:"limit,omitempty"`
+	Cursor               string                   `json:"cursor,omitempty"`
+}
+
+// decodeEmulatorArgs reads arguments from either a JSON or form encoded body.
+// Form values for structured arguments are expected to be encoded as JSON.
+func decodeEmulatorArgs(r *http.Request) (datastoreEmulatorArgs, error) {
+	var args datastoreEmulatorArgs
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return args, err
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		err = json.Unmarshal(body, &args)
+		return args, err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return args, err
+	}
+	fields := map[string]json.RawMessage{}
+	for key := range form {
+		value := form.Get(key)
+		switch key {
+		case "datastore", "id", "expression", "cursor":
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return args, err
+			}
+			fields[key] = encoded
+		default:
+			fields[key] = json.RawMessage(value)
+		}
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return args, err
+	}
+	err = json.Unmarshal(encoded, &args)
+	return args, err
+}
+
+// writeEmulatorResponse responds with the result or error in the format of the
+// Slack API
+func writeEmulatorResponse(w http.ResponseWriter, result interface{}, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	response := map[string]interface{}{"ok": true}
+	if err != nil {
+		slackErr := slackerror.ToSlackError(err)
+		response = map[string]interface{}{
+			"ok":    false,
+			"error": slackErr.Code,
+		}
+		if len(slackErr.Details) > 0 {
+			response["errors"] = slackErr.Details
+		}
+	} else if b, err := json.Marshal(result); err == nil {
+		_ = json.Unmarshal(b, &response)
+		response["ok"] = true
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
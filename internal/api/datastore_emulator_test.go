@@ -0,0 +1,206 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var emulatedDatastores = map[string]types.ManifestDatastore{
+	"tasks": {
+		PrimaryKey:          "id",
+		TimeToLiveAttribute: "expires",
+		Attributes: map[string]types.ManifestAttribute{
+			"id":      {Type: "string"},
+			"status":  {Type: "string"},
+			"points":  {Type: "integer"},
+			"expires": {Type: "number"},
+		},
+	},
+}
+
+func newTestDatastoreEmulator() *DatastoreEmulator {
+	return NewDatastoreEmulator(&APIMock{}, afero.NewMemMapFs(), "/project/.slack/datastores", emulatedDatastores)
+}
+
+func TestDatastoreEmulator_PutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	emulator := newTestDatastoreEmulator()
+
+	_, err := emulator.AppsDatastorePut(ctx, "", types.AppDatastorePut{
+		Datastore: "tasks",
+		Item:      map[string]interface{}{"id": "1", "status": "todo", "points": float64(3)},
+	})
+	require.NoError(t, err)
+
+	updated, err := emulator.AppsDatastoreUpdate(ctx, "", types.AppDatastoreUpdate{
+		Datastore: "tasks",
+		Item:      map[string]interface{}{"id": "1", "status": "done"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "1", "status": "done", "points": float64(3)}, updated.Item)
+
+	got, err := emulator.AppsDatastoreGet(ctx, "", types.AppDatastoreGet{Datastore: "tasks", ID: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, updated.Item, got.Item)
+
+	_, err = emulator.AppsDatastoreDelete(ctx, "", types.AppDatastoreDelete{Datastore: "tasks", ID: "1"})
+	require.NoError(t, err)
+	got, err = emulator.AppsDatastoreGet(ctx, "", types.AppDatastoreGet{Datastore: "tasks", ID: "1"})
+	require.NoError(t, err)
+	assert.Nil(t, got.Item)
+}
+
+func TestDatastoreEmulator_Validation(t *testing.T) {
+	tests := map[string]struct {
+		datastore    string
+		item         map[string]interface{}
+		expectedCode string
+	}{
+		"errors for an unknown datastore": {
+			datastore:    "unknown",
+			item:         map[string]interface{}{"id": "1"},
+			expectedCode: slackerror.ErrDatastoreNotFound,
+		},
+		"errors without a primary key": {
+			datastore:    "tasks",
+			item:         map[string]interface{}{"status": "todo"},
+			expectedCode: slackerror.ErrInvalidArguments,
+		},
+		"errors for an undefined attribute": {
+			datastore:    "tasks",
+			item:         map[string]interface{}{"id": "1", "owner": "U01"},
+			expectedCode: slackerror.ErrInvalidArguments,
+		},
+		"errors for an attribute of the wrong type": {
+			datastore:    "tasks",
+			item:         map[string]interface{}{"id": "1", "points": 1.5},
+			expectedCode: slackerror.ErrInvalidArguments,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			emulator := newTestDatastoreEmulator()
+			_, err := emulator.AppsDatastorePut(context.Background(), "", types.AppDatastorePut{
+				Datastore: tt.datastore,
+				Item:      tt.item,
+			})
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, slackerror.ToSlackError(err).Code)
+		})
+	}
+}
+
+func TestDatastoreEmulator_QueryAndCount(t *testing.T) {
+	ctx := context.Background()
+	emulator := newTestDatastoreEmulator()
+	emulator.now = func() time.Time { return time.Unix(1000, 0) }
+	_, err := emulator.AppsDatastoreBulkPut(ctx, "", types.AppDatastoreBulkPut{
+		Datastore: "tasks",
+		Items: []map[string]interface{}{
+			{"id": "1", "status": "todo", "points": float64(1)},
+			{"id": "2", "status": "todo", "points": float64(5)},
+			{"id": "3", "status": "done", "points": float64(8)},
+			{"id": "4", "status": "todo", "points": float64(13)},
+			{"id": "5", "status": "todo", "expires": float64(999)},
+		},
+	})
+	require.NoError(t, err)
+
+	query := types.AppDatastoreQuery{
+		Datastore:            "tasks",
+		Expression:           "#status = :status AND #points > :points",
+		ExpressionAttributes: map[string]interface{}{"#status": "status", "#points": "points"},
+		ExpressionValues:     map[string]interface{}{":status": "todo", ":points": float64(2)},
+		Limit:                1,
+	}
+	first, err := emulator.AppsDatastoreQuery(ctx, "", query)
+	require.NoError(t, err)
+	require.Len(t, first.Items, 1)
+	assert.Equal(t, "2", first.Items[0]["id"])
+	require.NotEmpty(t, first.NextCursor)
+
+	query.Cursor = first.NextCursor
+	second, err := emulator.AppsDatastoreQuery(ctx, "", query)
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "4", second.Items[0]["id"])
+	assert.Empty(t, second.NextCursor)
+
+	count, err := emulator.AppsDatastoreCount(ctx, "", types.AppDatastoreCount{Datastore: "tasks"})
+	require.NoError(t, err)
+	assert.Equal(t, 4, count.Count, "expired items should not be counted")
+}
+
+func TestDatastoreEmulator_Handler(t *testing.T) {
+	emulator := newTestDatastoreEmulator()
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true,"upstream":true}`))
+	}))
+	defer upstream.Close()
+	upstreamURL, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+	server := httptest.NewServer(emulator.Handler(upstreamURL))
+	defer server.Close()
+
+	form := url.Values{}
+	form.Set("datastore", "tasks")
+	form.Set("item", `{"id":"7","status":"todo"}`)
+	resp, err := http.Post(server.URL+"/api/apps.datastore.put", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	var putResponse map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&putResponse))
+	resp.Body.Close()
+	assert.Equal(t, true, putResponse["ok"])
+
+	resp, err = http.Post(server.URL+"/api/apps.datastore.get", "application/json", strings.NewReader(`{"datastore":"tasks","id":"7"}`))
+	require.NoError(t, err)
+	var getResponse map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&getResponse))
+	resp.Body.Close()
+	assert.Equal(t, map[string]interface{}{"id": "7", "status": "todo"}, getResponse["item"])
+
+	resp, err = http.Post(server.URL+"/api/chat.postMessage", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	var proxyResponse map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&proxyResponse))
+	resp.Body.Close()
+	assert.Equal(t, true, proxyResponse["upstream"])
+
+	resp, err = http.Post(server.URL+"/api/apps.datastore.truncate", "application/json", strings.NewReader(`{"datastore":"tasks"}`))
+	require.NoError(t, err)
+	var unknownResponse map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&unknownResponse))
+	resp.Body.Close()
+	assert.Equal(t, false, unknownResponse["ok"])
+	assert.Equal(t, slackerror.ErrUnknownMethod, unknownResponse["error"])
+	require.Len(t, unknownResponse["errors"], 1)
+	detail := unknownResponse["errors"].([]interface{})[0].(map[string]interface{})
+	assert.Contains(t, detail["message"], "apps.datastore.truncate")
+	assert.Contains(t, detail["remediation"], "upgrade")
+}
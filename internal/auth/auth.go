@@ -16,11 +16,9 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
-	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -53,6 +51,11 @@ type Client struct {
 	config    *config.Config
 	io        iostreams.IOStreamer
 	fs        afero.Fs
+
+	// store saves authorizations and is chosen when first used
+	store CredentialStore
+	// keyring replaces the system keyring of the keyring credential store
+	keyring Keyring
 }
 
 type AuthInterface interface {
@@ -64,18 +67,23 @@ type AuthInterface interface {
 	AuthWithTeamDomain(ctx context.Context, teamDomain string) (types.SlackAuth, error)
 	// AuthWithTeamID finds an auth with a given team ID
 	AuthWithTeamID(ctx context.Context, teamID string) (types.SlackAuth, error)
+	// AuthWithProfile finds the auth saved with a profile name
+	AuthWithProfile(ctx context.Context, profile string) (types.SlackAuth, error)
 	// Auths returns all the user's authorizatons as a slice
 	Auths(ctx context.Context) ([]types.SlackAuth, error)
 
-	// SetAuth will write new or overwrite existing auth record in credentials.json with provided auth
+	// SetAuth will write new or overwrite existing auth record in the credential store with provided auth
 	SetAuth(ctx context.Context, auth types.SlackAuth) (types.SlackAuth, string, error)
 	// SetSelectedAuth updates API configurations with relevant authentication details
 	SetSelectedAuth(ctx context.Context, auth types.SlackAuth, config *config.Config, os types.Os)
 
-	// DeleteAuth removes an auth from the list of auths in credentials.json
+	// DeleteAuth removes an auth from the list of auths in the credential store
 	DeleteAuth(context.Context, types.SlackAuth) (types.SlackAuth, error)
 	// RevokeToken removes access for a given token, filtering known and safe errors
 	RevokeToken(ctx context.Context, token string) error
+	// RefreshTokens rotates the saved tokens that expire within a duration and
+	// returns the outcome for each auth with a refresh token
+	RefreshTokens(ctx context.Context, within time.Duration) ([]TokenRefresh, error)
 
 	// ResolveAPIHost returns the API Host based on the API Host Flag, Dev Flag, Project Config, and Stored Auth API Host.
 	ResolveAPIHost(ctx context.Context, apiHostFlag string, customAuth *types.SlackAuth) string
@@ -119,7 +127,7 @@ func (c *Client) AuthWithTeamDomain(ctx context.Context, teamDomain string) (typ
 		return types.SlackAuth{}, err
 	}
 
-	for _, auth := range auths {
+	for _, auth := range SelectTeamAuths(auths, c.activeProfile()) {
 		if auth.TeamDomain == teamDomain {
 			return auth, nil
 		}
@@ -129,20 +137,31 @@ func (c *Client) AuthWithTeamDomain(ctx context.Context, teamDomain string) (typ
 		WithMessage("No credentials found with the team domain \"%s\"", teamDomain)
 }
 
-// AuthWithTeamID finds an auth with a given team ID
+// AuthWithTeamID finds an auth with a given team ID, preferring the active
+// profile if more than one user is saved for the team
 func (c *Client) AuthWithTeamID(ctx context.Context, teamID string) (types.SlackAuth, error) {
 	auths, err := c.Auths(ctx)
 	if err != nil {
 		return types.SlackAuth{}, err
 	}
 
-	for _, auth := range auths {
+	for _, auth := range SelectTeamAuths(auths, c.activeProfile()) {
 		if auth.TeamID == teamID {
 			return auth, nil
 		}
 	}
 
-	return types.SlackAuth{}, slackerror.New(slackerror.ErrCredentialsNotFound).
+	return types.SlackAuth{}, errCredentialsNotFound(teamID, c.activeProfile())
+}
+
+// errCredentialsNotFound returns the error for a team without saved credentials
+// and includes the profile that was searched if one is set
+func errCredentialsNotFound(teamID string, profile string) *slackerror.Error {
+	if profile != "" {
+		return slackerror.New(slackerror.ErrCredentialsNotFound).
+			WithMessage("No credentials found with the team ID \"%s\" and profile \"%s\"", teamID, profile)
+	}
+	return slackerror.New(slackerror.ErrCredentialsNotFound).
 		WithMessage("No credentials found with the team ID \"%s\"", teamID)
 }
 
@@ -196,33 +215,11 @@ func (c *Client) auths(ctx context.Context) (map[string]types.SlackAuth, error)
 	span, ctx = opentracing.StartSpanFromContext(ctx, "auths")
 	defer span.Finish()
 
-	var auths types.AuthByTeamDomain
-
-	c.io.PrintDebug(ctx, "reading credentials file")
-	dir, err := c.config.SystemConfig.SlackConfigDir(ctx)
-	if err != nil {
-		return auths, err
-	}
-	var path string = filepath.Join(dir, credentialsFileName)
-
-	if _, err := c.fs.Stat(path); os.IsNotExist(err) {
-		return auths, err
-	}
-
-	c.io.PrintDebug(ctx, "found authorizations at %s reading", path)
-	raw, err := afero.ReadFile(c.fs, path)
+	auths, err := c.loadAuths(ctx)
 	if err != nil {
 		return auths, err
 	}
 
-	err = json.Unmarshal(raw, &auths)
-	if err != nil {
-		return auths, slackerror.New(slackerror.ErrUnableToParseJSON).
-			WithMessage("Failed to parse contents of credentials file").
-			WithRootCause(err).
-			WithRemediation("Check that %s is valid JSON", style.HomePath(path))
-	}
-
 	var updatedAuthsByName types.AuthByTeamDomain
 	var updatedAuthsByTeamID types.AuthByTeamID
 	var hasUpdateRotation, hasUpdateNaming bool
@@ -242,6 +239,29 @@ func (c *Client) auths(ctx context.Context) (map[string]types.SlackAuth, error)
 	return updatedAuthsByTeamID, nil
 }
 
+// loadAuths reads the saved authorizations from the credential store without
+// rotating tokens
+func (c *Client) loadAuths(ctx context.Context) (types.AuthByTeamDomain, error) {
+	var auths types.AuthByTeamDomain
+
+	store, err := c.credentialStore(ctx)
+	if err != nil {
+		return auths, err
+	}
+	c.io.PrintDebug(ctx, "reading authorizations from the %s credential store", store.Name())
+	auths, err = store.Load(ctx)
+	if err != nil {
+		return auths, err
+	}
+	if store.Name() != CredentialStoreFile {
+		auths, err = c.migrateCredentialsFile(ctx, store, auths)
+		if err != nil {
+			return auths, err
+		}
+	}
+	return auths, nil
+}
+
 // migrateToAuthByTeamID takes a map of auths keyed by team_domain or team_id and returns a map of auths
 // guaranteed to be keyed by team id. Historically we have used non-unique team domain to store auths against.
 // It was not ideal.
@@ -255,7 +275,10 @@ func (c *Client) migrateToAuthByTeamID(ctx context.Context, auths types.AuthByTe
 	var updated = false
 
 	for authKey, auth := range auths {
-		if !c.isTeamID(authKey) {
+		if auth.Profile != "" {
+			// auths saved with a profile are keyed by the profile name
+			updatedAuths[authKey] = auth
+		} else if !c.isTeamID(authKey) {
 			// set the key in auths to use team_id
 			updatedAuths[auth.TeamID] = auth
 			updated = true
@@ -297,7 +320,7 @@ func (c *Client) rotateTokenAll(ctx context.Context, auths types.AuthByTeamDomai
 			// The user should go ahead with the bad token and the api will handle the
 			// return of the appropriate error to the user.
 			// We only want to warn the user about what we tried to do.
-			c.io.PrintDebug(ctx, "Your auth token for '%s' is outdated. Tried refreshing the credentials but encountered the following error:\n%s", auth.TeamDomain, err.Error())
+			c.io.PrintWarning(ctx, "%s", tokenRotationError(auth, err).Error())
 		} else if tokenIsUpdated {
 			updated = true
 			updatedAuths[authKey] = updatedAuth
@@ -313,7 +336,16 @@ func (c *Client) rotateToken(ctx context.Context, auth types.SlackAuth) (types.S
 	if !auth.ShouldRotateToken() {
 		return auth, false /* tokenIsUpdated */, nil
 	}
+	auth, err := c.refreshToken(ctx, auth)
+	if err != nil {
+		return auth, false /* tokenIsUpdated */, err
+	}
+	return auth, true /* tokenIsUpdated */, nil
+}
 
+// refreshToken exchanges the refresh token of an auth for a new token without
+// checking the expiration of the current token
+func (c *Client) refreshToken(ctx context.Context, auth types.SlackAuth) (types.SlackAuth, error) {
 	// Store the current apiHost before rotation
 	// We need this because we need to restore
 	// the apiHost to what it was before rotating each of the user's auths
@@ -326,10 +358,13 @@ func (c *Client) rotateToken(ctx context.Context, auth types.SlackAuth) (types.S
 		c.api.SetHost(defaultProdAPIClientHost)
 	}
 
+	// restore the previous default apiHost once the rotation is done
+	defer c.api.SetHost(activeAPIHostBeforeRotation)
+
 	var result, err = c.api.RotateToken(ctx, auth)
 	if err != nil {
 		// handle token rotation failure by sending meaningful messages to the users and remove already expired auth
-		return auth, false /* tokenIsUpdated */, err
+		return auth, err
 	}
 
 	auth.Token = result.Token
@@ -337,46 +372,40 @@ func (c *Client) rotateToken(ctx context.Context, auth types.SlackAuth) (types.S
 	auth.RefreshToken = result.RefreshToken
 	auth.LastUpdated = time.Now()
 
-	// now restore the previous default apiHost
-	c.api.SetHost(activeAPIHostBeforeRotation)
-
-	return auth, true /* tokenIsUpdated */, nil
+	return auth, nil
 }
 
-// setAuths sets the user's authorizations to the credentials file and returns the filepath of the credentials file in success cases
-func (c *Client) setAuths(ctx context.Context, auths types.AuthByTeamDomain) (path string, err error) {
-	var b []byte
-	b, err = json.MarshalIndent(auths, "", "  ")
-
-	if err != nil {
-		return "", err
-	}
-
-	dir, err := c.config.SystemConfig.SlackConfigDir(ctx)
+// setAuths saves the user's authorizations to the credential store and returns
+// where these were saved in success cases
+func (c *Client) setAuths(ctx context.Context, auths types.AuthByTeamDomain) (location string, err error) {
+	store, err := c.credentialStore(ctx)
 	if err != nil {
 		return "", err
 	}
-	path = filepath.Join(dir, credentialsFileName)
-
-	err = afero.WriteFile(c.fs, path, b, 0600)
-	if err != nil {
-		return path, err
-	}
-
-	return path, nil
+	return store.Save(ctx, auths)
 }
 
-// SetAuth will write new or overwrite existing auth record in credentials.json with provided auth
+// SetAuth will write new or overwrite existing auth record in the credential store with provided auth.
+// The auth is saved to the profile of the command if the auth has no profile.
 func (c *Client) SetAuth(ctx context.Context, auth types.SlackAuth) (types.SlackAuth, string, error) {
 	var span opentracing.Span
 	span, ctx = opentracing.StartSpanFromContext(ctx, "SetAuth")
 	defer span.Finish()
 
+	if auth.Profile == "" {
+		auth.Profile = c.activeProfile()
+	}
+	if auth.Profile != "" {
+		if err := ValidateProfileName(auth.Profile); err != nil {
+			return types.SlackAuth{}, "", err
+		}
+	}
+
 	allAuths, err := c.auths(ctx)
 	if err != nil {
 		return types.SlackAuth{}, "", fmt.Errorf("Failed to fetch user authorizations %s", err)
 	}
-	allAuths[auth.TeamID] = auth
+	allAuths[authKey(auth)] = auth
 
 	// Now save the auth
 	fileLocation, err := c.setAuths(ctx, allAuths)
@@ -410,20 +439,20 @@ func (c *Client) SetSelectedAuth(ctx context.Context, auth types.SlackAuth, conf
 	}
 }
 
-// DeleteAuth removes an auth from the list of auths in credentials.json
+// DeleteAuth removes an auth from the list of auths in the credential store
 func (c *Client) DeleteAuth(ctx context.Context, auth types.SlackAuth) (types.SlackAuth, error) {
-	// Ensure the auth exists
-	toDelete, err := c.AuthWithTeamID(ctx, auth.TeamID)
-	if err != nil {
-		return toDelete, err
-	}
 	allAuths, err := c.auths(ctx)
 	if err != nil {
-		return toDelete, err
+		return types.SlackAuth{}, err
+	}
+	// Ensure the auth exists
+	toDelete, ok := allAuths[authKey(auth)]
+	if !ok {
+		return types.SlackAuth{}, errCredentialsNotFound(auth.TeamID, auth.Profile)
 	}
-	delete(allAuths, auth.TeamID)
+	delete(allAuths, authKey(auth))
 
-	// Save to credentials.json
+	// Save to the credential store
 	_, err = c.setAuths(ctx, allAuths)
 	if err != nil {
 		return toDelete, err
@@ -16,6 +16,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
@@ -47,6 +48,11 @@ func (m *AuthMock) AuthWithTeamID(ctx context.Context, teamID string) (types.Sla
 	return args.Get(0).(types.SlackAuth), args.Error(1)
 }
 
+func (m *AuthMock) AuthWithProfile(ctx context.Context, profile string) (types.SlackAuth, error) {
+	args := m.Called(ctx, profile)
+	return args.Get(0).(types.SlackAuth), args.Error(1)
+}
+
 func (m *AuthMock) Auths(ctx context.Context) ([]types.SlackAuth, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]types.SlackAuth), args.Error(1)
@@ -71,6 +77,11 @@ func (m *AuthMock) RevokeToken(ctx context.Context, token string) error {
 	return args.Error(0)
 }
 
+func (m *AuthMock) RefreshTokens(ctx context.Context, within time.Duration) ([]TokenRefresh, error) {
+	args := m.Called(ctx, within)
+	return args.Get(0).([]TokenRefresh), args.Error(1)
+}
+
 func (m *AuthMock) ResolveAPIHost(ctx context.Context, apiHostFlag string, customAuth *types.SlackAuth) string {
 	args := m.Called(ctx, apiHostFlag, customAuth)
 	return args.String(0)
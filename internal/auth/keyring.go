@@ -0,0 +1,176 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+)
+
+// keyringService is the service name of secrets saved to the system keyring
+const keyringService = "slack-cli"
+
+// keyringRemediation suggests fixes for errors of the system keyring
+const keyringRemediation = "Check that the system keyring is installed and unlocked or set SLACK_CREDENTIAL_STORE to \"encrypted\""
+
+// errKeyringSecretNotFound is returned when the keyring has no saved secret
+var errKeyringSecretNotFound = errors.New("secret not found in keyring")
+
+// Keyring saves secrets with the credential manager of the operating system
+type Keyring interface {
+	// Get returns the secret saved for the account of a service
+	Get(ctx context.Context, service string, account string) (string, error)
+	// Set saves the secret for the account of a service
+	Set(ctx context.Context, service string, account string, secret string) error
+}
+
+// newSystemKeyring returns the keyring of the current operating system
+func newSystemKeyring() (Keyring, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		return keychainKeyring{}, nil
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return secretServiceKeyring{}, nil
+	default:
+		return nil, slackerror.New(slackerror.ErrInvalidCredentialStore).
+			WithMessage("The keyring credential store is not supported on %s", runtime.GOOS).
+			WithRemediation("Set SLACK_CREDENTIAL_STORE to \"encrypted\" to save authorizations to an encrypted file")
+	}
+}
+
+// runKeyringCommand runs a command of the keyring with secrets passed through
+// standard input instead of arguments that other processes can read
+func runKeyringCommand(ctx context.Context, stdin string, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && stderr.Len() > 0 {
+		err = fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), err
+}
+
+// secretServiceKeyring saves secrets with the Secret Service API through the
+// secret-tool command of libsecret
+type secretServiceKeyring struct{}
+
+func (secretServiceKeyring) Get(ctx context.Context, service string, account string) (string, error) {
+	stdout, err := runKeyringCommand(ctx, "", "secret-tool", "lookup", "service", service, "account", account)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && stdout == "" {
+		return "", errKeyringSecretNotFound
+	} else if err != nil {
+		return "", err
+	}
+	return stdout, nil
+}
+
+func (secretServiceKeyring) Set(ctx context.Context, service string, account string, secret string) error {
+	label := fmt.Sprintf("Slack CLI authorizations (%s)", account)
+	_, err := runKeyringCommand(ctx, secret, "secret-tool", "store", "--label", label, "service", service, "account", account)
+	return err
+}
+
+// keychainKeyring saves secrets to the login keychain of macOS with the
+// security command
+type keychainKeyring struct{}
+
+// keychainItemNotFound is the exit code of the security command when no
+// matching item exists
+const keychainItemNotFound = 44
+
+func (keychainKeyring) Get(ctx context.Context, service string, account string) (string, error) {
+	stdout, err := runKeyringCommand(ctx, "", "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == keychainItemNotFound {
+		return "", errKeyringSecretNotFound
+	} else if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(stdout, "\n"), nil
+}
+
+func (keychainKeyring) Set(ctx context.Context, service string, account string, secret string) error {
+	// Interactive mode reads the command from standard input and the secret is
+	// hex encoded to avoid quoting
+	command := fmt.Sprintf("add-generic-password -U -s %q -a %q -X %s\n", service, account, hex.EncodeToString([]byte(secret)))
+	_, err := runKeyringCommand(ctx, command, "security", "-i")
+	return err
+}
+
+// keyringStore saves authorizations as one secret in the system keyring for
+// each configuration directory
+type keyringStore struct {
+	keyring Keyring
+	account string
+}
+
+func newKeyringStore(keyring Keyring, dir string) *keyringStore {
+	return &keyringStore{keyring: keyring, account: dir}
+}
+
+func (s *keyringStore) Name() string {
+	return CredentialStoreKeyring
+}
+
+// Load reads the saved authorizations or returns none if no secret is saved
+func (s *keyringStore) Load(ctx context.Context) (types.AuthByTeamDomain, error) {
+	auths := types.AuthByTeamDomain{}
+	secret, err := s.keyring.Get(ctx, keyringService, s.account)
+	if errors.Is(err, errKeyringSecretNotFound) {
+		return auths, nil
+	} else if err != nil {
+		return auths, slackerror.New(slackerror.ErrCredentialStore).
+			WithMessage("Failed to read authorizations from the system keyring").
+			WithRootCause(err).
+			WithRemediation(keyringRemediation)
+	}
+	if err := json.Unmarshal([]byte(secret), &auths); err != nil {
+		return auths, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse the authorizations saved in the system keyring").
+			WithRootCause(err)
+	}
+	return auths, nil
+}
+
+func (s *keyringStore) Save(ctx context.Context, auths types.AuthByTeamDomain) (string, error) {
+	b, err := json.Marshal(auths)
+	if err != nil {
+		return "", err
+	}
+	location := fmt.Sprintf("the system keyring (%s for %s)", keyringService, style.HomePath(s.account))
+	if err := s.keyring.Set(ctx, keyringService, s.account, string(b)); err != nil {
+		return location, slackerror.New(slackerror.ErrCredentialStore).
+			WithMessage("Failed to save authorizations to the system keyring").
+			WithRootCause(err).
+			WithRemediation(keyringRemediation)
+	}
+	return location, nil
+}
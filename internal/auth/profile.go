@@ -0,0 +1,104 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// profileNamePattern matches lowercase profile names that are never confused
+// with the uppercase team IDs used as keys of other authorizations
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ValidateProfileName errors if a profile name cannot be saved
+func ValidateProfileName(profile string) error {
+	if !profileNamePattern.MatchString(profile) {
+		return slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The profile name \"%s\" is not valid", profile).
+			WithRemediation("Use lowercase letters, numbers, dashes, and underscores in profile names")
+	}
+	return nil
+}
+
+// authKey returns the key of an auth in the saved authorizations. Auths with a
+// profile are keyed by profile name so more than one user can be saved for a
+// team.
+func authKey(auth types.SlackAuth) string {
+	if auth.Profile != "" {
+		return auth.Profile
+	}
+	return auth.TeamID
+}
+
+// activeProfile returns the profile chosen for the command
+func (c *Client) activeProfile() string {
+	if c.config.ProfileResolved != "" {
+		return c.config.ProfileResolved
+	}
+	return c.config.ProfileFlag
+}
+
+// AuthWithProfile finds the auth saved with a profile name
+func (c *Client) AuthWithProfile(ctx context.Context, profile string) (types.SlackAuth, error) {
+	auths, err := c.Auths(ctx)
+	if err != nil {
+		return types.SlackAuth{}, err
+	}
+	for _, auth := range auths {
+		if auth.Profile == profile {
+			return auth, nil
+		}
+	}
+	return types.SlackAuth{}, slackerror.New(slackerror.ErrProfileNotFound).
+		WithMessage("No authorization was found for the profile \"%s\"", profile)
+}
+
+// SelectTeamAuths returns one auth for each team in the order of the provided
+// auths. A team with more than one saved user uses the auth of the profile,
+// then the auth saved without a profile, then the first profile by name.
+func SelectTeamAuths(auths []types.SlackAuth, profile string) []types.SlackAuth {
+	teams := map[string][]types.SlackAuth{}
+	for _, auth := range auths {
+		teams[auth.TeamID] = append(teams[auth.TeamID], auth)
+	}
+	selected := []types.SlackAuth{}
+	for _, auth := range auths {
+		candidates, ok := teams[auth.TeamID]
+		if !ok {
+			continue
+		}
+		delete(teams, auth.TeamID)
+		selected = append(selected, preferredAuth(candidates, profile))
+	}
+	return selected
+}
+
+// preferredAuth chooses between auths saved for the same team
+func preferredAuth(candidates []types.SlackAuth, profile string) types.SlackAuth {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Profile < candidates[j].Profile
+	})
+	for _, auth := range candidates {
+		if profile != "" && auth.Profile == profile {
+			return auth
+		}
+	}
+	return candidates[0]
+}
@@ -0,0 +1,187 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateProfileName(t *testing.T) {
+	tests := map[string]struct {
+		profile string
+		valid   bool
+	}{
+		"lowercase names are valid":       {profile: "service-bot_2", valid: true},
+		"empty names are not valid":       {profile: "", valid: false},
+		"uppercase names are not valid":   {profile: "T123456789A", valid: false},
+		"leading dashes are not valid":    {profile: "-service", valid: false},
+		"names with spaces are not valid": {profile: "my service", valid: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateProfileName(tt.profile)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+			}
+		})
+	}
+}
+
+func Test_SelectTeamAuths(t *testing.T) {
+	personal := types.SlackAuth{TeamDomain: "workspace-a", TeamID: "T123456789A", Token: "xoxp-personal"}
+	service := types.SlackAuth{TeamDomain: "workspace-a", TeamID: "T123456789A", Token: "xoxp-service", Profile: "service"}
+	staging := types.SlackAuth{TeamDomain: "workspace-a", TeamID: "T123456789A", Token: "xoxp-staging", Profile: "staging"}
+	other := types.SlackAuth{TeamDomain: "workspace-b", TeamID: "T123456789B", Token: "xoxp-other"}
+
+	tests := map[string]struct {
+		auths    []types.SlackAuth
+		profile  string
+		expected []types.SlackAuth
+	}{
+		"returns the auth saved without a profile by default": {
+			auths:    []types.SlackAuth{service, personal, other},
+			expected: []types.SlackAuth{personal, other},
+		},
+		"returns the auth of the active profile": {
+			auths:    []types.SlackAuth{personal, service, other},
+			profile:  "service",
+			expected: []types.SlackAuth{service, other},
+		},
+		"returns the first profile by name without an unnamed auth": {
+			auths:    []types.SlackAuth{staging, service},
+			expected: []types.SlackAuth{service},
+		},
+		"keeps teams without the active profile": {
+			auths:    []types.SlackAuth{other},
+			profile:  "service",
+			expected: []types.SlackAuth{other},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SelectTeamAuths(tt.auths, tt.profile))
+		})
+	}
+}
+
+func Test_AuthProfiles(t *testing.T) {
+	personal := types.SlackAuth{TeamDomain: "workspace-a", TeamID: "T123456789A", UserID: "U1", Token: "xoxp-personal"}
+	service := types.SlackAuth{TeamDomain: "workspace-a", TeamID: "T123456789A", UserID: "U2", Token: "xoxp-service"}
+
+	var setup = func(t *testing.T) (context.Context, *Client) {
+		ctx := slackcontext.MockContext(t.Context())
+		fsMock := slackdeps.NewFsMock()
+		osMock := slackdeps.NewOsMock()
+		osMock.AddDefaultMocks()
+		config := config.NewConfig(fsMock, osMock)
+		ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+		ioMock.AddDefaultMocks()
+		return ctx, NewClient(nil, nil, config, ioMock, fsMock)
+	}
+
+	t.Run("saves two users of a team with different profiles", func(t *testing.T) {
+		ctx, authClient := setup(t)
+		_, _, err := authClient.SetAuth(ctx, personal)
+		require.NoError(t, err)
+		authClient.config.ProfileFlag = "service"
+		saved, _, err := authClient.SetAuth(ctx, service)
+		require.NoError(t, err)
+		assert.Equal(t, "service", saved.Profile)
+
+		auths, err := authClient.auths(ctx)
+		require.NoError(t, err)
+		assert.Len(t, auths, 2)
+		assert.Equal(t, "xoxp-personal", auths[personal.TeamID].Token)
+		assert.Equal(t, "xoxp-service", auths["service"].Token)
+
+		found, err := authClient.AuthWithTeamID(ctx, personal.TeamID)
+		require.NoError(t, err)
+		assert.Equal(t, "xoxp-service", found.Token)
+		authClient.config.ProfileFlag = ""
+		found, err = authClient.AuthWithTeamID(ctx, personal.TeamID)
+		require.NoError(t, err)
+		assert.Equal(t, "xoxp-personal", found.Token)
+	})
+
+	t.Run("errors when saving an invalid profile name", func(t *testing.T) {
+		ctx, authClient := setup(t)
+		authClient.config.ProfileFlag = "Not Valid"
+		_, _, err := authClient.SetAuth(ctx, service)
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+	})
+
+	t.Run("deletes only the auth of the profile", func(t *testing.T) {
+		ctx, authClient := setup(t)
+		_, _, err := authClient.SetAuth(ctx, personal)
+		require.NoError(t, err)
+		profiled := service
+		profiled.Profile = "service"
+		_, _, err = authClient.SetAuth(ctx, profiled)
+		require.NoError(t, err)
+
+		_, err = authClient.DeleteAuth(ctx, profiled)
+		require.NoError(t, err)
+		auths, err := authClient.auths(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]types.SlackAuth{personal.TeamID: personal}, auths)
+	})
+
+	t.Run("errors with the active profile if no auth has the team", func(t *testing.T) {
+		ctx, authClient := setup(t)
+		authClient.config.ProfileFlag = "service"
+		_, err := authClient.AuthWithTeamID(ctx, "T000000000Z")
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrCredentialsNotFound, slackerror.ToSlackError(err).Code)
+		assert.Equal(t, `No credentials found with the team ID "T000000000Z" and profile "service"`, slackerror.ToSlackError(err).Message)
+	})
+
+	t.Run("errors with the profile when deleting an unknown auth", func(t *testing.T) {
+		ctx, authClient := setup(t)
+		_, _, err := authClient.SetAuth(ctx, personal)
+		require.NoError(t, err)
+		profiled := personal
+		profiled.Profile = "service"
+		_, err = authClient.DeleteAuth(ctx, profiled)
+		require.Error(t, err)
+		assert.Equal(t, `No credentials found with the team ID "T123456789A" and profile "service"`, slackerror.ToSlackError(err).Message)
+
+		_, err = authClient.DeleteAuth(ctx, types.SlackAuth{TeamID: "T000000000Z"})
+		require.Error(t, err)
+		assert.Equal(t, `No credentials found with the team ID "T000000000Z"`, slackerror.ToSlackError(err).Message)
+	})
+
+	t.Run("errors if no auth has the profile", func(t *testing.T) {
+		ctx, authClient := setup(t)
+		_, _, err := authClient.SetAuth(ctx, personal)
+		require.NoError(t, err)
+		_, err = authClient.AuthWithProfile(ctx, "service")
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrProfileNotFound, slackerror.ToSlackError(err).Code)
+	})
+}
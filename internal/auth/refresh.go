@@ -0,0 +1,134 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+)
+
+// TokenRefresh is the outcome of refreshing the token of a saved auth
+type TokenRefresh struct {
+	// Auth is the saved auth, with the new token if refreshed
+	Auth types.SlackAuth
+	// Refreshed is true if the token was rotated
+	Refreshed bool
+	// Err is the error of a failed rotation
+	Err error
+}
+
+// RefreshTokens rotates the tokens of saved auths that expire within the
+// duration, or every token that can be rotated if the duration is zero. Tokens
+// that live for less than the duration are rotated at half of their lifetime.
+// Every auth with a refresh token is returned in order of team domain and a
+// failed rotation does not stop the rotation of other auths.
+//
+// Each new token is saved once rotated since the rotation replaces the refresh
+// token. If saving fails the refreshes so far are returned with an error that
+// names the team that must log in again.
+func (c *Client) RefreshTokens(ctx context.Context, within time.Duration) ([]TokenRefresh, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "RefreshTokens")
+	defer span.Finish()
+
+	auths, err := c.loadAuths(ctx)
+	if err != nil {
+		return []TokenRefresh{}, err
+	}
+	keys := make([]string, 0, len(auths))
+	for key := range auths {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if auths[keys[i]].TeamDomain == auths[keys[j]].TeamDomain {
+			return keys[i] < keys[j]
+		}
+		return auths[keys[i]].TeamDomain < auths[keys[j]].TeamDomain
+	})
+
+	refreshes := []TokenRefresh{}
+	for _, key := range keys {
+		auth := auths[key]
+		if !auth.CanRotateToken() {
+			continue
+		}
+		if within > 0 && !auth.TokenExpiresWithin(auth.RefreshAhead(within)) {
+			refreshes = append(refreshes, TokenRefresh{Auth: auth})
+			continue
+		}
+		rotated, err := c.refreshToken(ctx, auth)
+		if err != nil {
+			refreshes = append(refreshes, TokenRefresh{Auth: auth, Err: tokenRotationError(auth, err)})
+			continue
+		}
+		auths[key] = rotated
+		if _, err := c.setAuths(ctx, auths); err != nil {
+			saveErr := tokenSaveError(auth, err)
+			refreshes = append(refreshes, TokenRefresh{Auth: auth, Err: saveErr})
+			return refreshes, saveErr
+		}
+		refreshes = append(refreshes, TokenRefresh{Auth: rotated, Refreshed: true})
+	}
+	return refreshes, nil
+}
+
+// tokenTeamName returns the team domain of an auth with the profile if set
+func tokenTeamName(auth types.SlackAuth) string {
+	if auth.Profile != "" {
+		return auth.TeamDomain + " (" + auth.Profile + ")"
+	}
+	return auth.TeamDomain
+}
+
+// tokenSaveError describes a rotated token that was not saved. The refresh
+// token that was saved is replaced by the rotation so the team must log in
+// again.
+func tokenSaveError(auth types.SlackAuth, err error) *slackerror.Error {
+	cause := slackerror.ToSlackError(err)
+	login := "login"
+	if auth.Profile != "" {
+		login = "login --profile " + auth.Profile
+	}
+	return slackerror.New(slackerror.ErrTokenRotation).
+		WithMessage("Failed to save the refreshed token for %s", tokenTeamName(auth)).
+		WithDetails(slackerror.ErrorDetails{
+			slackerror.ErrorDetail{Message: cause.Message, Code: cause.Code},
+		}).
+		WithRemediation("Log in again to %s with %s", tokenTeamName(auth), style.Commandf(login, false))
+}
+
+// tokenRotationError describes a failed rotation of the token for a team
+func tokenRotationError(auth types.SlackAuth, err error) *slackerror.Error {
+	team := tokenTeamName(auth)
+	cause := slackerror.ToSlackError(err)
+	if cause.Message == "" {
+		cause.Message = "The token could not be rotated"
+	}
+	rotationErr := slackerror.New(slackerror.ErrTokenRotation).
+		WithMessage("Failed to refresh the token for %s", team).
+		WithDetails(slackerror.ErrorDetails{
+			slackerror.ErrorDetail{Message: cause.Message, Code: cause.Code},
+		})
+	if auth.TokenIsExpired() {
+		rotationErr = rotationErr.WithRemediation("The token has expired. Log in again to %s", team)
+	}
+	return rotationErr
+}
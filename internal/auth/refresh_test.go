@@ -0,0 +1,169 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RefreshTokens(t *testing.T) {
+	now := int(time.Now().Unix())
+	twelveHoursLater := now + 12*60*60
+
+	var setup = func(t *testing.T, response string, auths types.AuthByTeamDomain) (context.Context, *Client) {
+		ctx := slackcontext.MockContext(t.Context())
+		fsMock := slackdeps.NewFsMock()
+		osMock := slackdeps.NewOsMock()
+		osMock.AddDefaultMocks()
+		config := config.NewConfig(fsMock, osMock)
+		ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+		ioMock.AddDefaultMocks()
+		mockAPIClient, teardown := api.NewFakeClient(t, api.FakeClientParams{
+			ExpectedMethod: "tooling.tokens.rotate",
+			Response:       response,
+		})
+		t.Cleanup(teardown)
+		authClient := NewClient(mockAPIClient, nil, config, ioMock, fsMock)
+		host := mockAPIClient.Host()
+		for key, auth := range auths {
+			auth.APIHost = &host
+			auths[key] = auth
+		}
+		_, err := authClient.setAuths(ctx, auths)
+		require.NoError(t, err)
+		return ctx, authClient
+	}
+
+	rotated := fmt.Sprintf(`{"ok":true,"token":"new-token","exp":%d,"refresh_token":"new-refresh-token"}`, twelveHoursLater)
+
+	t.Run("refreshes every token that can be rotated", func(t *testing.T) {
+		ctx, authClient := setup(t, rotated, types.AuthByTeamDomain{
+			"T1": {TeamDomain: "expiring", TeamID: "T1", Token: "old-token", RefreshToken: "refresh-token", ExpiresAt: now + 60*60},
+			"T2": {TeamDomain: "service", TeamID: "T2", Token: "xoxp-service"},
+		})
+		refreshes, err := authClient.RefreshTokens(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, refreshes, 1)
+		assert.True(t, refreshes[0].Refreshed)
+		assert.NoError(t, refreshes[0].Err)
+		assert.Equal(t, "new-token", refreshes[0].Auth.Token)
+
+		saved, err := authClient.loadAuths(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", saved["T1"].Token)
+		assert.Equal(t, "new-refresh-token", saved["T1"].RefreshToken)
+		assert.Equal(t, twelveHoursLater, saved["T1"].ExpiresAt)
+		assert.Equal(t, "xoxp-service", saved["T2"].Token)
+	})
+
+	t.Run("refreshes only tokens that expire within the duration", func(t *testing.T) {
+		ctx, authClient := setup(t, rotated, types.AuthByTeamDomain{
+			"T1": {TeamDomain: "later", TeamID: "T1", Token: "later-token", RefreshToken: "refresh-token", ExpiresAt: now + 60*60},
+			"T2": {TeamDomain: "soon", TeamID: "T2", Token: "soon-token", RefreshToken: "refresh-token", ExpiresAt: now + 5*60},
+		})
+		refreshes, err := authClient.RefreshTokens(ctx, 10*time.Minute)
+		require.NoError(t, err)
+		require.Len(t, refreshes, 2)
+		assert.Equal(t, "later", refreshes[0].Auth.TeamDomain)
+		assert.False(t, refreshes[0].Refreshed)
+		assert.Equal(t, "later-token", refreshes[0].Auth.Token)
+		assert.Equal(t, "soon", refreshes[1].Auth.TeamDomain)
+		assert.True(t, refreshes[1].Refreshed)
+		assert.Equal(t, "new-token", refreshes[1].Auth.Token)
+	})
+
+	t.Run("does not refresh tokens refreshed within half of the lifetime", func(t *testing.T) {
+		ctx, authClient := setup(t, rotated, types.AuthByTeamDomain{
+			"T1": {TeamDomain: "short", TeamID: "T1", Token: "short-token", RefreshToken: "refresh-token", ExpiresAt: now + 4*60, LastUpdated: time.Unix(int64(now), 0)},
+		})
+		refreshes, err := authClient.RefreshTokens(ctx, 10*time.Minute)
+		require.NoError(t, err)
+		require.Len(t, refreshes, 1)
+		assert.False(t, refreshes[0].Refreshed)
+		assert.Equal(t, "short-token", refreshes[0].Auth.Token)
+	})
+
+	t.Run("saves each refreshed token and names the team that was not saved", func(t *testing.T) {
+		ctx, authClient := setup(t, rotated, types.AuthByTeamDomain{
+			"T1": {TeamDomain: "workspace-a", TeamID: "T1", Token: "a-token", RefreshToken: "refresh-token", ExpiresAt: now + 60},
+			"T2": {TeamDomain: "workspace-b", TeamID: "T2", Token: "b-token", RefreshToken: "refresh-token", ExpiresAt: now + 60, Profile: "service"},
+			"T3": {TeamDomain: "workspace-c", TeamID: "T3", Token: "c-token", RefreshToken: "refresh-token", ExpiresAt: now + 60},
+		})
+		store, err := authClient.credentialStore(ctx)
+		require.NoError(t, err)
+		authClient.store = &failingSaveStore{CredentialStore: store, saves: 1}
+
+		refreshes, err := authClient.RefreshTokens(ctx, 0)
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrTokenRotation, slackerror.ToSlackError(err).Code)
+		assert.Equal(t, "Failed to save the refreshed token for workspace-b (service)", slackerror.ToSlackError(err).Message)
+		assert.Contains(t, slackerror.ToSlackError(err).Remediation, "login --profile service")
+		require.Len(t, refreshes, 2)
+		assert.True(t, refreshes[0].Refreshed)
+		assert.Equal(t, err, refreshes[1].Err)
+
+		saved, err := store.Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", saved["T1"].Token)
+		assert.Equal(t, "b-token", saved["T2"].Token)
+		assert.Equal(t, "c-token", saved["T3"].Token, "rotation stops after a token is not saved")
+	})
+
+	t.Run("reports the failed rotation of each team", func(t *testing.T) {
+		ctx, authClient := setup(t, `{"ok":false,"error":"invalid_refresh_token"}`, types.AuthByTeamDomain{
+			"T1": {TeamDomain: "workspace-a", TeamID: "T1", Token: "a-token", RefreshToken: "refresh-token", ExpiresAt: now - 60},
+			"T2": {TeamDomain: "workspace-b", TeamID: "T2", Token: "b-token", RefreshToken: "refresh-token", ExpiresAt: now + 60},
+		})
+		refreshes, err := authClient.RefreshTokens(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, refreshes, 2)
+		for _, refresh := range refreshes {
+			require.Error(t, refresh.Err)
+			rotationErr := slackerror.ToSlackError(refresh.Err)
+			assert.Equal(t, slackerror.ErrTokenRotation, rotationErr.Code)
+			assert.Contains(t, rotationErr.Message, refresh.Auth.TeamDomain)
+			require.Len(t, rotationErr.Details, 1)
+			assert.Equal(t, "invalid_refresh_token", rotationErr.Details[0].Code)
+		}
+		assert.Contains(t, slackerror.ToSlackError(refreshes[0].Err).Remediation, "expired")
+	})
+}
+
+// failingSaveStore errors when saving after a number of saves succeed
+type failingSaveStore struct {
+	CredentialStore
+	saves int
+}
+
+func (s *failingSaveStore) Save(ctx context.Context, auths types.AuthByTeamDomain) (string, error) {
+	if s.saves == 0 {
+		return "", slackerror.New(slackerror.ErrCredentialStore).WithMessage("The credential store is locked")
+	}
+	s.saves--
+	return s.CredentialStore.Save(ctx, auths)
+}
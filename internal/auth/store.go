@@ -0,0 +1,179 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+// Names of the credential stores that can save authorizations
+const (
+	CredentialStoreFile      = "file"
+	CredentialStoreEncrypted = "encrypted"
+	CredentialStoreKeyring   = "keyring"
+)
+
+// CredentialStore reads and writes the authorizations of the user
+type CredentialStore interface {
+	// Name returns the name of the credential store
+	Name() string
+	// Load returns the saved authorizations keyed by team
+	Load(ctx context.Context) (types.AuthByTeamDomain, error)
+	// Save replaces the saved authorizations and returns where these were saved
+	Save(ctx context.Context, auths types.AuthByTeamDomain) (string, error)
+}
+
+// credentialStore returns the store that saves authorizations, chosen with the
+// SLACK_CREDENTIAL_STORE environment variable or the "credential_store" value
+// of the system config file
+func (c *Client) credentialStore(ctx context.Context) (CredentialStore, error) {
+	if c.store != nil {
+		return c.store, nil
+	}
+	dir, err := c.config.SystemConfig.SlackConfigDir(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := c.config.CredentialStore
+	if name == "" {
+		if userConfig, err := c.config.SystemConfig.UserConfig(ctx); err == nil {
+			name = userConfig.CredentialStore
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", CredentialStoreFile:
+		c.store = newFileStore(c.fs, dir)
+	case CredentialStoreEncrypted:
+		c.store = newEncryptedFileStore(c.fs, dir, c.credentialKey)
+	case CredentialStoreKeyring:
+		keyring := c.keyring
+		if keyring == nil {
+			keyring, err = newSystemKeyring()
+			if err != nil {
+				return nil, err
+			}
+		}
+		c.store = newKeyringStore(keyring, dir)
+	default:
+		return nil, slackerror.New(slackerror.ErrInvalidCredentialStore).
+			WithMessage("The credential store \"%s\" is not supported", name)
+	}
+	return c.store, nil
+}
+
+// credentialKey returns the passphrase used to encrypt saved authorizations
+// from the environment or a prompt
+func (c *Client) credentialKey(ctx context.Context) (string, error) {
+	if c.config.CredentialKey != "" {
+		return c.config.CredentialKey, nil
+	}
+	if !c.io.IsTTY() {
+		return "", slackerror.New(slackerror.ErrCredentialStore).
+			WithMessage("No passphrase was found for the encrypted credentials file").
+			WithRemediation("Set the passphrase with the SLACK_CREDENTIAL_KEY environment variable")
+	}
+	response, err := c.io.PasswordPrompt(ctx, "Enter the passphrase for saved authorizations", iostreams.PasswordPromptConfig{Required: true})
+	if err != nil {
+		return "", err
+	}
+	c.config.CredentialKey = response.Value
+	return response.Value, nil
+}
+
+// migrateCredentialsFile moves authorizations from the plaintext credentials
+// file into another store then empties the file. Authorizations already in the
+// store are kept over those in the file.
+func (c *Client) migrateCredentialsFile(ctx context.Context, store CredentialStore, auths types.AuthByTeamDomain) (types.AuthByTeamDomain, error) {
+	dir, err := c.config.SystemConfig.SlackConfigDir(ctx)
+	if err != nil {
+		return auths, err
+	}
+	file := newFileStore(c.fs, dir)
+	plaintext, err := file.Load(ctx)
+	if err != nil && !os.IsNotExist(err) {
+		return auths, err
+	}
+	if len(plaintext) == 0 {
+		return auths, nil
+	}
+	migrated := types.AuthByTeamDomain{}
+	for key, auth := range plaintext {
+		migrated[key] = auth
+	}
+	for key, auth := range auths {
+		migrated[key] = auth
+	}
+	location, err := store.Save(ctx, migrated)
+	if err != nil {
+		return auths, err
+	}
+	if _, err := file.Save(ctx, types.AuthByTeamDomain{}); err != nil {
+		return migrated, err
+	}
+	c.io.PrintWarning(ctx, "Moved %d authorizations from %s to %s", len(plaintext), style.HomePath(file.path), style.HomePath(location))
+	return migrated, nil
+}
+
+// fileStore saves authorizations as plaintext JSON in the credentials file
+type fileStore struct {
+	fs   afero.Fs
+	path string
+}
+
+func newFileStore(fs afero.Fs, dir string) *fileStore {
+	return &fileStore{fs: fs, path: filepath.Join(dir, credentialsFileName)}
+}
+
+func (s *fileStore) Name() string {
+	return CredentialStoreFile
+}
+
+// Load reads the credentials file and errors if the file does not exist
+func (s *fileStore) Load(ctx context.Context) (types.AuthByTeamDomain, error) {
+	var auths types.AuthByTeamDomain
+	if _, err := s.fs.Stat(s.path); os.IsNotExist(err) {
+		return auths, err
+	}
+	raw, err := afero.ReadFile(s.fs, s.path)
+	if err != nil {
+		return auths, err
+	}
+	err = json.Unmarshal(raw, &auths)
+	if err != nil {
+		return auths, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse contents of credentials file").
+			WithRootCause(err).
+			WithRemediation("Check that %s is valid JSON", style.HomePath(s.path))
+	}
+	return auths, nil
+}
+
+func (s *fileStore) Save(ctx context.Context, auths types.AuthByTeamDomain) (string, error) {
+	b, err := json.MarshalIndent(auths, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return s.path, afero.WriteFile(s.fs, s.path, b, 0600)
+}
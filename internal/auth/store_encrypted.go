@@ -0,0 +1,176 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+const encryptedCredentialsFileName = "credentials.enc"
+
+// encryptedCredentialsVersion is the format of the encrypted credentials file
+const encryptedCredentialsVersion = 1
+
+// credentialKeyIterations is the number of PBKDF2 rounds that derive the
+// encryption key from a passphrase
+var credentialKeyIterations = 600000
+
+// encryptedCredentials is the encrypted credentials file with the parameters
+// needed to derive the key and decrypt the authorizations
+type encryptedCredentials struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// encryptedFileStore saves authorizations to a file encrypted with AES-GCM
+// and a key derived from a passphrase
+type encryptedFileStore struct {
+	fs         afero.Fs
+	path       string
+	passphrase func(ctx context.Context) (string, error)
+
+	// salt, iterations, and key are kept after the first derivation to avoid
+	// repeating it
+	salt       []byte
+	iterations int
+	key        []byte
+}
+
+func newEncryptedFileStore(fs afero.Fs, dir string, passphrase func(ctx context.Context) (string, error)) *encryptedFileStore {
+	return &encryptedFileStore{
+		fs:         fs,
+		path:       filepath.Join(dir, encryptedCredentialsFileName),
+		passphrase: passphrase,
+	}
+}
+
+func (s *encryptedFileStore) Name() string {
+	return CredentialStoreEncrypted
+}
+
+// Load decrypts the saved authorizations or returns none if no file exists
+func (s *encryptedFileStore) Load(ctx context.Context) (types.AuthByTeamDomain, error) {
+	auths := types.AuthByTeamDomain{}
+	raw, err := afero.ReadFile(s.fs, s.path)
+	if os.IsNotExist(err) {
+		return auths, nil
+	} else if err != nil {
+		return auths, err
+	}
+	var file encryptedCredentials
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return auths, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse contents of the encrypted credentials file").
+			WithRootCause(err).
+			WithRemediation("Check that %s is valid JSON", style.HomePath(s.path))
+	}
+	if file.Version != encryptedCredentialsVersion {
+		return auths, slackerror.New(slackerror.ErrCredentialStore).
+			WithMessage("The encrypted credentials file uses an unknown version %d", file.Version).
+			WithRemediation("Update the Slack CLI to read %s", style.HomePath(s.path))
+	}
+	aead, err := s.cipher(ctx, file.Salt, file.Iterations)
+	if err != nil {
+		return auths, err
+	}
+	plaintext, err := aead.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		s.salt, s.key = nil, nil
+		return auths, slackerror.New(slackerror.ErrCredentialStore).
+			WithMessage("Failed to decrypt the encrypted credentials file").
+			WithRootCause(err).
+			WithRemediation("Check the passphrase set with the SLACK_CREDENTIAL_KEY environment variable")
+	}
+	if err := json.Unmarshal(plaintext, &auths); err != nil {
+		return auths, slackerror.New(slackerror.ErrUnableToParseJSON).
+			WithMessage("Failed to parse the decrypted credentials").
+			WithRootCause(err)
+	}
+	return auths, nil
+}
+
+// Save encrypts the authorizations with a new nonce and replaces the file
+func (s *encryptedFileStore) Save(ctx context.Context, auths types.AuthByTeamDomain) (string, error) {
+	plaintext, err := json.Marshal(auths)
+	if err != nil {
+		return "", err
+	}
+	salt := s.salt
+	if salt == nil {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+	}
+	aead, err := s.cipher(ctx, salt, credentialKeyIterations)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(encryptedCredentials{
+		Version:    encryptedCredentialsVersion,
+		KDF:        "pbkdf2-sha256",
+		Iterations: credentialKeyIterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return s.path, afero.WriteFile(s.fs, s.path, b, 0600)
+}
+
+// cipher derives the key for the salt from the passphrase, reusing the last
+// key if the parameters are unchanged
+func (s *encryptedFileStore) cipher(ctx context.Context, salt []byte, iterations int) (cipher.AEAD, error) {
+	if s.key == nil || s.iterations != iterations || !bytes.Equal(s.salt, salt) {
+		passphrase, err := s.passphrase(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, 32)
+		if err != nil {
+			return nil, slackerror.New(slackerror.ErrCredentialStore).WithRootCause(err)
+		}
+		s.salt, s.iterations, s.key = salt, iterations, key
+	}
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,167 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryKeyring stands in for the system keyring with secrets kept in memory
+type memoryKeyring map[string]string
+
+func (k memoryKeyring) Get(ctx context.Context, service string, account string) (string, error) {
+	secret, ok := k[service+"/"+account]
+	if !ok {
+		return "", errKeyringSecretNotFound
+	}
+	return secret, nil
+}
+
+func (k memoryKeyring) Set(ctx context.Context, service string, account string, secret string) error {
+	k[service+"/"+account] = secret
+	return nil
+}
+
+func Test_CredentialStore(t *testing.T) {
+	credentialKeyIterations = 1000
+	authA := types.SlackAuth{TeamDomain: "workspace-a", TeamID: "T123456789A", Token: "xoxp-a"}
+	authB := types.SlackAuth{TeamDomain: "workspace-b", TeamID: "T123456789B", Token: "xoxp-b"}
+
+	var setup = func(t *testing.T, store string, key string) (context.Context, *Client, *iostreams.IOStreamsMock) {
+		ctx := slackcontext.MockContext(t.Context())
+		fsMock := slackdeps.NewFsMock()
+		osMock := slackdeps.NewOsMock()
+		osMock.AddDefaultMocks()
+		config := config.NewConfig(fsMock, osMock)
+		config.CredentialStore = store
+		config.CredentialKey = key
+		ioMock := iostreams.NewIOStreamsMock(config, fsMock, osMock)
+		ioMock.AddDefaultMocks()
+		authClient := NewClient(nil, nil, config, ioMock, fsMock)
+		authClient.keyring = memoryKeyring{}
+		return ctx, authClient, ioMock
+	}
+
+	var credentialsFile = func(t *testing.T, ctx context.Context, client *Client, name string) string {
+		dir, err := client.config.SystemConfig.SlackConfigDir(ctx)
+		require.NoError(t, err)
+		return filepath.Join(dir, name)
+	}
+
+	for _, name := range []string{CredentialStoreFile, CredentialStoreEncrypted, CredentialStoreKeyring} {
+		t.Run("saves and reads authorizations with the "+name+" store", func(t *testing.T) {
+			ctx, authClient, _ := setup(t, name, "correct horse")
+			_, _, err := authClient.SetAuth(ctx, authA)
+			require.NoError(t, err)
+			_, location, err := authClient.SetAuth(ctx, authB)
+			require.NoError(t, err)
+			assert.NotEmpty(t, location)
+
+			auths, err := authClient.auths(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]types.SlackAuth{authA.TeamID: authA, authB.TeamID: authB}, auths)
+			store, err := authClient.credentialStore(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, name, store.Name())
+		})
+	}
+
+	t.Run("encrypts tokens saved to the encrypted file", func(t *testing.T) {
+		ctx, authClient, _ := setup(t, CredentialStoreEncrypted, "correct horse")
+		_, location, err := authClient.SetAuth(ctx, authA)
+		require.NoError(t, err)
+		assert.Equal(t, credentialsFile(t, ctx, authClient, encryptedCredentialsFileName), location)
+
+		raw, err := afero.ReadFile(authClient.fs, location)
+		require.NoError(t, err)
+		assert.NotContains(t, string(raw), "xoxp-a")
+		var file encryptedCredentials
+		require.NoError(t, json.Unmarshal(raw, &file))
+		assert.Equal(t, encryptedCredentialsVersion, file.Version)
+		assert.Equal(t, "pbkdf2-sha256", file.KDF)
+	})
+
+	t.Run("errors if the passphrase does not decrypt the file", func(t *testing.T) {
+		ctx, authClient, _ := setup(t, CredentialStoreEncrypted, "correct horse")
+		_, _, err := authClient.SetAuth(ctx, authA)
+		require.NoError(t, err)
+
+		authClient.store = nil
+		authClient.config.CredentialKey = "battery staple"
+		_, err = authClient.auths(ctx)
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrCredentialStore, slackerror.ToSlackError(err).Code)
+		assert.Contains(t, err.Error(), "Failed to decrypt")
+	})
+
+	t.Run("prompts for a passphrase when no key is set", func(t *testing.T) {
+		ctx, authClient, ioMock := setup(t, CredentialStoreEncrypted, "")
+		ioMock.On("IsTTY").Unset()
+		ioMock.On("IsTTY").Return(true)
+		ioMock.On("PasswordPrompt", mock.Anything, mock.Anything, iostreams.MatchPromptConfig(iostreams.PasswordPromptConfig{
+			Required: true,
+		})).Return(iostreams.PasswordPromptResponse{Prompt: true, Value: "correct horse"}, nil)
+		_, _, err := authClient.SetAuth(ctx, authA)
+		require.NoError(t, err)
+		_, err = authClient.auths(ctx)
+		require.NoError(t, err)
+		ioMock.AssertNumberOfCalls(t, "PasswordPrompt", 1)
+	})
+
+	t.Run("errors without a passphrase when not interactive", func(t *testing.T) {
+		ctx, authClient, _ := setup(t, CredentialStoreEncrypted, "")
+		_, _, err := authClient.SetAuth(ctx, authA)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "SLACK_CREDENTIAL_KEY")
+	})
+
+	t.Run("moves authorizations from the plaintext credentials file", func(t *testing.T) {
+		ctx, authClient, _ := setup(t, CredentialStoreKeyring, "")
+		path := credentialsFile(t, ctx, authClient, credentialsFileName)
+		plaintext, err := json.Marshal(types.AuthByTeamID{authA.TeamID: authA})
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(authClient.fs, path, plaintext, 0o600))
+
+		auths, err := authClient.Auths(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []types.SlackAuth{authA}, auths)
+		raw, err := afero.ReadFile(authClient.fs, path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(raw), "xoxp-a")
+		keyring := authClient.keyring.(memoryKeyring)
+		assert.Contains(t, keyring[keyringService+"/"+filepath.Dir(path)], "xoxp-a")
+	})
+
+	t.Run("errors for an unknown credential store", func(t *testing.T) {
+		ctx, authClient, _ := setup(t, "vault", "")
+		_, err := authClient.auths(ctx)
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrInvalidCredentialStore, slackerror.ToSlackError(err).Code)
+	})
+}
@@ -24,6 +24,9 @@ import (
 // Environment Variable constants
 const slackAutoRequestAAAEnv = "SLACK_AUTO_REQUEST_AAA"
 const slackConfigDirEnv = "SLACK_CONFIG_DIR"
+const slackCredentialKeyEnv = "SLACK_CREDENTIAL_KEY"
+const slackCredentialStoreEnv = "SLACK_CREDENTIAL_STORE"
+const slackProfileEnv = "SLACK_PROFILE"
 const slackDisableTelemetryEnv = "SLACK_DISABLE_TELEMETRY"
 const slackTestTraceEnv = "SLACK_TEST_TRACE"
 
@@ -50,6 +53,9 @@ type Config struct {
 	DisableTelemetryFlag    bool
 	ForceFlag               bool
 	LogstashHostResolved    string
+	OutputFlag              string
+	ProfileFlag             string
+	ProfileResolved         string
 	RuntimeFlag             string
 	RuntimeName             string
 	RuntimeVersion          string
@@ -69,6 +75,11 @@ type Config struct {
 	DomainAuthTokens string
 	ManifestEnv      map[string]string
 
+	// CredentialStore is the name of the backend that saves authorizations
+	CredentialStore string
+	// CredentialKey is the passphrase that encrypts saved authorizations
+	CredentialKey string
+
 	// ProjectID is uuid for the project
 	ProjectID string
 
@@ -59,6 +59,22 @@ func (c *Config) LoadEnvironmentVariables() error {
 		c.ConfigDirFlag = configDir
 	}
 
+	// Load the auth profile from environment variables unless the flag is set
+	var profile = strings.TrimSpace(c.os.Getenv(slackProfileEnv))
+	if profile != "" && c.ProfileFlag == "" {
+		c.ProfileFlag = profile
+	}
+
+	// Load the credential store and encryption passphrase from environment variables
+	var credentialStore = strings.TrimSpace(c.os.Getenv(slackCredentialStoreEnv))
+	if credentialStore != "" {
+		c.CredentialStore = credentialStore
+	}
+	var credentialKey = c.os.Getenv(slackCredentialKeyEnv)
+	if credentialKey != "" {
+		c.CredentialKey = credentialKey
+	}
+
 	// Disable telemetry if either disable-telemetry or test-version environment variables
 	var disableTelemetry = strings.TrimSpace(c.os.Getenv(slackDisableTelemetryEnv))
 	var testVersion = strings.TrimSpace(c.os.Getenv(version.EnvTestVersion))
@@ -205,6 +205,27 @@ func Test_DotEnv_LoadEnvironmentVariables(t *testing.T) {
 				assert.Equal(t, "", cfg.ConfigDirFlag)
 			},
 		},
+		"SLACK_PROFILE=service should set the profile flag": {
+			envName:  "SLACK_PROFILE",
+			envValue: "service",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "service", cfg.ProfileFlag)
+			},
+		},
+		"SLACK_CREDENTIAL_STORE=keyring should set the credential store": {
+			envName:  "SLACK_CREDENTIAL_STORE",
+			envValue: "  keyring ",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "keyring", cfg.CredentialStore)
+			},
+		},
+		"SLACK_CREDENTIAL_KEY should set the credential key without trimming": {
+			envName:  "SLACK_CREDENTIAL_KEY",
+			envValue: " correct horse ",
+			assertOnConfig: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, " correct horse ", cfg.CredentialKey)
+			},
+		},
 	}
 
 	for name, tt := range tableTests {
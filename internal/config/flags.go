@@ -39,6 +39,8 @@ func (c *Config) InitializeGlobalFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringSliceVarP(&c.ExperimentsFlag, "experiment", "e", nil, "use the experiment(s) in the command")
 	cmd.PersistentFlags().BoolVarP(&c.ForceFlag, "force", "f", false, "ignore warnings and continue executing command")
 	cmd.PersistentFlags().BoolVarP(&c.NoColor, "no-color", "", false, "remove styles and formatting from outputs")
+	cmd.PersistentFlags().StringVarP(&c.OutputFlag, "output", "", "text", "output format: text, json, yaml, template=<go template>")
+	cmd.PersistentFlags().StringVarP(&c.ProfileFlag, "profile", "", "", "select a saved login by profile name")
 	cmd.PersistentFlags().BoolVarP(&c.SkipUpdateFlag, "skip-update", "s", false, "skip checking for latest version of CLI")
 	cmd.PersistentFlags().BoolVarP(&c.SlackDevFlag, "slackdev", "", false, "shorthand for --apihost=https://dev.slack.com")
 	cmd.PersistentFlags().StringVarP(&c.RuntimeFlag, "runtime", "r", "", "the project's runtime language:\n  deno (default), deno1.1, deno1.x, etc")
@@ -53,6 +53,7 @@ type ProjectConfigManager interface {
 	SetProjectID(ctx context.Context, projectID string) (string, error)
 	GetManifestSource(ctx context.Context) (ManifestSource, error)
 	SetManifestSource(ctx context.Context, source ManifestSource) error
+	GetProfile(ctx context.Context, environment string) (string, error)
 	GetSurveyConfig(ctx context.Context, name string) (SurveyConfig, error)
 	SetSurveyConfig(ctx context.Context, name string, surveyConfig SurveyConfig) error
 	ReadProjectConfigFile(ctx context.Context) (ProjectConfig, error)
@@ -70,6 +71,10 @@ type ProjectConfig struct {
 	ProjectID   string                  `json:"project_id,omitempty"`
 	Surveys     map[string]SurveyConfig `json:"surveys,omitempty"`
 
+	// Profiles are the auth profiles used by default for the "deployed" and
+	// "local" app environments of the project
+	Profiles map[string]string `json:"profiles,omitempty"`
+
 	// fs is the file system module that's shared by all packages and enables testing & mock of the file system
 	fs afero.Fs
 
@@ -194,6 +199,20 @@ func (c *ProjectConfig) SetManifestSource(ctx context.Context, source ManifestSo
 	return nil
 }
 
+// GetProfile returns the auth profile pinned to an app environment of the
+// project or an empty string if none is pinned
+func (c *ProjectConfig) GetProfile(ctx context.Context, environment string) (string, error) {
+	var span opentracing.Span
+	span, ctx = opentracing.StartSpanFromContext(ctx, "GetProfile")
+	defer span.Finish()
+
+	var projectConfig, err = c.ReadProjectConfigFile(ctx)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(projectConfig.Profiles[environment]), nil
+}
+
 // GetSurveyConfig reads the survey for the given survey ID from the project-level config file
 func (c *ProjectConfig) GetSurveyConfig(ctx context.Context, name string) (SurveyConfig, error) {
 	var span opentracing.Span
@@ -31,6 +31,7 @@ func NewProjectConfigMock() *ProjectConfigMock {
 
 func (m *ProjectConfigMock) AddDefaultMocks() {
 	m.On("GetManifestSource", mock.Anything).Return(ManifestSourceLocal, nil)
+	m.On("GetProfile", mock.Anything, mock.Anything).Return("", nil)
 }
 
 func (m *ProjectConfigMock) InitProjectID(ctx context.Context, overwriteExistingProjectID bool) (string, error) {
@@ -58,6 +59,11 @@ func (m *ProjectConfigMock) SetManifestSource(ctx context.Context, source Manife
 	return args.Error(0)
 }
 
+func (m *ProjectConfigMock) GetProfile(ctx context.Context, environment string) (string, error) {
+	args := m.Called(ctx, environment)
+	return args.String(0), args.Error(1)
+}
+
 func (m *ProjectConfigMock) GetSurveyConfig(ctx context.Context, id string) (SurveyConfig, error) {
 	args := m.Called(ctx, id)
 	return args.Get(0).(SurveyConfig), args.Error(1)
@@ -272,6 +272,47 @@ func Test_ProjectConfig_ManifestSource(t *testing.T) {
 	}
 }
 
+func Test_ProjectConfig_GetProfile(t *testing.T) {
+	tests := map[string]struct {
+		profiles        map[string]string
+		environment     string
+		expectedProfile string
+	}{
+		"returns the profile pinned to the environment": {
+			profiles:        map[string]string{"deployed": "service", "local": "personal"},
+			environment:     "deployed",
+			expectedProfile: "service",
+		},
+		"returns no profile if the environment is not pinned": {
+			profiles:        map[string]string{"local": "personal"},
+			environment:     "deployed",
+			expectedProfile: "",
+		},
+		"returns no profile without profiles": {
+			environment:     "local",
+			expectedProfile: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.AddDefaultMocks()
+			addProjectMocks(t, fs)
+			config := NewProjectConfig(fs, os)
+			projectConfig, err := config.ReadProjectConfigFile(ctx)
+			require.NoError(t, err)
+			projectConfig.Profiles = tt.profiles
+			_, err = config.WriteProjectConfigFile(ctx, projectConfig)
+			require.NoError(t, err)
+			actual, err := config.GetProfile(ctx, tt.environment)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedProfile, actual)
+		})
+	}
+}
+
 func Test_ProjectConfig_ReadProjectConfigFile(t *testing.T) {
 	t.Run("When not a project directory, should return an error", func(t *testing.T) {
 		ctx := slackcontext.MockContext(t.Context())
@@ -748,7 +789,7 @@ func Test_Config_CreateProjectConfigDirDotGitIgnoreFile(t *testing.T) {
 			existingDotGitIgnoreFileData: "",
 			expectedError:                nil,
 			expectedDotGitIgnoreFilePath: "/path/to/project-name/.slack/.gitignore",
-			expectedDotGitIgnoreFileData: "apps.dev.json\ncache/\n",
+			expectedDotGitIgnoreFileData: "apps.dev.json\nactivity/\ncache/\ndatastores/\nfixtures/\nimports/\n",
 		},
 		"Existing slack/hooks.json": {
 			projectDirPath:               "/path/to/project-name",
@@ -63,6 +63,7 @@ type SystemConfigManager interface {
 
 // SystemConfig contains the system-level config file
 type SystemConfig struct {
+	CredentialStore     string                  `json:"credential_store,omitempty"`
 	Experiments         []experiment.Experiment `json:"experiments,omitempty"`
 	LastUpdateCheckedAt time.Time               `json:"last_update_checked_at,omitempty"`
 	Surveys             map[string]SurveyConfig `json:"surveys,omitempty"`
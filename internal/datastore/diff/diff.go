@@ -0,0 +1,140 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff compares the items of two datastores by primary key.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Changed is an item stored in both datastores with different attributes
+type Changed struct {
+	Key        string                 `json:"key"`
+	Attributes []string               `json:"attributes"`
+	Source     map[string]interface{} `json:"source"`
+	Target     map[string]interface{} `json:"target"`
+}
+
+// Result lists the changes that make the target datastore match the source.
+// Items are ordered by primary key.
+type Result struct {
+	PrimaryKey string `json:"primary_key"`
+	// Added items are stored in the source but not the target
+	Added []map[string]interface{} `json:"added"`
+	// Removed items are stored in the target but not the source
+	Removed   []map[string]interface{} `json:"removed"`
+	Changed   []Changed                `json:"changed"`
+	Unchanged int                      `json:"unchanged"`
+	// Skipped counts items without a primary key that cannot be compared
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// Empty returns true if the datastores have the same items
+func (r Result) Empty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0
+}
+
+// Key returns the primary key of an item as text and false if the item has no
+// primary key
+func Key(item map[string]interface{}, primaryKey string) (string, bool) {
+	value, exists := item[primaryKey]
+	if !exists || value == nil {
+		return "", false
+	}
+	if text, ok := value.(string); ok {
+		return text, true
+	}
+	return fmt.Sprint(value), true
+}
+
+// Items compares items of the source datastore with the target datastore
+func Items(source []map[string]interface{}, target []map[string]interface{}, primaryKey string) Result {
+	result := Result{
+		PrimaryKey: primaryKey,
+		Added:      []map[string]interface{}{},
+		Removed:    []map[string]interface{}{},
+		Changed:    []Changed{},
+	}
+	sourceItems, skipped := index(source, primaryKey)
+	result.Skipped += skipped
+	targetItems, skipped := index(target, primaryKey)
+	result.Skipped += skipped
+
+	for _, key := range sortedKeys(sourceItems) {
+		item := sourceItems[key]
+		existing, exists := targetItems[key]
+		switch {
+		case !exists:
+			result.Added = append(result.Added, item)
+		case reflect.DeepEqual(item, existing):
+			result.Unchanged++
+		default:
+			result.Changed = append(result.Changed, Changed{
+				Key:        key,
+				Attributes: changedAttributes(item, existing),
+				Source:     item,
+				Target:     existing,
+			})
+		}
+	}
+	for _, key := range sortedKeys(targetItems) {
+		if _, exists := sourceItems[key]; !exists {
+			result.Removed = append(result.Removed, targetItems[key])
+		}
+	}
+	return result
+}
+
+func index(items []map[string]interface{}, primaryKey string) (map[string]map[string]interface{}, int) {
+	indexed := make(map[string]map[string]interface{}, len(items))
+	skipped := 0
+	for _, item := range items {
+		key, ok := Key(item, primaryKey)
+		if !ok {
+			skipped++
+			continue
+		}
+		indexed[key] = item
+	}
+	return indexed, skipped
+}
+
+func sortedKeys(items map[string]map[string]interface{}) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// changedAttributes returns the names of attributes with different values
+func changedAttributes(source map[string]interface{}, target map[string]interface{}) []string {
+	attributes := []string{}
+	for name, value := range source {
+		if other, exists := target[name]; !exists || !reflect.DeepEqual(value, other) {
+			attributes = append(attributes, name)
+		}
+	}
+	for name := range target {
+		if _, exists := source[name]; !exists {
+			attributes = append(attributes, name)
+		}
+	}
+	sort.Strings(attributes)
+	return attributes
+}
@@ -0,0 +1,65 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Items(t *testing.T) {
+	source := []map[string]interface{}{
+		{"id": "3", "status": "done"},
+		{"id": "1", "status": "open", "points": float64(2)},
+		{"id": "2", "status": "open"},
+		{"status": "orphan"},
+	}
+	target := []map[string]interface{}{
+		{"id": "1", "status": "done", "owner": "U01"},
+		{"id": "2", "status": "open"},
+		{"id": "4", "status": "open"},
+	}
+	result := Items(source, target, "id")
+	assert.Equal(t, Result{
+		PrimaryKey: "id",
+		Added: []map[string]interface{}{
+			{"id": "3", "status": "done"},
+		},
+		Removed: []map[string]interface{}{
+			{"id": "4", "status": "open"},
+		},
+		Changed: []Changed{
+			{
+				Key:        "1",
+				Attributes: []string{"owner", "points", "status"},
+				Source:     map[string]interface{}{"id": "1", "status": "open", "points": float64(2)},
+				Target:     map[string]interface{}{"id": "1", "status": "done", "owner": "U01"},
+			},
+		},
+		Unchanged: 1,
+		Skipped:   1,
+	}, result)
+	assert.False(t, result.Empty())
+	assert.True(t, Items(target, target, "id").Empty())
+}
+
+func Test_Key(t *testing.T) {
+	key, ok := Key(map[string]interface{}{"id": float64(42)}, "id")
+	assert.True(t, ok)
+	assert.Equal(t, "42", key)
+	_, ok = Key(map[string]interface{}{"id": nil}, "id")
+	assert.False(t, ok)
+}
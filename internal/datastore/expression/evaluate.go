@@ -0,0 +1,292 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Evaluate checks if an item matches a condition. A nil condition matches
+// every item and attributes that are missing from the item never match.
+func Evaluate(condition Condition, item map[string]interface{}, attributes map[string]interface{}, values map[string]interface{}) bool {
+	if condition == nil {
+		return true
+	}
+	e := evaluator{item: item, attributes: attributes, values: values}
+	return e.condition(condition)
+}
+
+type evaluator struct {
+	item       map[string]interface{}
+	attributes map[string]interface{}
+	values     map[string]interface{}
+}
+
+func (e evaluator) condition(condition Condition) bool {
+	switch c := condition.(type) {
+	case *Logical:
+		if c.Operator == "AND" {
+			return e.condition(c.Left) && e.condition(c.Right)
+		}
+		return e.condition(c.Left) || e.condition(c.Right)
+	case *Not:
+		return !e.condition(c.Condition)
+	case *Comparison:
+		left, ok := e.operand(c.Left)
+		if !ok {
+			return false
+		}
+		right, ok := e.operand(c.Right)
+		if !ok {
+			return false
+		}
+		return compare(left, c.Operator, right)
+	case *Between:
+		value, ok := e.operand(c.Operand)
+		if !ok {
+			return false
+		}
+		low, ok := e.operand(c.Low)
+		if !ok {
+			return false
+		}
+		high, ok := e.operand(c.High)
+		if !ok {
+			return false
+		}
+		return compare(value, ">=", low) && compare(value, "<=", high)
+	case *In:
+		value, ok := e.operand(c.Operand)
+		if !ok {
+			return false
+		}
+		for _, operand := range c.List {
+			if candidate, ok := e.operand(operand); ok && compare(value, "=", candidate) {
+				return true
+			}
+		}
+		return false
+	case *Function:
+		return e.function(c)
+	}
+	return false
+}
+
+func (e evaluator) function(function *Function) bool {
+	value, exists := e.operand(function.Args[0])
+	switch function.Name {
+	case "attribute_exists":
+		return exists
+	case "attribute_not_exists":
+		return !exists
+	}
+	if !exists {
+		return false
+	}
+	arg, ok := e.operand(function.Args[1])
+	if !ok {
+		return false
+	}
+	switch function.Name {
+	case "attribute_type":
+		expected, ok := arg.(string)
+		return ok && attributeType(value) == expected
+	case "begins_with":
+		s, ok := value.(string)
+		prefix, isString := arg.(string)
+		return ok && isString && strings.HasPrefix(s, prefix)
+	case "contains":
+		switch v := value.(type) {
+		case string:
+			substring, ok := arg.(string)
+			return ok && strings.Contains(v, substring)
+		case []interface{}:
+			for _, element := range v {
+				if compare(element, "=", arg) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// operand returns the value of an operand and if that value exists
+func (e evaluator) operand(operand Operand) (interface{}, bool) {
+	switch o := operand.(type) {
+	case *Value:
+		value, ok := e.values[o.Name]
+		return value, ok
+	case *Size:
+		value, ok := e.operand(o.Path)
+		if !ok {
+			return nil, false
+		}
+		switch v := value.(type) {
+		case string:
+			return float64(len(v)), true
+		case []interface{}:
+			return float64(len(v)), true
+		case map[string]interface{}:
+			return float64(len(v)), true
+		}
+		return nil, false
+	case *Path:
+		var current interface{} = e.item
+		for _, segment := range o.Segments {
+			if segment.IsList {
+				list, ok := current.([]interface{})
+				if !ok || segment.Index >= len(list) {
+					return nil, false
+				}
+				current = list[segment.Index]
+				continue
+			}
+			name := segment.Name
+			if strings.HasPrefix(name, "#") {
+				resolved, ok := e.attributes[name].(string)
+				if !ok {
+					return nil, false
+				}
+				name = resolved
+			}
+			object, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if current, ok = object[name]; !ok {
+				return nil, false
+			}
+		}
+		return current, true
+	}
+	return nil, false
+}
+
+// compare orders numbers and strings and checks other values for equality
+func compare(left interface{}, operator string, right interface{}) bool {
+	var order int
+	switch l := left.(type) {
+	case float64:
+		r, ok := toFloat(right)
+		if !ok {
+			return operator == "<>"
+		}
+		switch {
+		case l < r:
+			order = -1
+		case l > r:
+			order = 1
+		}
+	case string:
+		switch right.(type) {
+		case string:
+		case bool:
+			// string values are read as the boolean of the attribute
+			if lb, ok := toBool(l); ok {
+				return compare(lb, operator, right)
+			}
+			return operator == "<>"
+		default:
+			// string values are read as the number of the attribute
+			if lf, ok := toFloat(l); ok {
+				return compare(lf, operator, right)
+			}
+			return operator == "<>"
+		}
+		order = strings.Compare(l, right.(string))
+	default:
+		if lf, ok := toFloat(left); ok {
+			return compare(lf, operator, right)
+		}
+		if _, ok := left.(bool); ok {
+			if rb, ok := toBool(right); ok {
+				right = rb
+			}
+		}
+		equal := reflect.DeepEqual(left, right)
+		switch operator {
+		case "=":
+			return equal
+		case "<>":
+			return !equal
+		}
+		return false
+	}
+	switch operator {
+	case "=":
+		return order == 0
+	case "<>":
+		return order != 0
+	case "<":
+		return order < 0
+	case "<=":
+		return order <= 0
+	case ">":
+		return order > 0
+	case ">=":
+		return order >= 0
+	}
+	return false
+}
+
+// toFloat reads numbers and the strings that the validator accepts as numbers
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// toBool reads booleans and the strings that the validator accepts as booleans
+func toBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	}
+	return false, false
+}
+
+// attributeType returns the DynamoDB type descriptor of a decoded JSON value
+func attributeType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "S"
+	case float64, int, int64:
+		return "N"
+	case bool:
+		return "BOOL"
+	case []interface{}:
+		return "L"
+	case map[string]interface{}:
+		return "M"
+	case nil:
+		return "NULL"
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Evaluate(t *testing.T) {
+	item := map[string]interface{}{
+		"id":     "task-1",
+		"status": "todo",
+		"points": float64(5),
+		"done":   false,
+		"tags":   []interface{}{"urgent", "backend"},
+		"owner":  map[string]interface{}{"name": "Ada", "teams": []interface{}{"core"}},
+	}
+	attributes := map[string]interface{}{
+		"#id":     "id",
+		"#status": "status",
+		"#points": "points",
+		"#done":   "done",
+		"#tags":   "tags",
+		"#owner":  "owner",
+	}
+	values := map[string]interface{}{
+		":todo":   "todo",
+		":done":   "done",
+		":one":    float64(1),
+		":five":   float64(5),
+		":ten":    float64(10),
+		":false":  false,
+		":task":   "task-",
+		":urgent": "urgent",
+		":ada":    "Ada",
+		":core":   "core",
+		":string": "S",
+		":list":   "L",
+	}
+	tests := map[string]struct {
+		expression string
+		expected   bool
+	}{
+		"empty expression matches":            {expression: "", expected: true},
+		"string equality":                     {expression: "#status = :todo", expected: true},
+		"string inequality":                   {expression: "#status <> :todo", expected: false},
+		"number ordering":                     {expression: "#points > :one AND #points <= :five", expected: true},
+		"boolean equality":                    {expression: "#done = :false", expected: true},
+		"OR of conditions":                    {expression: "#status = :done OR #points = :five", expected: true},
+		"NOT of a condition":                  {expression: "NOT #status = :done", expected: true},
+		"BETWEEN is inclusive":                {expression: "#points BETWEEN :five AND :ten", expected: true},
+		"IN a list of values":                 {expression: "#status IN (:done, :todo)", expected: true},
+		"begins_with a prefix":                {expression: "begins_with(#id, :task)", expected: true},
+		"contains an element of a list":       {expression: "contains(#tags, :urgent)", expected: true},
+		"contains a substring":                {expression: "contains(#status, :done)", expected: false},
+		"nested map attribute":                {expression: "#owner.name = :ada", expected: true},
+		"nested list index":                   {expression: "#owner.teams[0] = :core", expected: true},
+		"list index out of range":             {expression: "#tags[5] = :urgent", expected: false},
+		"size of a list":                      {expression: "size(#tags) > :one", expected: true},
+		"attribute exists":                    {expression: "attribute_exists(#owner.name)", expected: true},
+		"attribute not exists":                {expression: "attribute_not_exists(#owner.email)", expected: true},
+		"attribute type":                      {expression: "attribute_type(#status, :string) AND attribute_type(#tags, :list)", expected: true},
+		"missing attribute does not match":    {expression: "#owner.email = :ada", expected: false},
+		"missing attribute is not unequal":    {expression: "#owner.email <> :ada", expected: false},
+		"mismatched types are never equal":    {expression: "#points = :todo", expected: false},
+		"mismatched types are always unequal": {expression: "#points <> :todo", expected: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			condition, err := Parse(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, Evaluate(condition, item, attributes, values))
+		})
+	}
+}
+
+func Test_Evaluate_CoercibleValues(t *testing.T) {
+	datastore := &types.ManifestDatastore{
+		PrimaryKey: "id",
+		Attributes: map[string]types.ManifestAttribute{
+			"id":    {Type: "string"},
+			"count": {Type: "integer"},
+			"score": {Type: "number"},
+			"done":  {Type: "boolean"},
+		},
+	}
+	item := map[string]interface{}{
+		"id":    "task-1",
+		"count": float64(1),
+		"score": float64(2.5),
+		"done":  true,
+	}
+	tests := map[string]struct {
+		expression string
+		values     map[string]interface{}
+		expected   bool
+	}{
+		"integer attribute and numeric string": {
+			expression: "#count < :v",
+			values:     map[string]interface{}{":v": "3"},
+			expected:   true,
+		},
+		"numeric string and integer attribute": {
+			expression: ":v > #count",
+			values:     map[string]interface{}{":v": "3"},
+			expected:   true,
+		},
+		"number attribute and decimal string": {
+			expression: "#score = :v",
+			values:     map[string]interface{}{":v": "2.5"},
+			expected:   true,
+		},
+		"decimal string and number attribute": {
+			expression: ":v >= #score",
+			values:     map[string]interface{}{":v": "2.4"},
+			expected:   false,
+		},
+		"number attribute between numeric strings": {
+			expression: "#score BETWEEN :low AND :high",
+			values:     map[string]interface{}{":low": "1", ":high": "3"},
+			expected:   true,
+		},
+		"boolean attribute and boolean string": {
+			expression: "#done = :v",
+			values:     map[string]interface{}{":v": "true"},
+			expected:   true,
+		},
+		"boolean string and boolean attribute": {
+			expression: ":v <> #done",
+			values:     map[string]interface{}{":v": "false"},
+			expected:   true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			attributes := map[string]interface{}{"#count": "count", "#score": "score", "#done": "done"}
+			for key := range attributes {
+				if !strings.Contains(tt.expression, key) {
+					delete(attributes, key)
+				}
+			}
+			condition, err := Validate(Query{Expression: tt.expression, Attributes: attributes, Values: tt.values}, datastore)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, Evaluate(condition, item, attributes, tt.values))
+		})
+	}
+}
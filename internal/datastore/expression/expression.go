@@ -0,0 +1,442 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expression parses, validates, and evaluates the condition expressions
+// used to query and count items in a datastore.
+//
+// The grammar follows DynamoDB condition expressions:
+//
+//	condition  = operand comparator operand
+//	           | operand BETWEEN operand AND operand
+//	           | operand IN ( operand {, operand} )
+//	           | function
+//	           | condition AND condition
+//	           | condition OR condition
+//	           | NOT condition
+//	           | ( condition )
+//	comparator = "=" | "<>" | "<" | "<=" | ">" | ">="
+//	function   = attribute_exists ( path )
+//	           | attribute_not_exists ( path )
+//	           | attribute_type ( path , value )
+//	           | begins_with ( path , operand )
+//	           | contains ( path , operand )
+//	operand    = path | value | size ( path )
+//	path       = name { . name | [ index ] }
+//	name       = #attribute | attribute
+//	value      = :value
+package expression
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error describes a problem with an expression and the position of the token
+// that caused it
+type Error struct {
+	Expression string
+	Message    string
+	// Offset is the byte offset of the offending token or -1 when the error
+	// is not caused by a single token
+	Offset int
+}
+
+// Error returns the message and position of the error
+func (e *Error) Error() string {
+	if e.Offset < 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s at position %d", e.Message, e.Offset+1)
+}
+
+// Pointer returns the expression with a caret beneath the offending token
+func (e *Error) Pointer() string {
+	if e.Offset < 0 {
+		return e.Expression
+	}
+	return e.Expression + "\n" + strings.Repeat(" ", e.Offset) + "^"
+}
+
+// Condition is a node of an expression that evaluates to true or false
+type Condition interface {
+	condition()
+}
+
+// Operand is a node of an expression that evaluates to a value
+type Operand interface {
+	operand()
+	offset() int
+}
+
+// Logical joins two conditions with AND or OR
+type Logical struct {
+	Operator string
+	Left     Condition
+	Right    Condition
+}
+
+// Not negates a condition
+type Not struct {
+	Condition Condition
+}
+
+// Comparison compares two operands with a comparator
+type Comparison struct {
+	Operator string
+	Left     Operand
+	Right    Operand
+}
+
+// Between checks that an operand is within an inclusive range
+type Between struct {
+	Operand Operand
+	Low     Operand
+	High    Operand
+}
+
+// In checks that an operand equals one of a list of operands
+type In struct {
+	Operand Operand
+	List    []Operand
+}
+
+// Function is a condition function such as begins_with or contains
+type Function struct {
+	Name   string
+	Args   []Operand
+	Offset int
+}
+
+// Path references an attribute of an item
+type Path struct {
+	Segments []Segment
+}
+
+// Segment is a part of a path that is either a name or a list index
+type Segment struct {
+	Name   string // Name is "#placeholder" or an attribute name
+	Index  int
+	IsList bool
+	Offset int
+}
+
+// Value references a value from the expression values
+type Value struct {
+	Name   string
+	Offset int
+}
+
+// Size returns the size of the attribute at a path
+type Size struct {
+	Path   *Path
+	Offset int
+}
+
+func (*Logical) condition()    {}
+func (*Not) condition()        {}
+func (*Comparison) condition() {}
+func (*Between) condition()    {}
+func (*In) condition()         {}
+func (*Function) condition()   {}
+
+func (*Path) operand()  {}
+func (*Value) operand() {}
+func (*Size) operand()  {}
+
+func (p *Path) offset() int  { return p.Segments[0].Offset }
+func (v *Value) offset() int { return v.Offset }
+func (s *Size) offset() int  { return s.Offset }
+
+// String returns the path as written in the expression
+func (p *Path) String() string {
+	var b strings.Builder
+	for i, segment := range p.Segments {
+		switch {
+		case segment.IsList:
+			fmt.Fprintf(&b, "[%d]", segment.Index)
+		case i > 0:
+			b.WriteString("." + segment.Name)
+		default:
+			b.WriteString(segment.Name)
+		}
+	}
+	return b.String()
+}
+
+// conditionFunctions are the functions that can be used as conditions and the
+// number of arguments each function expects
+var conditionFunctions = map[string]int{
+	"attribute_exists":     1,
+	"attribute_not_exists": 1,
+	"attribute_type":       2,
+	"begins_with":          2,
+	"contains":             2,
+}
+
+// Parse returns the condition described by an expression. An empty expression
+// returns a nil condition that matches every item.
+func Parse(expression string) (Condition, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{expression: expression, tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+	condition, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, "Unexpected %s", next)
+	}
+	return condition, nil
+}
+
+// parser builds conditions from tokens with recursive descent in order of
+// precedence from OR, AND, NOT, then comparisons and functions
+type parser struct {
+	expression string
+	tokens     []token
+	position   int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.position]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.position]
+	if t.kind != tokenEOF {
+		p.position++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) *Error {
+	return &Error{
+		Expression: p.expression,
+		Message:    fmt.Sprintf(format, args...),
+		Offset:     t.offset,
+	}
+}
+
+func (p *parser) expect(kind tokenKind, text string) (token, error) {
+	t := p.next()
+	if t.kind != kind || (text != "" && t.text != text) {
+		expected := text
+		if expected == "" {
+			expected = kind.String()
+		}
+		return t, p.errorf(t, "Expected %s but found %s", expected, t)
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (Condition, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Operator: "OR", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Condition, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isKeyword("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Operator: "AND", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Condition, error) {
+	if p.peek().isKeyword("NOT") {
+		p.next()
+		condition, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Condition: condition}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Condition, error) {
+	t := p.peek()
+	if t.kind == tokenSymbol && t.text == "(" {
+		p.next()
+		condition, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenSymbol, ")"); err != nil {
+			return nil, err
+		}
+		return condition, nil
+	}
+	if t.kind == tokenIdentifier && p.tokens[p.position+1].text == "(" {
+		if _, ok := conditionFunctions[strings.ToLower(t.text)]; ok {
+			return p.parseFunction()
+		}
+	}
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	t = p.next()
+	switch {
+	case t.kind == tokenComparator:
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &Comparison{Operator: t.text, Left: left, Right: right}, nil
+	case t.isKeyword("BETWEEN"):
+		low, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if and := p.next(); !and.isKeyword("AND") {
+			return nil, p.errorf(and, "Expected AND but found %s", and)
+		}
+		high, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &Between{Operand: left, Low: low, High: high}, nil
+	case t.isKeyword("IN"):
+		if _, err := p.expect(tokenSymbol, "("); err != nil {
+			return nil, err
+		}
+		in := &In{Operand: left}
+		for {
+			operand, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			in.List = append(in.List, operand)
+			separator := p.next()
+			if separator.kind == tokenSymbol && separator.text == ")" {
+				return in, nil
+			}
+			if separator.kind != tokenSymbol || separator.text != "," {
+				return nil, p.errorf(separator, "Expected , or ) but found %s", separator)
+			}
+		}
+	default:
+		return nil, p.errorf(t, "Expected a comparator, BETWEEN, or IN but found %s", t)
+	}
+}
+
+func (p *parser) parseFunction() (Condition, error) {
+	name := p.next()
+	p.next() // (
+	function := &Function{Name: strings.ToLower(name.text), Offset: name.offset}
+	for {
+		operand, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if len(function.Args) == 0 {
+			if _, ok := operand.(*Path); !ok {
+				return nil, &Error{Expression: p.expression, Message: fmt.Sprintf("The first argument of %s must be an attribute", function.Name), Offset: operand.offset()}
+			}
+		}
+		function.Args = append(function.Args, operand)
+		separator := p.next()
+		if separator.kind == tokenSymbol && separator.text == ")" {
+			break
+		}
+		if separator.kind != tokenSymbol || separator.text != "," {
+			return nil, p.errorf(separator, "Expected , or ) but found %s", separator)
+		}
+	}
+	if expected := conditionFunctions[function.Name]; len(function.Args) != expected {
+		return nil, p.errorf(name, "The function %s expects %d arguments but found %d", function.Name, expected, len(function.Args))
+	}
+	return function, nil
+}
+
+func (p *parser) parseOperand() (Operand, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tokenValue:
+		p.next()
+		return &Value{Name: t.text, Offset: t.offset}, nil
+	case t.kind == tokenIdentifier && strings.EqualFold(t.text, "size") && p.tokens[p.position+1].text == "(":
+		p.next()
+		p.next()
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenSymbol, ")"); err != nil {
+			return nil, err
+		}
+		return &Size{Path: path, Offset: t.offset}, nil
+	case t.kind == tokenName, t.kind == tokenIdentifier && !t.isReserved():
+		return p.parsePath()
+	default:
+		return nil, p.errorf(t, "Expected an attribute or value but found %s", t)
+	}
+}
+
+func (p *parser) parsePath() (*Path, error) {
+	t := p.next()
+	if t.kind != tokenName && (t.kind != tokenIdentifier || t.isReserved()) {
+		return nil, p.errorf(t, "Expected an attribute but found %s", t)
+	}
+	path := &Path{Segments: []Segment{{Name: t.text, Offset: t.offset}}}
+	for {
+		t = p.peek()
+		switch {
+		case t.kind == tokenSymbol && t.text == ".":
+			p.next()
+			name := p.next()
+			if name.kind != tokenName && name.kind != tokenIdentifier {
+				return nil, p.errorf(name, "Expected an attribute but found %s", name)
+			}
+			path.Segments = append(path.Segments, Segment{Name: name.text, Offset: name.offset})
+		case t.kind == tokenSymbol && t.text == "[":
+			p.next()
+			index, err := p.expect(tokenNumber, "")
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokenSymbol, "]"); err != nil {
+				return nil, err
+			}
+			path.Segments = append(path.Segments, Segment{Index: index.number, IsList: true, Offset: index.offset})
+		default:
+			return path, nil
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := map[string]struct {
+		expression        string
+		expectedCondition Condition
+	}{
+		"empty expression has no condition": {
+			expression:        "  ",
+			expectedCondition: nil,
+		},
+		"comparison of a placeholder and a value": {
+			expression: "#status = :status",
+			expectedCondition: &Comparison{
+				Operator: "=",
+				Left:     &Path{Segments: []Segment{{Name: "#status", Offset: 0}}},
+				Right:    &Value{Name: ":status", Offset: 10},
+			},
+		},
+		"AND binds tighter than OR": {
+			expression: "a = :a OR b = :b AND c = :c",
+			expectedCondition: &Logical{
+				Operator: "OR",
+				Left: &Comparison{
+					Operator: "=",
+					Left:     &Path{Segments: []Segment{{Name: "a", Offset: 0}}},
+					Right:    &Value{Name: ":a", Offset: 4},
+				},
+				Right: &Logical{
+					Operator: "AND",
+					Left: &Comparison{
+						Operator: "=",
+						Left:     &Path{Segments: []Segment{{Name: "b", Offset: 10}}},
+						Right:    &Value{Name: ":b", Offset: 14},
+					},
+					Right: &Comparison{
+						Operator: "=",
+						Left:     &Path{Segments: []Segment{{Name: "c", Offset: 21}}},
+						Right:    &Value{Name: ":c", Offset: 25},
+					},
+				},
+			},
+		},
+		"keywords are case insensitive": {
+			expression: "not #a between :low and :high",
+			expectedCondition: &Not{Condition: &Between{
+				Operand: &Path{Segments: []Segment{{Name: "#a", Offset: 4}}},
+				Low:     &Value{Name: ":low", Offset: 15},
+				High:    &Value{Name: ":high", Offset: 24},
+			}},
+		},
+		"IN with a list of values": {
+			expression: "#a IN (:x, :y)",
+			expectedCondition: &In{
+				Operand: &Path{Segments: []Segment{{Name: "#a", Offset: 0}}},
+				List:    []Operand{&Value{Name: ":x", Offset: 7}, &Value{Name: ":y", Offset: 11}},
+			},
+		},
+		"functions with nested paths and size": {
+			expression: "begins_with(#a.b[2], :p) AND size(#c) > :n",
+			expectedCondition: &Logical{
+				Operator: "AND",
+				Left: &Function{
+					Name:   "begins_with",
+					Offset: 0,
+					Args: []Operand{
+						&Path{Segments: []Segment{
+							{Name: "#a", Offset: 12},
+							{Name: "b", Offset: 15},
+							{Index: 2, IsList: true, Offset: 17},
+						}},
+						&Value{Name: ":p", Offset: 21},
+					},
+				},
+				Right: &Comparison{
+					Operator: ">",
+					Left:     &Size{Path: &Path{Segments: []Segment{{Name: "#c", Offset: 34}}}, Offset: 29},
+					Right:    &Value{Name: ":n", Offset: 40},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			condition, err := Parse(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCondition, condition)
+		})
+	}
+}
+
+func Test_Parse_Errors(t *testing.T) {
+	tests := map[string]struct {
+		expression      string
+		expectedMessage string
+		expectedOffset  int
+	}{
+		"unexpected character": {
+			expression:      "#a = :a & #b = :b",
+			expectedMessage: `Unexpected character "&"`,
+			expectedOffset:  8,
+		},
+		"placeholder without a name": {
+			expression:      "# = :a",
+			expectedMessage: `Expected a name after "#"`,
+			expectedOffset:  0,
+		},
+		"missing comparator": {
+			expression:      "#a :a",
+			expectedMessage: `Expected a comparator, BETWEEN, or IN but found ":a"`,
+			expectedOffset:  3,
+		},
+		"missing operand": {
+			expression:      "#a = ",
+			expectedMessage: "Expected an attribute or value but found the end of the expression",
+			expectedOffset:  5,
+		},
+		"unclosed parenthesis": {
+			expression:      "(#a = :a",
+			expectedMessage: "Expected ) but found the end of the expression",
+			expectedOffset:  8,
+		},
+		"reserved word as an attribute": {
+			expression:      "#a = :a AND and = :b",
+			expectedMessage: `Expected an attribute or value but found "and"`,
+			expectedOffset:  12,
+		},
+		"wrong number of function arguments": {
+			expression:      "begins_with(#a)",
+			expectedMessage: "The function begins_with expects 2 arguments but found 1",
+			expectedOffset:  0,
+		},
+		"function without an attribute": {
+			expression:      "contains(:a, #b)",
+			expectedMessage: "The first argument of contains must be an attribute",
+			expectedOffset:  9,
+		},
+		"trailing tokens": {
+			expression:      "#a = :a :b",
+			expectedMessage: `Unexpected ":b"`,
+			expectedOffset:  8,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(tt.expression)
+			require.Error(t, err)
+			expressionErr, ok := err.(*Error)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedMessage, expressionErr.Message)
+			assert.Equal(t, tt.expectedOffset, expressionErr.Offset)
+		})
+	}
+}
+
+func Test_Error_Pointer(t *testing.T) {
+	err := &Error{Expression: "#a = :a & #b", Message: "Unexpected", Offset: 8}
+	assert.Equal(t, "Unexpected at position 9", err.Error())
+	assert.Equal(t, "#a = :a & #b\n        ^", err.Pointer())
+
+	err = &Error{Expression: "#a = :a", Message: "Unused", Offset: -1}
+	assert.Equal(t, "Unused", err.Error())
+	assert.Equal(t, "#a = :a", err.Pointer())
+}
@@ -0,0 +1,164 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenName
+	tokenValue
+	tokenIdentifier
+	tokenNumber
+	tokenComparator
+	tokenSymbol
+)
+
+// String returns a description of the token kind for error messages
+func (k tokenKind) String() string {
+	switch k {
+	case tokenName:
+		return "an attribute name"
+	case tokenValue:
+		return "a value"
+	case tokenIdentifier:
+		return "an identifier"
+	case tokenNumber:
+		return "a number"
+	case tokenComparator:
+		return "a comparator"
+	case tokenSymbol:
+		return "a symbol"
+	default:
+		return "the end of the expression"
+	}
+}
+
+// reservedWords are keywords that cannot be used as attribute names without a
+// "#" placeholder
+var reservedWords = map[string]bool{
+	"AND":     true,
+	"BETWEEN": true,
+	"IN":      true,
+	"NOT":     true,
+	"OR":      true,
+}
+
+type token struct {
+	kind   tokenKind
+	text   string
+	number int
+	offset int
+}
+
+// String returns the token as quoted text for error messages
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return t.kind.String()
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// isKeyword checks if the token is the keyword regardless of case
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenIdentifier && strings.EqualFold(t.text, keyword)
+}
+
+// isReserved checks if the token is a reserved keyword
+func (t token) isReserved() bool {
+	return t.kind == tokenIdentifier && reservedWords[strings.ToUpper(t.text)]
+}
+
+// tokenize splits an expression into tokens that end with an EOF token
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || c == ':':
+			end := i + 1
+			for end < len(expression) && isWordChar(expression[end]) {
+				end++
+			}
+			if end == i+1 {
+				return nil, &Error{
+					Expression: expression,
+					Message:    fmt.Sprintf("Expected a name after %q", string(c)),
+					Offset:     i,
+				}
+			}
+			kind := tokenName
+			if c == ':' {
+				kind = tokenValue
+			}
+			tokens = append(tokens, token{kind: kind, text: expression[i:end], offset: i})
+			i = end
+		case isDigit(c):
+			end := i
+			for end < len(expression) && isDigit(expression[end]) {
+				end++
+			}
+			number, err := strconv.Atoi(expression[i:end])
+			if err != nil {
+				return nil, &Error{Expression: expression, Message: "Invalid number", Offset: i}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: expression[i:end], number: number, offset: i})
+			i = end
+		case isWordChar(c):
+			end := i
+			for end < len(expression) && isWordChar(expression[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: expression[i:end], offset: i})
+			i = end
+		case c == '<' || c == '>' || c == '=':
+			text := string(c)
+			if i+1 < len(expression) {
+				pair := expression[i : i+2]
+				if pair == "<=" || pair == ">=" || pair == "<>" {
+					text = pair
+				}
+			}
+			tokens = append(tokens, token{kind: tokenComparator, text: text, offset: i})
+			i += len(text)
+		case strings.ContainsRune("(),.[]", rune(c)):
+			tokens = append(tokens, token{kind: tokenSymbol, text: string(c), offset: i})
+			i++
+		default:
+			return nil, &Error{
+				Expression: expression,
+				Message:    fmt.Sprintf("Unexpected character %q", string(c)),
+				Offset:     i,
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, offset: len(expression)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
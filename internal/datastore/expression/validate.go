@@ -0,0 +1,279 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+)
+
+// Query contains an expression with the attributes and values it references
+type Query struct {
+	Expression string
+	Attributes map[string]interface{}
+	Values     map[string]interface{}
+}
+
+// Validate parses the expression of a query and checks that every attribute
+// and value is defined and used. Attribute types are checked when a datastore
+// definition from the manifest is provided.
+func Validate(query Query, datastore *types.ManifestDatastore) (Condition, error) {
+	condition, err := Parse(query.Expression)
+	if err != nil {
+		return nil, err
+	}
+	v := &validator{
+		query:          query,
+		datastore:      datastore,
+		usedAttributes: map[string]bool{},
+		usedValues:     map[string]bool{},
+	}
+	if condition != nil {
+		if err := v.condition(condition); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.unused(query.Attributes, v.usedAttributes, "attribute"); err != nil {
+		return nil, err
+	}
+	if err := v.unused(query.Values, v.usedValues, "value"); err != nil {
+		return nil, err
+	}
+	return condition, nil
+}
+
+type validator struct {
+	query          Query
+	datastore      *types.ManifestDatastore
+	usedAttributes map[string]bool
+	usedValues     map[string]bool
+}
+
+func (v *validator) errorf(offset int, format string, args ...interface{}) *Error {
+	return &Error{
+		Expression: v.query.Expression,
+		Message:    fmt.Sprintf(format, args...),
+		Offset:     offset,
+	}
+}
+
+func (v *validator) condition(condition Condition) error {
+	switch c := condition.(type) {
+	case *Logical:
+		if err := v.condition(c.Left); err != nil {
+			return err
+		}
+		return v.condition(c.Right)
+	case *Not:
+		return v.condition(c.Condition)
+	case *Comparison:
+		return v.compatible(c.Left, c.Right)
+	case *Between:
+		if err := v.compatible(c.Operand, c.Low); err != nil {
+			return err
+		}
+		return v.compatible(c.Operand, c.High)
+	case *In:
+		for _, operand := range c.List {
+			if err := v.compatible(c.Operand, operand); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *Function:
+		for _, arg := range c.Args {
+			if _, err := v.operandType(arg); err != nil {
+				return err
+			}
+		}
+		switch c.Name {
+		case "begins_with":
+			return v.requireTypes(c.Args[0], c.Args[1], c.Name, "string")
+		case "contains":
+			return v.requireTypes(c.Args[0], c.Args[1], c.Name, "string", "array")
+		}
+		return nil
+	}
+	return nil
+}
+
+// compatible checks that two operands can be compared when both types are known
+func (v *validator) compatible(left Operand, right Operand) error {
+	leftType, err := v.operandType(left)
+	if err != nil {
+		return err
+	}
+	rightType, err := v.operandType(right)
+	if err != nil {
+		return err
+	}
+	if leftType == "" || rightType == "" || leftType == rightType {
+		return nil
+	}
+	if v.coercible(right, leftType) || v.coercible(left, rightType) {
+		return nil
+	}
+	return v.errorf(right.offset(), "Cannot compare %s of type %s with type %s", describe(left), leftType, rightType)
+}
+
+// coercible checks if an operand is a string value that can be read as another
+// type. Values gathered from prompts are strings even for numbers and booleans.
+func (v *validator) coercible(operand Operand, kind string) bool {
+	value, ok := operand.(*Value)
+	if !ok {
+		return false
+	}
+	text, ok := v.query.Values[value.Name].(string)
+	if !ok {
+		return false
+	}
+	switch kind {
+	case "number":
+		_, err := strconv.ParseFloat(text, 64)
+		return err == nil
+	case "boolean":
+		_, err := strconv.ParseBool(text)
+		return err == nil
+	}
+	return false
+}
+
+// requireTypes checks that the attribute of a function is one of the allowed
+// types and that the argument matches that type
+func (v *validator) requireTypes(path Operand, arg Operand, function string, allowed ...string) error {
+	pathType, _ := v.operandType(path)
+	if pathType != "" && !contains(allowed, pathType) {
+		return v.errorf(path.offset(), "The function %s requires %s to be of type %s", function, describe(path), strings.Join(allowed, " or "))
+	}
+	argType, _ := v.operandType(arg)
+	if pathType == "string" && argType != "" && argType != "string" {
+		return v.errorf(arg.offset(), "The function %s requires %s to be of type string", function, describe(arg))
+	}
+	return nil
+}
+
+// operandType checks the references of an operand and returns the type of the
+// operand when it is known
+func (v *validator) operandType(operand Operand) (string, error) {
+	switch o := operand.(type) {
+	case *Value:
+		value, ok := v.query.Values[o.Name]
+		if !ok {
+			return "", v.errorf(o.Offset, "The expression value %s is not defined in expression_values", o.Name)
+		}
+		v.usedValues[o.Name] = true
+		return jsonType(value), nil
+	case *Size:
+		if _, err := v.operandType(o.Path); err != nil {
+			return "", err
+		}
+		return "number", nil
+	case *Path:
+		var attribute string
+		for i, segment := range o.Segments {
+			if segment.IsList {
+				continue
+			}
+			name := segment.Name
+			if strings.HasPrefix(name, "#") {
+				resolved, ok := v.query.Attributes[name].(string)
+				if !ok {
+					return "", v.errorf(segment.Offset, "The expression attribute %s is not defined in expression_attributes", name)
+				}
+				v.usedAttributes[name] = true
+				name = resolved
+			}
+			if i == 0 {
+				attribute = name
+			}
+		}
+		if v.datastore == nil || len(v.datastore.Attributes) == 0 {
+			return "", nil
+		}
+		definition, ok := v.datastore.Attributes[attribute]
+		if !ok {
+			return "", v.errorf(o.offset(), "The attribute %q is not defined for the datastore", attribute)
+		}
+		if len(o.Segments) > 1 {
+			return "", nil
+		}
+		switch definition.Type {
+		case "string", "boolean", "object", "array":
+			return definition.Type, nil
+		case "number", "integer":
+			return "number", nil
+		}
+		return "", nil
+	}
+	return "", nil
+}
+
+// unused errors if a defined attribute or value is not used in the expression
+func (v *validator) unused(defined map[string]interface{}, used map[string]bool, kind string) error {
+	var names []string
+	for name := range defined {
+		if !used[name] {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+	return v.errorf(-1, "The expression %s %s is defined but not used in the expression", kind, strings.Join(names, ", "))
+}
+
+// describe returns the text of an operand for error messages
+func describe(operand Operand) string {
+	switch o := operand.(type) {
+	case *Value:
+		return o.Name
+	case *Size:
+		return "size(" + o.Path.String() + ")"
+	case *Path:
+		return o.String()
+	}
+	return ""
+}
+
+// jsonType returns the type of a decoded JSON value
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64, int, int64:
+		return "number"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	return ""
+}
+
+func contains(list []string, item string) bool {
+	for _, element := range list {
+		if element == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Validate(t *testing.T) {
+	datastore := &types.ManifestDatastore{
+		PrimaryKey: "id",
+		Attributes: map[string]types.ManifestAttribute{
+			"id":     {Type: "string"},
+			"status": {Type: "string"},
+			"points": {Type: "integer"},
+			"tags":   {Type: "array"},
+			"owner":  {Type: "object"},
+		},
+	}
+	tests := map[string]struct {
+		query           Query
+		datastore       *types.ManifestDatastore
+		expectedMessage string
+		expectedOffset  int
+	}{
+		"valid query with every reference used": {
+			query: Query{
+				Expression: "#status = :status AND #points BETWEEN :low AND :high",
+				Attributes: map[string]interface{}{"#status": "status", "#points": "points"},
+				Values:     map[string]interface{}{":status": "todo", ":low": float64(1), ":high": float64(5)},
+			},
+			datastore: datastore,
+		},
+		"valid functions on strings and arrays": {
+			query: Query{
+				Expression: "begins_with(#status, :prefix) AND contains(#tags, :tag) AND #owner.name = :name",
+				Attributes: map[string]interface{}{"#status": "status", "#tags": "tags", "#owner": "owner"},
+				Values:     map[string]interface{}{":prefix": "to", ":tag": "urgent", ":name": "Ada"},
+			},
+			datastore: datastore,
+		},
+		"types are not checked without a datastore": {
+			query: Query{
+				Expression: "#points = :points",
+				Attributes: map[string]interface{}{"#points": "points"},
+				Values:     map[string]interface{}{":points": "five"},
+			},
+		},
+		"string values that read as numbers are compared to numbers": {
+			query: Query{
+				Expression: "#points < :points",
+				Attributes: map[string]interface{}{"#points": "points"},
+				Values:     map[string]interface{}{":points": "12"},
+			},
+			datastore: datastore,
+		},
+		"undefined attribute placeholder": {
+			query: Query{
+				Expression: "#status = :status",
+				Values:     map[string]interface{}{":status": "todo"},
+			},
+			expectedMessage: "The expression attribute #status is not defined in expression_attributes",
+			expectedOffset:  0,
+		},
+		"undefined value placeholder": {
+			query: Query{
+				Expression: "#status = :status",
+				Attributes: map[string]interface{}{"#status": "status"},
+			},
+			expectedMessage: "The expression value :status is not defined in expression_values",
+			expectedOffset:  10,
+		},
+		"unused values": {
+			query: Query{
+				Expression: "#status = :status",
+				Attributes: map[string]interface{}{"#status": "status"},
+				Values:     map[string]interface{}{":status": "todo", ":b": 1, ":a": 2},
+			},
+			expectedMessage: "The expression value :a, :b is defined but not used in the expression",
+			expectedOffset:  -1,
+		},
+		"attribute missing from the datastore": {
+			query: Query{
+				Expression: "#priority = :priority",
+				Attributes: map[string]interface{}{"#priority": "priority"},
+				Values:     map[string]interface{}{":priority": "high"},
+			},
+			datastore:       datastore,
+			expectedMessage: `The attribute "priority" is not defined for the datastore`,
+			expectedOffset:  0,
+		},
+		"comparison of mismatched types": {
+			query: Query{
+				Expression: "#points > :points",
+				Attributes: map[string]interface{}{"#points": "points"},
+				Values:     map[string]interface{}{":points": "five"},
+			},
+			datastore:       datastore,
+			expectedMessage: "Cannot compare #points of type number with type string",
+			expectedOffset:  10,
+		},
+		"begins_with on a number": {
+			query: Query{
+				Expression: "begins_with(#points, :prefix)",
+				Attributes: map[string]interface{}{"#points": "points"},
+				Values:     map[string]interface{}{":prefix": "1"},
+			},
+			datastore:       datastore,
+			expectedMessage: "The function begins_with requires #points to be of type string",
+			expectedOffset:  12,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Validate(tt.query, tt.datastore)
+			if tt.expectedMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			expressionErr, ok := err.(*Error)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedMessage, expressionErr.Message)
+			assert.Equal(t, tt.expectedOffset, expressionErr.Offset)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/goutils"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+const (
+	columnarFormatName    = "slack-datastore-columnar"
+	columnarFormatVersion = 1
+)
+
+// columnarFile is a gzip compressed JSON document that stores the values of
+// each column together. Missing attributes are stored as null.
+type columnarFile struct {
+	Format  string           `json:"format"`
+	Version int              `json:"version"`
+	Rows    int              `json:"rows"`
+	Columns []columnarColumn `json:"columns"`
+}
+
+type columnarColumn struct {
+	Name   string        `json:"name"`
+	Type   string        `json:"type,omitempty"`
+	Values []interface{} `json:"values"`
+}
+
+// columnarWriter keeps items in memory until closed since values are written
+// by column
+type columnarWriter struct {
+	w       io.Writer
+	columns []Column
+	file    columnarFile
+}
+
+func newColumnarWriter(w io.Writer, columns []Column) *columnarWriter {
+	file := columnarFile{
+		Format:  columnarFormatName,
+		Version: columnarFormatVersion,
+		Columns: make([]columnarColumn, len(columns)),
+	}
+	for i, column := range columns {
+		file.Columns[i] = columnarColumn{Name: column.Name, Type: column.Type, Values: []interface{}{}}
+	}
+	return &columnarWriter{w: w, columns: columns, file: file}
+}
+
+func (c *columnarWriter) Write(item map[string]interface{}) error {
+	for i, column := range c.columns {
+		value, _ := lookup(item, column)
+		c.file.Columns[i].Values = append(c.file.Columns[i].Values, value)
+	}
+	c.file.Rows++
+	return nil
+}
+
+func (c *columnarWriter) Close() error {
+	gz := gzip.NewWriter(c.w)
+	if err := json.NewEncoder(gz).Encode(c.file); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// columnarReader decodes the entire file and returns items by row
+type columnarReader struct {
+	columns []Column
+	values  [][]interface{}
+	rows    int
+	row     int
+}
+
+func newColumnarReader(r io.Reader, columns []Column) (*columnarReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, slackerror.New(slackerror.ErrInvalidArguments).
+			WithMessage("The columnar file could not be decompressed").
+			WithRootCause(err)
+	}
+	defer gz.Close()
+	var file columnarFile
+	if err := json.NewDecoder(gz).Decode(&file); err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+	}
+	if file.Format != columnarFormatName || file.Version != columnarFormatVersion {
+		return nil, slackerror.New(slackerror.ErrInvalidArguments).
+			WithMessage("The file is not a columnar datastore export of version %d", columnarFormatVersion)
+	}
+	names := make([]string, len(file.Columns))
+	values := make([][]interface{}, len(file.Columns))
+	for i, column := range file.Columns {
+		if len(column.Values) != file.Rows {
+			return nil, slackerror.New(slackerror.ErrInvalidArguments).
+				WithMessage("The column \"%s\" has %d values but the file has %d rows", column.Name, len(column.Values), file.Rows)
+		}
+		names[i] = column.Name
+		values[i] = column.Values
+	}
+	matched, err := columnsByName(names, columns)
+	if err != nil {
+		return nil, err
+	}
+	return &columnarReader{columns: matched, values: values, rows: file.Rows}, nil
+}
+
+func (c *columnarReader) Read() (map[string]interface{}, string, error) {
+	if c.row >= c.rows {
+		return nil, "", io.EOF
+	}
+	row := c.row
+	c.row++
+	item := map[string]interface{}{}
+	var rowErr *RowError
+	for i, column := range c.columns {
+		value, err := coerce(c.values[i][row], column)
+		if err != nil {
+			if rowErr == nil {
+				rowErr = &RowError{Reason: fmt.Sprintf("column %s: %s", column.Name, err)}
+			}
+			assign(item, column, c.values[i][row])
+			continue
+		}
+		if value != nil {
+			assign(item, column, value)
+		}
+	}
+	text, err := goutils.JSONMarshalUnescaped(item)
+	if err != nil {
+		return nil, "", err
+	}
+	text = strings.TrimSuffix(text, "\n")
+	if rowErr != nil {
+		return nil, text, rowErr
+	}
+	return item, text, nil
+}
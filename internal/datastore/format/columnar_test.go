@@ -0,0 +1,88 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Columnar_RoundTrip(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+	items := []map[string]interface{}{
+		{
+			"id":     "1",
+			"points": float64(3),
+			"done":   true,
+			"tags":   []interface{}{"a"},
+			"owner":  map[string]interface{}{"name": ""},
+		},
+		{"id": "2", "estimate": 1.25},
+	}
+
+	var buff bytes.Buffer
+	writer := NewWriter(Columnar, &buff, columns)
+	for _, item := range items {
+		require.NoError(t, writer.Write(item))
+	}
+	require.NoError(t, writer.Close())
+
+	reader, err := NewReader(Columnar, &buff, columns)
+	require.NoError(t, err)
+	for _, expected := range items {
+		item, _, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, expected, item)
+	}
+	_, _, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_Columnar_Reader_Errors(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+	compress := func(document string) *bytes.Buffer {
+		var buff bytes.Buffer
+		gz := gzip.NewWriter(&buff)
+		_, err := gz.Write([]byte(document))
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+		return &buff
+	}
+
+	_, err = NewReader(Columnar, bytes.NewBufferString(`{"rows":0}`), columns)
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrInvalidArguments, slackerror.ToSlackError(err).Code)
+
+	_, err = NewReader(Columnar, compress(`{"format":"slack-datastore-columnar","version":1,"rows":1,"columns":[{"name":"id","values":[]}]}`), columns)
+	require.Error(t, err)
+	assert.Contains(t, slackerror.ToSlackError(err).Message, `The column "id" has 0 values but the file has 1 rows`)
+
+	reader, err := NewReader(Columnar, compress(`{"format":"slack-datastore-columnar","version":1,"rows":2,"columns":[{"name":"id","values":["1","2"]},{"name":"done","values":["yes",false]}]}`), columns)
+	require.NoError(t, err)
+	_, text, err := reader.Read()
+	assert.Equal(t, &RowError{Reason: `column done: "yes" is not a boolean`}, err)
+	assert.Equal(t, `{"done":"yes","id":"1"}`, text)
+	item, _, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "2", "done": false}, item)
+}
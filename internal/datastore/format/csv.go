@@ -0,0 +1,160 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// csvWriter writes a header of column names and a row for each item. Missing
+// attributes are written as empty cells.
+type csvWriter struct {
+	writer        *csv.Writer
+	columns       []Column
+	headerWritten bool
+}
+
+func newCSVWriter(w io.Writer, columns []Column) *csvWriter {
+	return &csvWriter{writer: csv.NewWriter(w), columns: columns}
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+	c.headerWritten = true
+	header := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		header[i] = column.Name
+	}
+	return c.writer.Write(header)
+}
+
+func (c *csvWriter) Write(item map[string]interface{}) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	record := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		value, ok := lookup(item, column)
+		if !ok {
+			continue
+		}
+		cell, err := csvCell(value)
+		if err != nil {
+			return err
+		}
+		record[i] = cell
+	}
+	return c.writer.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// csvCell formats a value as text with arrays and objects as JSON
+func csvCell(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
+
+// csvReader reads items from rows that follow a header of column names. Empty
+// cells of string columns are kept as empty strings and other empty cells are
+// left out of the item.
+type csvReader struct {
+	reader  *csv.Reader
+	columns []Column
+}
+
+func newCSVReader(r io.Reader, columns []Column) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, slackerror.New(slackerror.ErrInvalidArguments).
+				WithMessage("The CSV file is missing a header of column names")
+		}
+		return nil, slackerror.Wrap(err, slackerror.ErrInvalidArguments)
+	}
+	matched, err := columnsByName(header, columns)
+	if err != nil {
+		return nil, err
+	}
+	return &csvReader{reader: reader, columns: matched}, nil
+}
+
+func (c *csvReader) Read() (map[string]interface{}, string, error) {
+	record, err := c.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, fmt.Sprintf("line %d", parseErr.Line), &RowError{Reason: parseErr.Err.Error()}
+		}
+		return nil, "", err
+	}
+	text := csvRecordText(record)
+	if len(record) != len(c.columns) {
+		return nil, text, &RowError{Reason: fmt.Sprintf("expected %d columns but found %d", len(c.columns), len(record))}
+	}
+	item := map[string]interface{}{}
+	for i, column := range c.columns {
+		if record[i] == "" && column.Type != "string" {
+			continue
+		}
+		value, err := coerce(record[i], column)
+		if err != nil {
+			return nil, text, &RowError{Reason: fmt.Sprintf("column %s: %s", column.Name, err)}
+		}
+		assign(item, column, value)
+	}
+	return item, text, nil
+}
+
+// csvRecordText returns a record as the line of CSV it was read from
+func csvRecordText(record []string) string {
+	var buff bytes.Buffer
+	writer := csv.NewWriter(&buff)
+	_ = writer.Write(record)
+	writer.Flush()
+	return string(bytes.TrimRight(buff.Bytes(), "\n"))
+}
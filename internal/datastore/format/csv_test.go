@@ -0,0 +1,149 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CSV_RoundTrip(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+	items := []map[string]interface{}{
+		{
+			"id":       "1",
+			"points":   float64(3),
+			"estimate": 0.5,
+			"done":     false,
+			"tags":     []interface{}{"a", "b,c"},
+			"metadata": map[string]interface{}{"source": "import"},
+			"owner": map[string]interface{}{
+				"name":    "Ada",
+				"contact": map[string]interface{}{"email": "ada@example.com"},
+			},
+			"assignee": "U0123",
+		},
+		{"id": "2"},
+	}
+
+	var buff bytes.Buffer
+	writer := NewWriter(CSV, &buff, columns)
+	for _, item := range items {
+		require.NoError(t, writer.Write(item))
+	}
+	require.NoError(t, writer.Close())
+	assert.Equal(t, strings.Join([]string{
+		"id,assignee,done,estimate,metadata,owner.contact.email,owner.name,points,tags",
+		`1,U0123,false,0.5,"{""source"":""import""}",ada@example.com,Ada,3,"[""a"",""b,c""]"`,
+		"2,,,,,,,,",
+		"",
+	}, "\n"), buff.String())
+
+	// Empty cells of string columns are read as empty strings
+	expected := []map[string]interface{}{
+		items[0],
+		{
+			"id": "2",
+			"owner": map[string]interface{}{
+				"name":    "",
+				"contact": map[string]interface{}{"email": ""},
+			},
+		},
+	}
+	reader, err := NewReader(CSV, &buff, columns)
+	require.NoError(t, err)
+	for _, expected := range expected {
+		item, _, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, expected, item)
+	}
+	_, _, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_CSV_RoundTrip_EmptyStrings(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+	item := map[string]interface{}{
+		"id":       "1",
+		"assignee": "",
+		"owner":    map[string]interface{}{"name": "", "contact": map[string]interface{}{"email": "ada@example.com"}},
+	}
+
+	var buff bytes.Buffer
+	writer := NewWriter(CSV, &buff, columns)
+	require.NoError(t, writer.Write(item))
+	require.NoError(t, writer.Close())
+
+	reader, err := NewReader(CSV, &buff, columns)
+	require.NoError(t, err)
+	read, _, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":    "1",
+		"owner": map[string]interface{}{"name": "", "contact": map[string]interface{}{"email": "ada@example.com"}},
+	}, read, "empty strings of custom types are left out")
+}
+
+func Test_CSV_RoundTrip_UndeclaredAttributes(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+	item := map[string]interface{}{
+		"id":       "1",
+		"priority": "high",
+		"metadata": map[string]interface{}{"source": "import"},
+		"owner":    map[string]interface{}{"name": "Ada", "team": "core"},
+	}
+	assert.Equal(t, []string{"owner.team", "priority"}, Undeclared(item, columns))
+
+	var buff bytes.Buffer
+	writer := NewWriter(CSV, &buff, columns)
+	require.NoError(t, writer.Write(item))
+	require.NoError(t, writer.Close())
+
+	reader, err := NewReader(CSV, &buff, columns)
+	require.NoError(t, err)
+	read, _, err := reader.Read()
+	require.NoError(t, err)
+	assert.Empty(t, Undeclared(read, columns))
+	assert.NotContains(t, read, "priority")
+}
+
+func Test_CSV_Reader_Errors(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+
+	_, err = NewReader(CSV, strings.NewReader("id,priority\n1,high\n"), columns)
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrInvalidArguments, slackerror.ToSlackError(err).Code)
+
+	reader, err := NewReader(CSV, strings.NewReader("id,points,done\n1,many,true\n2\n3,4,false\n"), columns)
+	require.NoError(t, err)
+	_, text, err := reader.Read()
+	assert.Equal(t, &RowError{Reason: `column points: "many" is not a number`}, err)
+	assert.Equal(t, "1,many,true", text)
+	_, _, err = reader.Read()
+	assert.Equal(t, &RowError{Reason: "expected 3 columns but found 1"}, err)
+	item, _, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "3", "points": float64(4), "done": false}, item)
+}
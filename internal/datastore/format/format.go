@@ -0,0 +1,385 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package format reads and writes datastore items in the file formats used to
+// export and import items.
+//
+// JSON Lines files contain one item per line as is. CSV and columnar files use
+// columns from the attributes of a datastore in the manifest, where attributes
+// of objects with known properties become columns named with a dotted path and
+// arrays or other objects are kept as JSON in a single column.
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// Format is the file format of exported or imported items
+type Format string
+
+const (
+	JSONL    Format = "jsonl"
+	CSV      Format = "csv"
+	Columnar Format = "columnar"
+)
+
+// Formats lists the supported formats in the order shown in help text
+var Formats = []Format{JSONL, CSV, Columnar}
+
+// Detect returns the format from a flag value or the extension of the file
+// path when the flag is not set. Files with unknown extensions use JSON Lines.
+func Detect(flag string, path string) (Format, error) {
+	if flag != "" {
+		for _, format := range Formats {
+			if strings.EqualFold(flag, string(format)) {
+				return format, nil
+			}
+		}
+		return "", slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The file format \"%s\" is not supported", flag).
+			WithRemediation("Choose a format of %s", strings.Join(formatNames(), ", "))
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return CSV, nil
+	case ".columnar":
+		return Columnar, nil
+	default:
+		return JSONL, nil
+	}
+}
+
+func formatNames() []string {
+	names := make([]string, len(Formats))
+	for i, format := range Formats {
+		names[i] = string(format)
+	}
+	return names
+}
+
+// Column is an attribute of an item that is written to a single column
+type Column struct {
+	Name string
+	Path []string
+	Type string
+}
+
+// Columns returns the columns for items of a datastore with the primary key
+// first and other attributes sorted by name
+func Columns(datastore types.ManifestDatastore) ([]Column, error) {
+	names := make([]string, 0, len(datastore.Attributes))
+	for name := range datastore.Attributes {
+		if name != datastore.PrimaryKey {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, ok := datastore.Attributes[datastore.PrimaryKey]; ok {
+		names = append([]string{datastore.PrimaryKey}, names...)
+	}
+	columns := []Column{}
+	for _, name := range names {
+		attributeColumns, err := attributeColumns([]string{name}, datastore.Attributes[name])
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, attributeColumns...)
+	}
+	return columns, nil
+}
+
+// attributeColumns flattens an object attribute with known properties into a
+// column for each property
+func attributeColumns(path []string, attribute types.ManifestAttribute) ([]Column, error) {
+	properties, err := attributeProperties(attribute)
+	if err != nil {
+		return nil, err
+	}
+	if attribute.Type != "object" || len(properties) == 0 {
+		return []Column{{
+			Name: strings.Join(path, "."),
+			Path: path,
+			Type: attribute.Type,
+		}}, nil
+	}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	columns := []Column{}
+	for _, name := range names {
+		propertyPath := append(append([]string{}, path...), name)
+		propertyColumns, err := attributeColumns(propertyPath, properties[name])
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, propertyColumns...)
+	}
+	return columns, nil
+}
+
+// attributeProperties decodes the properties of an object attribute
+func attributeProperties(attribute types.ManifestAttribute) (map[string]types.ManifestAttribute, error) {
+	if attribute.Properties == nil || (attribute.Properties.Data == nil && attribute.Properties.JSONData == nil) {
+		return nil, nil
+	}
+	data, err := attribute.Properties.MarshalJSON()
+	if err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+	}
+	var properties map[string]types.ManifestAttribute
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+	}
+	return properties, nil
+}
+
+// Writer writes items to a file
+type Writer interface {
+	Write(item map[string]interface{}) error
+	// Close writes buffered items but does not close the underlying file
+	Close() error
+}
+
+// Reader reads items from a file
+type Reader interface {
+	// Read returns the next item and the text it was read from. A *RowError is
+	// returned for an item that cannot be read and io.EOF after the last item.
+	Read() (map[string]interface{}, string, error)
+}
+
+// RowError describes an item of a file that cannot be read. Other items can
+// still be read after a RowError.
+type RowError struct {
+	Reason string
+}
+
+func (e *RowError) Error() string {
+	return e.Reason
+}
+
+// NewWriter returns a writer of items in the format
+func NewWriter(format Format, w io.Writer, columns []Column) Writer {
+	switch format {
+	case CSV:
+		return newCSVWriter(w, columns)
+	case Columnar:
+		return newColumnarWriter(w, columns)
+	default:
+		return &jsonlWriter{w: w}
+	}
+}
+
+// NewReader returns a reader of items in the format. Errors are returned if
+// the header of the file does not match the columns.
+func NewReader(format Format, r io.Reader, columns []Column) (Reader, error) {
+	switch format {
+	case CSV:
+		return newCSVReader(r, columns)
+	case Columnar:
+		return newColumnarReader(r, columns)
+	default:
+		return newJSONLReader(r), nil
+	}
+}
+
+// lookup returns the value of a column in an item
+func lookup(item map[string]interface{}, column Column) (interface{}, bool) {
+	var current interface{} = item
+	for _, name := range column.Path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[name]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// assign sets the value of a column in an item and creates parent objects
+func assign(item map[string]interface{}, column Column, value interface{}) {
+	current := item
+	for _, name := range column.Path[:len(column.Path)-1] {
+		next, ok := current[name].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[name] = next
+		}
+		current = next
+	}
+	current[column.Path[len(column.Path)-1]] = value
+}
+
+// columnsByName matches names from the header of a file to columns
+func columnsByName(names []string, columns []Column) ([]Column, error) {
+	known := map[string]Column{}
+	for _, column := range columns {
+		known[column.Name] = column
+	}
+	matched := make([]Column, len(names))
+	for i, name := range names {
+		column, ok := known[name]
+		if !ok {
+			return nil, slackerror.New(slackerror.ErrInvalidArguments).
+				WithMessage("The column \"%s\" is not an attribute of the datastore", name).
+				WithRemediation("Remove the column or add the attribute to the datastore in the app manifest")
+		}
+		matched[i] = column
+	}
+	return matched, nil
+}
+
+// Undeclared returns the dotted paths of attributes in an item that are not
+// written to any of the columns, sorted by name
+func Undeclared(item map[string]interface{}, columns []Column) []string {
+	names := map[string]bool{}
+	for _, column := range columns {
+		names[column.Name] = true
+	}
+	undeclared := []string{}
+	undeclaredAttributes(item, nil, names, &undeclared)
+	sort.Strings(undeclared)
+	return undeclared
+}
+
+// undeclaredAttributes collects attributes of an object that are not columns
+// and follows objects with properties that are columns
+func undeclaredAttributes(object map[string]interface{}, path []string, names map[string]bool, undeclared *[]string) {
+	for key, value := range object {
+		attributePath := append(append([]string{}, path...), key)
+		name := strings.Join(attributePath, ".")
+		if names[name] {
+			continue
+		}
+		if properties, ok := value.(map[string]interface{}); ok && hasPropertyColumns(name, names) {
+			undeclaredAttributes(properties, attributePath, names, undeclared)
+			continue
+		}
+		*undeclared = append(*undeclared, name)
+	}
+}
+
+// hasPropertyColumns returns if an attribute has properties that are columns
+func hasPropertyColumns(name string, names map[string]bool) bool {
+	for column := range names {
+		if strings.HasPrefix(column, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Coerce converts a value to the type of a datastore attribute
+func Coerce(value interface{}, attributeType string) (interface{}, error) {
+	return coerce(value, Column{Type: attributeType})
+}
+
+// coerce converts a value to the type of a column. Strings are parsed as the
+// type of the column and other values are checked against the type.
+func coerce(value interface{}, column Column) (interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return coerceString(v, column)
+	case float64:
+		switch column.Type {
+		case "number":
+			return v, nil
+		case "integer":
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("%s is not an integer", strconv.FormatFloat(v, 'f', -1, 64))
+			}
+			return v, nil
+		case "string":
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	case bool:
+		switch column.Type {
+		case "boolean":
+			return v, nil
+		case "string":
+			return strconv.FormatBool(v), nil
+		}
+	case []interface{}:
+		if column.Type == "array" || !IsKnownType(column.Type) {
+			return v, nil
+		}
+	case map[string]interface{}:
+		if column.Type == "object" || !IsKnownType(column.Type) {
+			return v, nil
+		}
+	}
+	if !IsKnownType(column.Type) {
+		return value, nil
+	}
+	return nil, fmt.Errorf("expected a value of type %s", column.Type)
+}
+
+func coerceString(value string, column Column) (interface{}, error) {
+	switch column.Type {
+	case "string":
+		return value, nil
+	case "number", "integer":
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return coerce(number, column)
+	case "boolean":
+		boolean, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return boolean, nil
+	case "array", "object":
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, fmt.Errorf("%q is not a JSON %s", value, column.Type)
+		}
+		return coerce(decoded, column)
+	}
+	// Custom types are kept as strings unless these contain JSON
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+			return decoded, nil
+		}
+	}
+	return value, nil
+}
+
+// IsKnownType returns if values can be converted to an attribute type. Values
+// of custom types are kept as is.
+func IsKnownType(kind string) bool {
+	switch kind {
+	case "string", "number", "integer", "boolean", "array", "object":
+		return true
+	}
+	return false
+}
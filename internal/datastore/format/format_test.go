@@ -0,0 +1,174 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDatastore has attributes of each type and an object with properties
+func mockDatastore() types.ManifestDatastore {
+	properties := json.RawMessage(`{"name":{"type":"string"},"contact":{"type":"object","properties":{"email":{"type":"string"}}}}`)
+	return types.ManifestDatastore{
+		PrimaryKey: "id",
+		Attributes: map[string]types.ManifestAttribute{
+			"id":       {Type: "string"},
+			"points":   {Type: "integer"},
+			"estimate": {Type: "number"},
+			"done":     {Type: "boolean"},
+			"tags":     {Type: "array"},
+			"metadata": {Type: "object"},
+			"owner":    {Type: "object", Properties: &types.RawJSON{JSONData: &properties}},
+			"assignee": {Type: "slack#/types/user_id"},
+		},
+	}
+}
+
+func Test_Detect(t *testing.T) {
+	tests := map[string]struct {
+		flag           string
+		path           string
+		expectedFormat Format
+		expectedError  string
+	}{
+		"flag is used before the extension": {
+			flag:           "CSV",
+			path:           "items.jsonl",
+			expectedFormat: CSV,
+		},
+		"csv extension": {
+			path:           "exports/items.csv",
+			expectedFormat: CSV,
+		},
+		"columnar extension": {
+			path:           "items.columnar",
+			expectedFormat: Columnar,
+		},
+		"other extensions use json lines": {
+			path:           "items.txt",
+			expectedFormat: JSONL,
+		},
+		"unknown flag value errors": {
+			flag:          "parquet",
+			expectedError: slackerror.ErrInvalidFlag,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			format, err := Detect(tt.flag, tt.path)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, format)
+		})
+	}
+}
+
+func Test_Columns(t *testing.T) {
+	columns, err := Columns(mockDatastore())
+	require.NoError(t, err)
+	names := []string{}
+	for _, column := range columns {
+		names = append(names, column.Name)
+	}
+	assert.Equal(t, []string{
+		"id",
+		"assignee",
+		"done",
+		"estimate",
+		"metadata",
+		"owner.contact.email",
+		"owner.name",
+		"points",
+		"tags",
+	}, names)
+	assert.Equal(t, Column{Name: "owner.contact.email", Path: []string{"owner", "contact", "email"}, Type: "string"}, columns[5])
+}
+
+func Test_coerce(t *testing.T) {
+	tests := map[string]struct {
+		value         interface{}
+		column        Column
+		expectedValue interface{}
+		expectedError string
+	}{
+		"string to number": {
+			value:         " 1.5 ",
+			column:        Column{Type: "number"},
+			expectedValue: 1.5,
+		},
+		"string to integer": {
+			value:         "42",
+			column:        Column{Type: "integer"},
+			expectedValue: float64(42),
+		},
+		"fractional integer errors": {
+			value:         "4.2",
+			column:        Column{Type: "integer"},
+			expectedError: "4.2 is not an integer",
+		},
+		"string to boolean": {
+			value:         "TRUE",
+			column:        Column{Type: "boolean"},
+			expectedValue: true,
+		},
+		"string to array": {
+			value:         `["a","b"]`,
+			column:        Column{Type: "array"},
+			expectedValue: []interface{}{"a", "b"},
+		},
+		"object for an array errors": {
+			value:         `{"a":1}`,
+			column:        Column{Type: "array"},
+			expectedError: "expected a value of type array",
+		},
+		"number to string": {
+			value:         float64(7),
+			column:        Column{Type: "string"},
+			expectedValue: "7",
+		},
+		"custom types keep strings": {
+			value:         "U0123",
+			column:        Column{Type: "slack#/types/user_id"},
+			expectedValue: "U0123",
+		},
+		"invalid number errors": {
+			value:         "seven",
+			column:        Column{Type: "number"},
+			expectedError: `"seven" is not a number`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, err := coerce(tt.value, tt.column)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedValue, value)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/toughtackle/slack-cli/internal/goutils"
+)
+
+// jsonlWriter writes each item as JSON on a separate line
+type jsonlWriter struct {
+	w io.Writer
+}
+
+func (j *jsonlWriter) Write(item map[string]interface{}) error {
+	line, err := goutils.JSONMarshalUnescaped(item)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(j.w, line)
+	return err
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+// jsonlReader reads an item from each line and skips empty lines
+type jsonlReader struct {
+	scanner *bufio.Scanner
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	return &jsonlReader{scanner: bufio.NewScanner(r)}
+}
+
+func (j *jsonlReader) Read() (map[string]interface{}, string, error) {
+	for j.scanner.Scan() {
+		line := j.scanner.Text()
+		if line == "" {
+			continue
+		}
+		var item map[string]interface{}
+		if err := goutils.JSONUnmarshal([]byte(line), &item); err != nil {
+			return nil, line, &RowError{Reason: "item couldn't be parsed as JSON"}
+		}
+		return item, line, nil
+	}
+	if err := j.scanner.Err(); err != nil {
+		return nil, "", err
+	}
+	return nil, "", io.EOF
+}
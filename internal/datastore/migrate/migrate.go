@@ -0,0 +1,351 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migrate compares the datastore schemas of two app manifests and
+// changes stored items to match the newer schema.
+//
+// Transforms are applied to each item in a fixed order: attributes are renamed,
+// then values are coerced to a type, then defaults are set for missing values,
+// and last attributes are dropped.
+package migrate
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/datastore/format"
+	"github.com/toughtackle/slack-cli/internal/goutils"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// ChangeKind describes how an attribute differs between schemas
+type ChangeKind string
+
+const (
+	AttributeAdded   ChangeKind = "added"
+	AttributeRemoved ChangeKind = "removed"
+	AttributeChanged ChangeKind = "changed"
+)
+
+// Change is an attribute that differs between the deployed and local schema
+type Change struct {
+	Attribute string
+	Kind      ChangeKind
+	// From is the type of the attribute in the deployed schema
+	From string
+	// To is the type of the attribute in the local schema
+	To string
+}
+
+// String describes the change for output
+func (c Change) String() string {
+	switch c.Kind {
+	case AttributeAdded:
+		return fmt.Sprintf("%s was added with type %s", c.Attribute, c.To)
+	case AttributeRemoved:
+		return fmt.Sprintf("%s was removed from type %s", c.Attribute, c.From)
+	default:
+		return fmt.Sprintf("%s changed from type %s to %s", c.Attribute, c.From, c.To)
+	}
+}
+
+// Compare returns the attributes that changed from the deployed datastore to
+// the local datastore ordered by name
+func Compare(deployed types.ManifestDatastore, local types.ManifestDatastore) []Change {
+	changes := []Change{}
+	for name, attribute := range local.Attributes {
+		previous, exists := deployed.Attributes[name]
+		switch {
+		case !exists:
+			changes = append(changes, Change{Attribute: name, Kind: AttributeAdded, To: attribute.Type})
+		case previous.Type != attribute.Type:
+			changes = append(changes, Change{Attribute: name, Kind: AttributeChanged, From: previous.Type, To: attribute.Type})
+		}
+	}
+	for name, attribute := range deployed.Attributes {
+		if _, exists := local.Attributes[name]; !exists {
+			changes = append(changes, Change{Attribute: name, Kind: AttributeRemoved, From: attribute.Type})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Attribute < changes[j].Attribute
+	})
+	return changes
+}
+
+// TransformKind is the change a transform makes to an item
+type TransformKind string
+
+const (
+	Rename  TransformKind = "rename"
+	Default TransformKind = "default"
+	Drop    TransformKind = "drop"
+	Coerce  TransformKind = "coerce"
+)
+
+// transformOrder is the order transforms are applied in
+var transformOrder = []TransformKind{Rename, Coerce, Default, Drop}
+
+// Transform changes an attribute of stored items
+type Transform struct {
+	Kind      TransformKind
+	Attribute string
+	// Target is the new name of a renamed attribute or the type of a coerced
+	// attribute
+	Target string
+	// Value is set for an attribute without a value
+	Value interface{}
+}
+
+// String describes the transform for output
+func (t Transform) String() string {
+	switch t.Kind {
+	case Rename:
+		return fmt.Sprintf("rename %s to %s", t.Attribute, t.Target)
+	case Default:
+		value, _ := goutils.JSONMarshalUnescaped(t.Value)
+		return fmt.Sprintf("default %s to %s", t.Attribute, strings.TrimSuffix(value, "\n"))
+	case Drop:
+		return fmt.Sprintf("drop %s", t.Attribute)
+	default:
+		return fmt.Sprintf("coerce %s to %s", t.Attribute, t.Target)
+	}
+}
+
+// coercibleTypes are the attribute types that values can be coerced to
+var coercibleTypes = []string{"string", "number", "integer", "boolean", "array", "object"}
+
+// Options are the transforms of a migration as written in flags. Renames and
+// defaults use "attribute=value" and coercions can omit the type to use the
+// type of the local schema.
+type Options struct {
+	Renames  []string
+	Defaults []string
+	Drops    []string
+	Coerces  []string
+}
+
+// ParseTransforms returns the transforms of options after checking each is
+// possible with the local schema of the datastore
+func ParseTransforms(options Options, local types.ManifestDatastore) ([]Transform, error) {
+	transforms := []Transform{}
+	for _, rename := range options.Renames {
+		from, to, ok := strings.Cut(rename, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, invalidTransformError("rename", rename, "Use the format --rename <old>=<new>")
+		}
+		if from == local.PrimaryKey || to == local.PrimaryKey {
+			return nil, invalidTransformError("rename", rename, "The primary key of a datastore cannot be renamed")
+		}
+		if _, exists := local.Attributes[to]; !exists {
+			return nil, invalidTransformError("rename", rename, fmt.Sprintf("The attribute \"%s\" must be defined in the local manifest", to))
+		}
+		transforms = append(transforms, Transform{Kind: Rename, Attribute: from, Target: to})
+	}
+	for _, coerce := range options.Coerces {
+		name, kind, _ := strings.Cut(coerce, "=")
+		name, kind = strings.TrimSpace(name), strings.TrimSpace(kind)
+		attribute, exists := local.Attributes[name]
+		if name == "" || !exists {
+			return nil, invalidTransformError("coerce", coerce, fmt.Sprintf("The attribute \"%s\" must be defined in the local manifest", name))
+		}
+		if kind == "" {
+			kind = attribute.Type
+		}
+		if !format.IsKnownType(kind) {
+			return nil, invalidTransformError("coerce", coerce, fmt.Sprintf("Values can only be coerced to a type of %s", strings.Join(coercibleTypes, ", ")))
+		}
+		if kind != attribute.Type {
+			return nil, invalidTransformError("coerce", coerce, fmt.Sprintf("The attribute \"%s\" has the type %s in the local manifest", name, attribute.Type))
+		}
+		transforms = append(transforms, Transform{Kind: Coerce, Attribute: name, Target: kind})
+	}
+	for _, value := range options.Defaults {
+		name, raw, ok := strings.Cut(value, "=")
+		name = strings.TrimSpace(name)
+		attribute, exists := local.Attributes[name]
+		if !ok || name == "" {
+			return nil, invalidTransformError("default", value, "Use the format --default <attribute>=<value>")
+		}
+		if !exists {
+			return nil, invalidTransformError("default", value, fmt.Sprintf("The attribute \"%s\" must be defined in the local manifest", name))
+		}
+		var decoded interface{} = raw
+		if attribute.Type != "string" {
+			decoded = parseValue(raw)
+		}
+		coerced, err := format.Coerce(decoded, attribute.Type)
+		if err != nil {
+			return nil, invalidTransformError("default", value, fmt.Sprintf("The value %s", err))
+		}
+		transforms = append(transforms, Transform{Kind: Default, Attribute: name, Value: coerced})
+	}
+	for _, name := range options.Drops {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, invalidTransformError("drop", name, "Use the format --drop <attribute>")
+		}
+		if name == local.PrimaryKey {
+			return nil, invalidTransformError("drop", name, "The primary key of a datastore cannot be dropped")
+		}
+		if _, exists := local.Attributes[name]; exists {
+			return nil, invalidTransformError("drop", name, fmt.Sprintf("The attribute \"%s\" is still defined in the local manifest", name))
+		}
+		transforms = append(transforms, Transform{Kind: Drop, Attribute: name})
+	}
+	return transforms, nil
+}
+
+// parseValue decodes a default value as JSON and otherwise keeps the text
+func parseValue(raw string) interface{} {
+	var decoded interface{}
+	if err := goutils.JSONUnmarshal([]byte(raw), &decoded); err != nil {
+		return raw
+	}
+	return decoded
+}
+
+func invalidTransformError(kind string, value string, remediation string) error {
+	return slackerror.New(slackerror.ErrInvalidFlag).
+		WithMessage("The --%s value \"%s\" is not a valid transform", kind, value).
+		WithRemediation("%s", remediation)
+}
+
+// Unhandled returns the schema changes that no transform accounts for. Items
+// stored before these changes might not match the local schema.
+func Unhandled(changes []Change, transforms []Transform) []Change {
+	handled := func(change Change) bool {
+		for _, transform := range transforms {
+			switch change.Kind {
+			case AttributeAdded:
+				if (transform.Kind == Default && transform.Attribute == change.Attribute) ||
+					(transform.Kind == Rename && transform.Target == change.Attribute) {
+					return true
+				}
+			case AttributeRemoved:
+				if (transform.Kind == Drop || transform.Kind == Rename) && transform.Attribute == change.Attribute {
+					return true
+				}
+			case AttributeChanged:
+				if transform.Kind == Coerce && transform.Attribute == change.Attribute {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	unhandled := []Change{}
+	for _, change := range changes {
+		if !handled(change) {
+			unhandled = append(unhandled, change)
+		}
+	}
+	return unhandled
+}
+
+// Undeployed returns the transforms that write attributes or types that the
+// deployed datastore does not have yet. Items are saved to the deployed
+// datastore so these changes must be deployed before items are saved.
+func Undeployed(transforms []Transform, deployed types.ManifestDatastore) []Transform {
+	undeployed := []Transform{}
+	for _, transform := range transforms {
+		var written bool
+		switch transform.Kind {
+		case Rename:
+			_, written = deployed.Attributes[transform.Target]
+		case Default:
+			_, written = deployed.Attributes[transform.Attribute]
+		case Coerce:
+			attribute, exists := deployed.Attributes[transform.Attribute]
+			written = exists && attribute.Type == transform.Target
+		default:
+			written = true
+		}
+		if !written {
+			undeployed = append(undeployed, transform)
+		}
+	}
+	return undeployed
+}
+
+// Apply returns a copy of the item with the transforms applied along with the
+// transforms that changed it. The item is not changed if an error is returned.
+func Apply(item map[string]interface{}, transforms []Transform) (map[string]interface{}, []Transform, error) {
+	result := make(map[string]interface{}, len(item))
+	for name, value := range item {
+		result[name] = value
+	}
+	applied := []Transform{}
+	for _, kind := range transformOrder {
+		for _, transform := range transforms {
+			if transform.Kind != kind {
+				continue
+			}
+			changed, err := apply(result, transform)
+			if err != nil {
+				return nil, nil, err
+			}
+			if changed {
+				applied = append(applied, transform)
+			}
+		}
+	}
+	return result, applied, nil
+}
+
+func apply(item map[string]interface{}, transform Transform) (bool, error) {
+	value, exists := item[transform.Attribute]
+	switch transform.Kind {
+	case Rename:
+		if !exists {
+			return false, nil
+		}
+		if _, taken := item[transform.Target]; taken {
+			return false, fmt.Errorf("cannot rename %s since %s already has a value", transform.Attribute, transform.Target)
+		}
+		item[transform.Target] = value
+		delete(item, transform.Attribute)
+		return true, nil
+	case Coerce:
+		if !exists || value == nil {
+			return false, nil
+		}
+		coerced, err := format.Coerce(value, transform.Target)
+		if err != nil {
+			return false, fmt.Errorf("attribute %s: %s", transform.Attribute, err)
+		}
+		if reflect.DeepEqual(value, coerced) {
+			return false, nil
+		}
+		item[transform.Attribute] = coerced
+		return true, nil
+	case Default:
+		if exists && value != nil {
+			return false, nil
+		}
+		item[transform.Attribute] = transform.Value
+		return true, nil
+	case Drop:
+		if !exists {
+			return false, nil
+		}
+		delete(item, transform.Attribute)
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockDeployedDatastore() types.ManifestDatastore {
+	return types.ManifestDatastore{
+		PrimaryKey: "id",
+		Attributes: map[string]types.ManifestAttribute{
+			"id":     {Type: "string"},
+			"status": {Type: "string"},
+			"points": {Type: "string"},
+			"legacy": {Type: "boolean"},
+		},
+	}
+}
+
+func mockLocalDatastore() types.ManifestDatastore {
+	return types.ManifestDatastore{
+		PrimaryKey: "id",
+		Attributes: map[string]types.ManifestAttribute{
+			"id":       {Type: "string"},
+			"state":    {Type: "string"},
+			"points":   {Type: "number"},
+			"priority": {Type: "integer"},
+		},
+	}
+}
+
+func Test_Compare(t *testing.T) {
+	changes := Compare(mockDeployedDatastore(), mockLocalDatastore())
+	assert.Equal(t, []Change{
+		{Attribute: "legacy", Kind: AttributeRemoved, From: "boolean"},
+		{Attribute: "points", Kind: AttributeChanged, From: "string", To: "number"},
+		{Attribute: "priority", Kind: AttributeAdded, To: "integer"},
+		{Attribute: "state", Kind: AttributeAdded, To: "string"},
+		{Attribute: "status", Kind: AttributeRemoved, From: "string"},
+	}, changes)
+	assert.Equal(t, "points changed from type string to number", changes[1].String())
+	assert.Empty(t, Compare(mockLocalDatastore(), mockLocalDatastore()))
+}
+
+func Test_ParseTransforms(t *testing.T) {
+	tests := map[string]struct {
+		options             Options
+		expectedTransforms  []Transform
+		expectedRemediation string
+	}{
+		"parses each kind of transform": {
+			options: Options{
+				Renames:  []string{"status=state"},
+				Defaults: []string{"priority=3"},
+				Drops:    []string{"legacy"},
+				Coerces:  []string{"points"},
+			},
+			expectedTransforms: []Transform{
+				{Kind: Rename, Attribute: "status", Target: "state"},
+				{Kind: Coerce, Attribute: "points", Target: "number"},
+				{Kind: Default, Attribute: "priority", Value: float64(3)},
+				{Kind: Drop, Attribute: "legacy"},
+			},
+		},
+		"keeps default text of string attributes": {
+			options: Options{Defaults: []string{"state=true"}},
+			expectedTransforms: []Transform{
+				{Kind: Default, Attribute: "state", Value: "true"},
+			},
+		},
+		"errors for renames without a new name": {
+			options:             Options{Renames: []string{"status"}},
+			expectedRemediation: "Use the format --rename <old>=<new>",
+		},
+		"errors for renames of the primary key": {
+			options:             Options{Renames: []string{"id=state"}},
+			expectedRemediation: "The primary key of a datastore cannot be renamed",
+		},
+		"errors for defaults of the wrong type": {
+			options:             Options{Defaults: []string{"priority=high"}},
+			expectedRemediation: `The value "high" is not a number`,
+		},
+		"errors for drops of attributes in the local manifest": {
+			options:             Options{Drops: []string{"points"}},
+			expectedRemediation: `The attribute "points" is still defined in the local manifest`,
+		},
+		"errors for coercions to unsupported types": {
+			options:             Options{Coerces: []string{"points=decimal"}},
+			expectedRemediation: "Values can only be coerced to a type of string, number, integer, boolean, array, object",
+		},
+		"errors for coercions to a type other than the local schema": {
+			options:             Options{Coerces: []string{"points=string"}},
+			expectedRemediation: `The attribute "points" has the type number in the local manifest`,
+		},
+		"parses coercions to the type of the local schema": {
+			options: Options{Coerces: []string{"points=number"}},
+			expectedTransforms: []Transform{
+				{Kind: Coerce, Attribute: "points", Target: "number"},
+			},
+		},
+		"errors for coercions of unknown attributes": {
+			options:             Options{Coerces: []string{"estimate=number"}},
+			expectedRemediation: `The attribute "estimate" must be defined in the local manifest`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			transforms, err := ParseTransforms(tt.options, mockLocalDatastore())
+			if tt.expectedRemediation != "" {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+				assert.Equal(t, tt.expectedRemediation, slackerror.ToSlackError(err).Remediation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTransforms, transforms)
+		})
+	}
+}
+
+func Test_Unhandled(t *testing.T) {
+	changes := Compare(mockDeployedDatastore(), mockLocalDatastore())
+	transforms := []Transform{
+		{Kind: Rename, Attribute: "status", Target: "state"},
+		{Kind: Coerce, Attribute: "points", Target: "number"},
+	}
+	assert.Equal(t, []Change{
+		{Attribute: "legacy", Kind: AttributeRemoved, From: "boolean"},
+		{Attribute: "priority", Kind: AttributeAdded, To: "integer"},
+	}, Unhandled(changes, transforms))
+}
+
+func Test_Undeployed(t *testing.T) {
+	transforms := []Transform{
+		{Kind: Rename, Attribute: "status", Target: "state"},
+		{Kind: Coerce, Attribute: "points", Target: "number"},
+		{Kind: Default, Attribute: "priority", Value: float64(2)},
+		{Kind: Drop, Attribute: "legacy"},
+	}
+	assert.Equal(t, transforms[:3], Undeployed(transforms, mockDeployedDatastore()))
+	assert.Empty(t, Undeployed(transforms, mockLocalDatastore()))
+}
+
+func Test_Apply(t *testing.T) {
+	transforms := []Transform{
+		{Kind: Drop, Attribute: "legacy"},
+		{Kind: Default, Attribute: "priority", Value: float64(3)},
+		{Kind: Coerce, Attribute: "points", Target: "number"},
+		{Kind: Rename, Attribute: "status", Target: "state"},
+	}
+	tests := map[string]struct {
+		item            map[string]interface{}
+		expectedItem    map[string]interface{}
+		expectedApplied []Transform
+		expectedError   string
+	}{
+		"applies transforms in order": {
+			item:         map[string]interface{}{"id": "1", "status": "done", "points": "5", "legacy": true},
+			expectedItem: map[string]interface{}{"id": "1", "state": "done", "points": float64(5), "priority": float64(3)},
+			expectedApplied: []Transform{
+				transforms[3],
+				transforms[2],
+				transforms[1],
+				transforms[0],
+			},
+		},
+		"leaves items in the new shape unchanged": {
+			item:            map[string]interface{}{"id": "2", "state": "done", "points": float64(1), "priority": float64(1)},
+			expectedItem:    map[string]interface{}{"id": "2", "state": "done", "points": float64(1), "priority": float64(1)},
+			expectedApplied: []Transform{},
+		},
+		"errors if a value cannot be coerced": {
+			item:          map[string]interface{}{"id": "3", "points": "many"},
+			expectedError: `attribute points: "many" is not a number`,
+		},
+		"errors if a renamed attribute already has a value": {
+			item:          map[string]interface{}{"id": "4", "status": "done", "state": "open"},
+			expectedError: "cannot rename status since state already has a value",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := map[string]interface{}{}
+			for key, value := range tt.item {
+				original[key] = value
+			}
+			item, applied, err := Apply(tt.item, transforms)
+			assert.Equal(t, original, tt.item)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedItem, item)
+			assert.Equal(t, tt.expectedApplied, applied)
+		})
+	}
+}
@@ -84,3 +84,48 @@ func JSONUnmarshal(data []byte, v interface{}) error {
 	}
 	return nil
 }
+
+// tokenPrefixes are the prefixes of Slack token values
+var tokenPrefixes = []string{"xapp-", "xoxb-", "xoxe-", "xoxp-"}
+
+// RedactJSONTokens replaces the values of "token" keys, keys that end with
+// "_token", and strings that look like Slack tokens with "..." in JSON data.
+// Data that is not valid JSON is returned unchanged.
+func RedactJSONTokens(data []byte) []byte {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return data
+	}
+	redacted, err := JSONMarshalUnescaped(redactTokens(v))
+	if err != nil {
+		return data
+	}
+	return []byte(strings.TrimSuffix(redacted, "\n"))
+}
+
+// redactTokens replaces token values within a decoded JSON value
+func redactTokens(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, val := range value {
+			if _, ok := val.(string); ok && (key == "token" || strings.HasSuffix(key, "_token")) {
+				value[key] = "..."
+				continue
+			}
+			value[key] = redactTokens(val)
+		}
+	case []interface{}:
+		for i, val := range value {
+			value[i] = redactTokens(val)
+		}
+	case string:
+		for _, prefix := range tokenPrefixes {
+			if strings.HasPrefix(value, prefix) {
+				return "..."
+			}
+		}
+	}
+	return v
+}
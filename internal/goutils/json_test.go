@@ -192,3 +192,36 @@ func Test_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_RedactJSONTokens(t *testing.T) {
+	for name, tt := range map[string]struct {
+		data         string
+		expectedJSON string
+	}{
+		"redacts token keys": {
+			data:         `{"token":"abc","bot_access_token":"xoxb-123","team_id":"T001"}`,
+			expectedJSON: `{"token":"...","bot_access_token":"...","team_id":"T001"}`,
+		},
+		"redacts token values in nested objects and arrays": {
+			data:         `{"event":{"inputs":{"keys":["xoxp-123","value"]},"count":12345678901234567890}}`,
+			expectedJSON: `{"event":{"inputs":{"keys":["...","value"]},"count":12345678901234567890}}`,
+		},
+		"keeps token keys that are not strings": {
+			data:         `{"next_token":null,"token":{"id":"1"}}`,
+			expectedJSON: `{"next_token":null,"token":{"id":"1"}}`,
+		},
+		"returns invalid json unchanged": {
+			data:         `}{`,
+			expectedJSON: `}{`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			redacted := RedactJSONTokens([]byte(tt.data))
+			if json.Valid([]byte(tt.expectedJSON)) {
+				assert.JSONEq(t, tt.expectedJSON, string(redacted))
+			} else {
+				assert.Equal(t, tt.expectedJSON, string(redacted))
+			}
+		})
+	}
+}
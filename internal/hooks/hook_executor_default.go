@@ -68,9 +68,12 @@ func (e *HookExecutorDefaultProtocol) Execute(ctx context.Context, opts HookExec
 	}
 
 	cmd := opts.Exec.Command(cmdEnvVars, stdout, stderr, opts.Stdin, cmdArgs[0], cmdArgVars...)
-	err = cmd.Run()
+	err = runCommand(ctx, opts.Hook, cmd)
 
 	response := strings.TrimSpace(buffout.String())
+	if isStopped(err) {
+		return "", err
+	}
 	if err != nil {
 		return "", slackerror.New(slackerror.ErrSDKHookInvocationFailed).
 			WithMessage("Command for '%s' returned an error: %s\n%s", opts.Hook.Name, err, strings.TrimSpace(bufferr.String()))
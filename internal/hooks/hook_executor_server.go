@@ -0,0 +1,337 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// servedHooks maps the hooks answered by a hook server to the hook names that
+// are sent in requests
+var servedHooks = map[string]string{
+	"GetManifest": "get-manifest",
+	"GetTrigger":  "get-trigger",
+	"Start":       "start",
+}
+
+// maxHookServerFrameSize is the largest response line read from a hook server
+const maxHookServerFrameSize = 16 * 1024 * 1024
+
+// hookServerRequest is a single line of JSON written to the hook server stdin
+type hookServerRequest struct {
+	ID    string            `json:"id"`
+	Hook  string            `json:"hook"`
+	Args  map[string]string `json:"args,omitempty"`
+	Env   map[string]string `json:"env,omitempty"`
+	Stdin string            `json:"stdin,omitempty"`
+}
+
+// hookServerResponse is a single line of JSON read from the hook server stdout.
+// Lines that aren't responses are treated as diagnostic output.
+type hookServerResponse struct {
+	ID       string `json:"id"`
+	Response string `json:"response"`
+	Output   string `json:"output,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// HookExecutorServerProtocol uses a protocol between the CLI and the SDK where the SDK starts once as a
+// long-lived hook server and requests and responses are exchanged as lines of JSON over stdin and stdout.
+// Hooks that aren't served are run with the fallback executor.
+type HookExecutorServerProtocol struct {
+	IO       iostreams.IOStreamer
+	Fallback HookExecutor
+
+	mu      sync.Mutex
+	servers map[string]*hookServer
+}
+
+// Execute sends the hook request to the hook server for the hook command,
+// starting the server on first use.
+func (e *HookExecutorServerProtocol) Execute(ctx context.Context, opts HookExecOpts) (string, error) {
+	hook, ok := servedHooks[opts.Hook.Name]
+	if !ok {
+		return e.Fallback.Execute(ctx, opts)
+	}
+
+	server, err := e.server(ctx, opts)
+	if err != nil {
+		return "", err
+	}
+	defer server.active.Done()
+
+	req := hookServerRequest{
+		Hook: hook,
+		Args: opts.Args,
+		Env:  opts.Env,
+	}
+	if opts.Stdin != nil {
+		stdin, err := io.ReadAll(opts.Stdin)
+		if err != nil {
+			return "", err
+		}
+		req.Stdin = string(stdin)
+	}
+
+	requestCtx := ctx
+	if opts.Hook.Timeout > 0 {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(ctx, opts.Hook.Timeout)
+		defer cancel()
+	}
+	res, err := server.request(requestCtx, req)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return "", slackerror.New(slackerror.ErrProcessInterrupted)
+	case err != nil && errors.Is(requestCtx.Err(), context.DeadlineExceeded):
+		// The server is restarted for the next request since it stopped answering
+		e.discard(opts.Hook.Command, server)
+		return "", slackerror.New(slackerror.ErrSDKHookTimeout).
+			WithMessage("The '%s' hook didn't finish within %s", opts.Hook.Name, opts.Hook.Timeout)
+	case err != nil:
+		return "", slackerror.New(slackerror.ErrSDKHookInvocationFailed).
+			WithMessage("Error running '%s' command: %s", opts.Hook.Name, err)
+	}
+	if res.Output != "" {
+		_, _ = e.IO.WriteDebug(ctx).Write([]byte(res.Output))
+		if opts.Stdout != nil {
+			_, _ = opts.Stdout.Write([]byte(res.Output))
+		}
+	}
+	if res.Error != "" {
+		return "", slackerror.New(slackerror.ErrSDKHookInvocationFailed).
+			WithMessage("Error running '%s' command: %s", opts.Hook.Name, res.Error)
+	}
+	return strings.TrimSpace(res.Response), nil
+}
+
+// Close stops the running hook servers after the requests being handled are
+// answered. Servers are started again when a hook is next executed, which
+// loads changes to the app code.
+func (e *HookExecutorServerProtocol) Close() error {
+	e.mu.Lock()
+	servers := e.servers
+	e.servers = nil
+	e.mu.Unlock()
+	for _, server := range servers {
+		server.active.Wait()
+		server.stop()
+	}
+	return nil
+}
+
+// discard stops a hook server without waiting for the requests being handled
+// so that the next request to the hook command starts another server
+func (e *HookExecutorServerProtocol) discard(command string, server *hookServer) {
+	e.mu.Lock()
+	if e.servers[command] == server {
+		delete(e.servers, command)
+	}
+	e.mu.Unlock()
+	server.stop()
+}
+
+// server returns the running hook server for the hook command or starts one.
+// The request is counted as active on the server until it is done.
+func (e *HookExecutorServerProtocol) server(ctx context.Context, opts HookExecOpts) (*hookServer, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.servers == nil {
+		e.servers = map[string]*hookServer{}
+	}
+	if server, ok := e.servers[opts.Hook.Command]; ok && !server.exited() {
+		server.active.Add(1)
+		return server, nil
+	}
+	server, err := startHookServer(ctx, e.IO, opts)
+	if err != nil {
+		return nil, err
+	}
+	server.active.Add(1)
+	e.servers[opts.Hook.Command] = server
+	return server, nil
+}
+
+// hookServer is a started hook command that answers requests until it exits
+type hookServer struct {
+	cmd     ShellCommand
+	stdin   io.WriteCloser
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	nextID  int
+	pending map[string]chan hookServerResponse
+	done    chan struct{}
+	// active counts the requests being handled so the server is stopped after
+	// these are answered
+	active sync.WaitGroup
+}
+
+// startHookServer starts the hook command with pipes for stdin and stdout.
+// Files are used for the pipes so that the process owns these streams without
+// copies that would keep the command from exiting. The ends of the pipes used
+// by the command are closed after it exits to end reading from stdout.
+func startHookServer(ctx context.Context, ios iostreams.IOStreamer, opts HookExecOpts) (*hookServer, error) {
+	cmdArgs, cmdArgVars, cmdEnvVars, err := processExecOpts(HookExecOpts{Hook: opts.Hook})
+	if err != nil {
+		return nil, err
+	}
+	cmdArgVars = append(cmdArgVars, "--protocol="+HookProtocolServer.String())
+
+	if opts.Exec == nil {
+		opts.Exec = ShellExec{}
+	}
+
+	stdinReader, stdinWriter, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		_ = stdinReader.Close()
+		_ = stdinWriter.Close()
+		return nil, err
+	}
+
+	ios.PrintDebug(ctx, "starting hook server: %s %s", cmdArgs[0], strings.Join(cmdArgVars, " "))
+	cmd := opts.Exec.Command(cmdEnvVars, stdoutWriter, ios.WriteDebug(ctx), stdinReader, cmdArgs[0], cmdArgVars...)
+	if err := cmd.Start(); err != nil {
+		_ = stdinReader.Close()
+		_ = stdinWriter.Close()
+		_ = stdoutReader.Close()
+		_ = stdoutWriter.Close()
+		return nil, slackerror.New(slackerror.ErrSDKHookInvocationFailed).
+			WithMessage("Error starting the hook server for '%s' command: %s", opts.Hook.Name, err)
+	}
+	go func() {
+		err := cmd.Wait()
+		ios.PrintDebug(ctx, "hook server exited: %v", err)
+		_ = stdinReader.Close()
+		_ = stdoutWriter.Close()
+	}()
+
+	server := &hookServer{
+		cmd:     cmd,
+		stdin:   stdinWriter,
+		pending: map[string]chan hookServerResponse{},
+		done:    make(chan struct{}),
+	}
+	go server.read(ctx, ios, stdoutReader)
+	return server, nil
+}
+
+// read delivers responses to pending requests until the hook server exits
+func (s *hookServer) read(ctx context.Context, ios iostreams.IOStreamer, stdout io.ReadCloser) {
+	defer func() {
+		_ = stdout.Close()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.pending = nil
+		close(s.done)
+	}()
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxHookServerFrameSize)
+	for scanner.Scan() {
+		var res hookServerResponse
+		if err := json.Unmarshal(scanner.Bytes(), &res); err != nil || res.ID == "" {
+			ios.PrintDebug(ctx, "%s", scanner.Text())
+			continue
+		}
+		s.mu.Lock()
+		ch, ok := s.pending[res.ID]
+		delete(s.pending, res.ID)
+		s.mu.Unlock()
+		if ok {
+			ch <- res
+		}
+	}
+}
+
+// request writes a request to the hook server and waits for the response
+func (s *hookServer) request(ctx context.Context, req hookServerRequest) (hookServerResponse, error) {
+	ch := make(chan hookServerResponse, 1)
+	s.mu.Lock()
+	if s.pending == nil {
+		s.mu.Unlock()
+		return hookServerResponse{}, slackerror.New("the hook server exited")
+	}
+	s.nextID++
+	req.ID = strconv.Itoa(s.nextID)
+	s.pending[req.ID] = ch
+	s.mu.Unlock()
+
+	forget := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.pending, req.ID)
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		forget()
+		return hookServerResponse{}, err
+	}
+	s.writeMu.Lock()
+	_, err = s.stdin.Write(append(data, '\n'))
+	s.writeMu.Unlock()
+	if err != nil {
+		forget()
+		return hookServerResponse{}, err
+	}
+
+	select {
+	case res := <-ch:
+		return res, nil
+	case <-s.done:
+		select {
+		case res := <-ch:
+			return res, nil
+		default:
+			return hookServerResponse{}, slackerror.New("the hook server exited before responding")
+		}
+	case <-ctx.Done():
+		forget()
+		return hookServerResponse{}, ctx.Err()
+	}
+}
+
+// exited returns true after the hook server process exits
+func (s *hookServer) exited() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// stop closes stdin so the hook server can exit and kills the started process
+func (s *hookServer) stop() {
+	_ = s.stdin.Close()
+	if killer, ok := s.cmd.(interface{ Kill() error }); ok {
+		_ = killer.Kill()
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHookServerExec starts hook servers that answer requests in a goroutine
+type fakeHookServerExec struct {
+	mu      sync.Mutex
+	started int
+	args    []string
+	// held is signaled when a "hold" request is received and release answers it
+	held    chan struct{}
+	release chan struct{}
+}
+
+func (e *fakeHookServerExec) Command(env []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, name string, arg ...string) ShellCommand {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.started++
+	e.args = arg
+	return &fakeHookServerCommand{stdin: stdin, stdout: stdout, done: make(chan struct{}), held: e.held, release: e.release}
+}
+
+// fakeHookServerCommand responds with the hook name and stdin of requests. The
+// "fail" hook answers with an error, the "exit" hook stops the server, the
+// "hang" hook never answers, and the "hold" hook answers once released.
+type fakeHookServerCommand struct {
+	MockCommand
+	stdin   io.Reader
+	stdout  io.Writer
+	done    chan struct{}
+	held    chan struct{}
+	release chan struct{}
+}
+
+func (c *fakeHookServerCommand) Start() error {
+	go func() {
+		defer close(c.done)
+		scanner := bufio.NewScanner(c.stdin)
+		for scanner.Scan() {
+			var req hookServerRequest
+			if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+				return
+			}
+			res := hookServerResponse{
+				ID:       req.ID,
+				Response: fmt.Sprintf(`{"hook":%q,"stdin":%q,"source":%q}`, req.Hook, req.Stdin, req.Args["source"]),
+				Output:   "handled " + req.Hook + "\n",
+			}
+			switch req.Hook {
+			case "exit":
+				return
+			case "hang":
+				continue
+			case "fail":
+				res.Error = "something broke"
+			case "hold":
+				c.held <- struct{}{}
+				<-c.release
+			}
+			_, _ = fmt.Fprintln(c.stdout, "a log line that is not a response")
+			data, _ := json.Marshal(res)
+			_, _ = c.stdout.Write(append(data, '\n'))
+		}
+	}()
+	return nil
+}
+
+func (c *fakeHookServerCommand) Wait() error {
+	<-c.done
+	return nil
+}
+
+func newHookServerExecutor(t *testing.T) (*HookExecutorServerProtocol, *MockHookExecutor) {
+	os := slackdeps.NewOsMock()
+	os.AddDefaultMocks()
+	fs := slackdeps.NewFsMock()
+	config := config.NewConfig(fs, os)
+	io := iostreams.NewIOStreamsMock(config, fs, os)
+	io.AddDefaultMocks()
+	fallback := &MockHookExecutor{}
+	fallback.On("Execute", mock.Anything, mock.Anything).Return("fallback", nil)
+	executor := &HookExecutorServerProtocol{IO: io, Fallback: fallback}
+	t.Cleanup(func() { _ = executor.Close() })
+	return executor, fallback
+}
+
+func Test_Hook_Execute_Server_Protocol(t *testing.T) {
+	t.Run("starts the server once for requests to the same command", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{}
+
+		stdout := bytes.Buffer{}
+		response, err := executor.Execute(ctx, HookExecOpts{
+			Hook:   HookScript{Name: "Start", Command: "node server.js"},
+			Stdin:  strings.NewReader(`{"type":"event"}`),
+			Stdout: &stdout,
+			Exec:   exec,
+		})
+		require.NoError(t, err)
+		require.Equal(t, `{"hook":"start","stdin":"{\"type\":\"event\"}","source":""}`, response)
+		require.Equal(t, "handled start\n", stdout.String())
+
+		response, err = executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "GetManifest", Command: "node server.js"},
+			Args: map[string]string{"source": "/path/to/project"},
+			Exec: exec,
+		})
+		require.NoError(t, err)
+		require.Equal(t, `{"hook":"get-manifest","stdin":"","source":"/path/to/project"}`, response)
+
+		require.Equal(t, 1, exec.started)
+		require.Contains(t, exec.args, "--protocol=hook-server")
+	})
+
+	t.Run("answers concurrent requests", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{}
+
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				body := fmt.Sprintf("event-%d", i)
+				response, err := executor.Execute(ctx, HookExecOpts{
+					Hook:  HookScript{Name: "Start", Command: "node server.js"},
+					Stdin: strings.NewReader(body),
+					Exec:  exec,
+				})
+				require.NoError(t, err)
+				require.Contains(t, response, body)
+			}()
+		}
+		wg.Wait()
+		require.Equal(t, 1, exec.started)
+	})
+
+	t.Run("returns errors from the server", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		servedHooks["Fail"] = "fail"
+		defer delete(servedHooks, "Fail")
+
+		_, err := executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "Fail", Command: "node server.js"},
+			Exec: &fakeHookServerExec{},
+		})
+		require.Error(t, err)
+		require.Equal(t, slackerror.ErrSDKHookInvocationFailed, slackerror.ToSlackError(err).Code)
+		require.Contains(t, err.Error(), "something broke")
+	})
+
+	t.Run("restarts the server after it exits", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{}
+		servedHooks["Exit"] = "exit"
+		defer delete(servedHooks, "Exit")
+
+		_, err := executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "Exit", Command: "node server.js"},
+			Exec: exec,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "exited")
+
+		_, err = executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "Start", Command: "node server.js"},
+			Exec: exec,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, exec.started)
+	})
+
+	t.Run("restarts the server after it is closed", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{}
+		opts := HookExecOpts{
+			Hook: HookScript{Name: "Start", Command: "node server.js"},
+			Exec: exec,
+		}
+
+		_, err := executor.Execute(ctx, opts)
+		require.NoError(t, err)
+		require.NoError(t, executor.Close())
+		_, err = executor.Execute(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, 2, exec.started)
+	})
+
+	t.Run("restarts the server after a request times out", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{}
+		servedHooks["Hang"] = "hang"
+		defer delete(servedHooks, "Hang")
+
+		_, err := executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "Hang", Command: "node server.js", Timeout: 50 * time.Millisecond},
+			Exec: exec,
+		})
+		require.Error(t, err)
+		require.Equal(t, slackerror.ErrSDKHookTimeout, slackerror.ToSlackError(err).Code)
+
+		_, err = executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "Start", Command: "node server.js"},
+			Exec: exec,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, exec.started)
+	})
+
+	t.Run("answers requests being handled before the server is closed", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, _ := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{held: make(chan struct{}), release: make(chan struct{})}
+		servedHooks["Hold"] = "hold"
+		defer delete(servedHooks, "Hold")
+
+		type result struct {
+			response string
+			err      error
+		}
+		results := make(chan result, 1)
+		go func() {
+			response, err := executor.Execute(ctx, HookExecOpts{
+				Hook: HookScript{Name: "Hold", Command: "node server.js"},
+				Exec: exec,
+			})
+			results <- result{response, err}
+		}()
+		<-exec.held
+
+		closed := make(chan struct{})
+		go func() {
+			_ = executor.Close()
+			close(closed)
+		}()
+		select {
+		case <-closed:
+			require.Fail(t, "the server was closed while a request was being handled")
+		case <-time.After(50 * time.Millisecond):
+		}
+		close(exec.release)
+
+		res := <-results
+		require.NoError(t, res.err)
+		require.Contains(t, res.response, `"hook":"hold"`)
+		<-closed
+	})
+
+	t.Run("runs other hooks with the fallback executor", func(t *testing.T) {
+		ctx := slackcontext.MockContext(t.Context())
+		executor, fallback := newHookServerExecutor(t)
+		exec := &fakeHookServerExec{}
+
+		response, err := executor.Execute(ctx, HookExecOpts{
+			Hook: HookScript{Name: "Deploy", Command: "node deploy.js"},
+			Exec: exec,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "fallback", response)
+		fallback.AssertNumberOfCalls(t, "Execute", 1)
+		require.Equal(t, 0, exec.started)
+	})
+}
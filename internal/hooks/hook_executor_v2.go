@@ -85,7 +85,11 @@ func (e *HookExecutorMessageBoundaryProtocol) Execute(ctx context.Context, opts
 	}
 
 	cmd := opts.Exec.Command(cmdEnvVars, &stdout, stderr, opts.Stdin, cmdArgs[0], cmdArgVars...)
-	if err = cmd.Run(); err != nil {
+	err = runCommand(ctx, opts.Hook, cmd)
+	if isStopped(err) {
+		return "", err
+	}
+	if err != nil {
 		return "", slackerror.New(slackerror.ErrSDKHookInvocationFailed).
 			WithMessage("Error running '%s' command: %s", opts.Hook.Name, err)
 	}
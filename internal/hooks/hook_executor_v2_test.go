@@ -17,8 +17,10 @@ package hooks
 import (
 	"errors"
 	"io"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
@@ -175,6 +177,32 @@ func Test_Hook_Execute_V2_Protocol(t *testing.T) {
 	}
 }
 
+func Test_Hook_Execute_V2_Protocol_Timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses the sleep command")
+	}
+	gracePeriod := stopGracePeriod
+	stopGracePeriod = 100 * time.Millisecond
+	t.Cleanup(func() {
+		stopGracePeriod = gracePeriod
+	})
+	ctx := slackcontext.MockContext(t.Context())
+	fs := slackdeps.NewFsMock()
+	os := slackdeps.NewOsMock()
+	config := config.NewConfig(fs, os)
+	ios := iostreams.NewIOStreamsMock(config, fs, os)
+	ios.AddDefaultMocks()
+	hookExecutor := &HookExecutorMessageBoundaryProtocol{
+		IO: ios,
+	}
+	// The protocol arguments are passed to the command after the sleep
+	_, err := hookExecutor.Execute(ctx, HookExecOpts{
+		Hook: HookScript{Name: "GetManifest", Command: "sleep 10; true", Timeout: 50 * time.Millisecond},
+	})
+	require.Error(t, err)
+	require.Equal(t, slackerror.ErrSDKHookTimeout, slackerror.ToSlackError(err).Code)
+}
+
 func Test_Hook_Execute_V2_GenerateMD5FromRandomString(t *testing.T) {
 	randomString1 := generateMD5FromRandomString()
 	randomString2 := generateMD5FromRandomString()
@@ -30,6 +30,19 @@ type HookExecutor interface {
 func GetHookExecutor(ios iostreams.IOStreamer, cfg SDKCLIConfig) HookExecutor {
 	protocol := cfg.Config.SupportedProtocols.Preferred()
 	switch protocol {
+	case HookProtocolServer:
+		// Hooks that aren't served use the next preferred protocol
+		fallback := cfg
+		fallback.Config.SupportedProtocols = ProtocolVersions{}
+		for _, p := range cfg.Config.SupportedProtocols {
+			if p != HookProtocolServer {
+				fallback.Config.SupportedProtocols = append(fallback.Config.SupportedProtocols, p)
+			}
+		}
+		return &HookExecutorServerProtocol{
+			IO:       ios,
+			Fallback: GetHookExecutor(ios, fallback),
+		}
 	case HookProtocolV2:
 		return &HookExecutorMessageBoundaryProtocol{
 			IO: ios,
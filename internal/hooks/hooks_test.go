@@ -44,6 +44,10 @@ func Test_Hooks_GetHookExecutor(t *testing.T) {
 			protocolVersions: ProtocolVersions{HookProtocolDefault, HookProtocolV2},
 			expectedType:     &HookExecutorDefaultProtocol{},
 		},
+		"Type HookProtocolServer": {
+			protocolVersions: ProtocolVersions{HookProtocolServer, HookProtocolV2},
+			expectedType:     &HookExecutorServerProtocol{},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -58,4 +62,16 @@ func Test_Hooks_GetHookExecutor(t *testing.T) {
 			require.IsType(t, tt.expectedType, hookExecutor)
 		})
 	}
+
+	t.Run("HookProtocolServer falls back to the next preferred protocol", func(t *testing.T) {
+		os := slackdeps.NewOsMock()
+		os.AddDefaultMocks()
+		fs := slackdeps.NewFsMock()
+		config := config.NewConfig(fs, os)
+		io := iostreams.NewIOStreamsMock(config, fs, os)
+		sdkConfig := NewSDKConfigMock()
+		sdkConfig.Config.SupportedProtocols = ProtocolVersions{HookProtocolServer, HookProtocolV2}
+		hookExecutor := GetHookExecutor(io, sdkConfig)
+		require.IsType(t, &HookExecutorMessageBoundaryProtocol{}, hookExecutor.(*HookExecutorServerProtocol).Fallback)
+	})
 }
@@ -20,6 +20,7 @@ type Protocol string
 const (
 	HookProtocolDefault Protocol = "default"
 	HookProtocolV2      Protocol = "message-boundaries"
+	HookProtocolServer  Protocol = "hook-server"
 )
 
 func (p Protocol) String() string {
@@ -28,5 +29,5 @@ func (p Protocol) String() string {
 
 // Valid returns true if this protocol is understood by the CLI.
 func (p Protocol) Valid() bool {
-	return p == HookProtocolDefault || p == HookProtocolV2
+	return p == HookProtocolDefault || p == HookProtocolV2 || p == HookProtocolServer
 }
@@ -28,6 +28,9 @@ func Test_Protocol_String(t *testing.T) {
 
 	p = HookProtocolV2
 	require.Equal(t, string(HookProtocolV2), p.String())
+
+	p = HookProtocolServer
+	require.Equal(t, string(HookProtocolServer), p.String())
 }
 
 func Test_Protocol_Valid(t *testing.T) {
@@ -39,6 +42,9 @@ func Test_Protocol_Valid(t *testing.T) {
 	p = HookProtocolV2
 	require.True(t, p.Valid())
 
+	p = HookProtocolServer
+	require.True(t, p.Valid())
+
 	p = "invalid_protocol"
 	require.False(t, p.Valid())
 }
@@ -17,6 +17,7 @@ package hooks
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/slackerror"
 )
@@ -25,6 +26,7 @@ import (
 type HookScript struct {
 	Command string
 	Name    string
+	Timeout time.Duration // Optional, stops the hook after this duration when set
 }
 
 // IsAvailable returns true when the HookScript.Command exists
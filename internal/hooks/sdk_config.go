@@ -23,8 +23,9 @@ import (
 // SDKCLIConfig contains configuration for communication between the CLI and the SDK.
 // It is set by merging the app's local hooks.json and the response from the `get-hooks` hook.
 type SDKCLIConfig struct {
-	Runtime string `json:"runtime,omitempty"` // Optional, runtime version e.g. deno, deno1.x
-	Hooks   struct {
+	Runtime        string         `json:"runtime,omitempty"`         // Optional, runtime version e.g. deno, deno1.x
+	RuntimeAdapter RuntimeAdapter `json:"runtime-adapter,omitempty"` // Optional, hooks for a runtime without built-in support
+	Hooks          struct {
 		BuildProject  HookScript `json:"build,omitempty"`
 		CheckUpdate   HookScript `json:"check-update,omitempty"`
 		Deploy        HookScript `json:"deploy,omitempty"`
@@ -40,6 +41,8 @@ type SDKCLIConfig struct {
 		SDKManagedConnection bool             `json:"sdk-managed-connection-enabled,omitempty"`
 		TriggerPaths         []string         `json:"trigger-paths,omitempty"`
 		SupportedProtocols   ProtocolVersions `json:"protocol-version,omitempty"`
+		EventConcurrency     int              `json:"event-concurrency,omitempty"` // Optional, events handled at once during local run
+		HookTimeouts         map[string]int   `json:"hook-timeouts,omitempty"`     // Optional, seconds a hook can run by hook name
 	} `json:"config,omitempty"`
 
 	WorkingDirectory string
@@ -76,3 +79,24 @@ type WatchOpts struct {
 func (w *WatchOpts) IsAvailable() bool {
 	return w != nil
 }
+
+// RuntimeAdapter implements a runtime that the CLI doesn't support natively
+// with hooks of the project
+type RuntimeAdapter struct {
+	Name  string `json:"name,omitempty"`
+	Hooks struct {
+		IgnoreDirectories   HookScript `json:"ignore-directories,omitempty"`
+		InstallDependencies HookScript `json:"install-dependencies,omitempty"`
+		PreparePackage      HookScript `json:"prepare-package,omitempty"`
+		Version             HookScript `json:"version,omitempty"`
+	} `json:"hooks,omitempty"`
+}
+
+// IsAvailable returns true when a runtime adapter is declared
+func (a RuntimeAdapter) IsAvailable() bool {
+	return strings.TrimSpace(a.Name) != "" ||
+		a.Hooks.IgnoreDirectories.IsAvailable() ||
+		a.Hooks.InstallDependencies.IsAvailable() ||
+		a.Hooks.PreparePackage.IsAvailable() ||
+		a.Hooks.Version.IsAvailable()
+}
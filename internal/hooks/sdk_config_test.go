@@ -15,6 +15,7 @@
 package hooks
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -58,6 +59,8 @@ func Test_ProtocolResolution(t *testing.T) {
 				SDKManagedConnection bool             `json:"sdk-managed-connection-enabled,omitempty"`
 				TriggerPaths         []string         `json:"trigger-paths,omitempty"`
 				SupportedProtocols   ProtocolVersions `json:"protocol-version,omitempty"`
+				EventConcurrency     int              `json:"event-concurrency,omitempty"`
+				HookTimeouts         map[string]int   `json:"hook-timeouts,omitempty"`
 			}{
 				SupportedProtocols: ProtocolVersions{
 					"fake-news",
@@ -76,6 +79,8 @@ func Test_ProtocolResolution(t *testing.T) {
 				SDKManagedConnection bool             `json:"sdk-managed-connection-enabled,omitempty"`
 				TriggerPaths         []string         `json:"trigger-paths,omitempty"`
 				SupportedProtocols   ProtocolVersions `json:"protocol-version,omitempty"`
+				EventConcurrency     int              `json:"event-concurrency,omitempty"`
+				HookTimeouts         map[string]int   `json:"hook-timeouts,omitempty"`
 			}{
 				SupportedProtocols: ProtocolVersions{
 					"fake-news",
@@ -121,3 +126,30 @@ func Test_WatchOpts_IsAvailable(t *testing.T) {
 		})
 	}
 }
+
+func Test_RuntimeAdapter_IsAvailable(t *testing.T) {
+	tests := map[string]struct {
+		hooksJSON           string
+		expectedIsAvailable bool
+	}{
+		"RuntimeAdapter not exists": {
+			hooksJSON:           `{"runtime": "deno"}`,
+			expectedIsAvailable: false,
+		},
+		"RuntimeAdapter has a name": {
+			hooksJSON:           `{"runtime-adapter": {"name": "Bun"}}`,
+			expectedIsAvailable: true,
+		},
+		"RuntimeAdapter has hooks": {
+			hooksJSON:           `{"runtime-adapter": {"hooks": {"install-dependencies": "bundle install"}}}`,
+			expectedIsAvailable: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var sdkConfig SDKCLIConfig
+			require.NoError(t, json.Unmarshal([]byte(tt.hooksJSON), &sdkConfig))
+			require.Equal(t, tt.expectedIsAvailable, sdkConfig.RuntimeAdapter.IsAvailable())
+		})
+	}
+}
@@ -15,12 +15,17 @@
 package hooks
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
 )
 
 // ExecInterface is an interface for running shell commands in the OS
@@ -56,6 +61,7 @@ func (_ ShellExec) command(name string, arg ...string) *exec.Cmd {
 func (sh ShellExec) Command(env []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, name string, arg ...string) ShellCommand {
 	cmd := sh.command(name, arg...)
 	cmd.Env = env
+	setProcessGroup(cmd)
 	if stdout != nil {
 		cmd.Stdout = stdout
 	}
@@ -73,6 +79,74 @@ type execCommander struct {
 	*exec.Cmd
 }
 
+// Interrupt asks the processes of a started command to exit
+func (c execCommander) Interrupt() error {
+	if c.Process == nil {
+		return nil
+	}
+	return interruptProcessGroup(c.Cmd)
+}
+
+// Kill stops the processes of a started command, including processes that
+// were started by the shell
+func (c execCommander) Kill() error {
+	if c.Process == nil {
+		return nil
+	}
+	return killProcessGroup(c.Cmd)
+}
+
+// stopGracePeriod is the time an interrupted command has to exit before it is
+// killed
+var stopGracePeriod = 5 * time.Second
+
+// StopCommand interrupts a started command and kills it if the command hasn't
+// exited within the grace period. The exited channel receives the result of
+// waiting on the command.
+func StopCommand(cmd ShellCommand, exited <-chan error) error {
+	if process, ok := cmd.(interface{ Interrupt() error }); ok {
+		_ = process.Interrupt()
+		select {
+		case err := <-exited:
+			return err
+		case <-time.After(stopGracePeriod):
+		}
+	}
+	if process, ok := cmd.(interface{ Kill() error }); ok {
+		_ = process.Kill()
+	}
+	return <-exited
+}
+
+// runCommand runs a command until it exits, the timeout of the hook passes, or
+// the context is canceled. A command that is stopped early returns an error
+// with a timeout or interrupted code.
+func runCommand(ctx context.Context, hook HookScript, cmd ShellCommand) error {
+	if hook.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, hook.Timeout)
+		defer cancel()
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+	select {
+	case err := <-exited:
+		return err
+	case <-ctx.Done():
+		_ = StopCommand(cmd, exited)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return slackerror.New(slackerror.ErrSDKHookTimeout).
+				WithMessage("The '%s' hook didn't finish within %s", hook.Name, hook.Timeout)
+		}
+		return slackerror.New(slackerror.ErrProcessInterrupted)
+	}
+}
+
 type HookExecOpts struct {
 	Directory string
 	Hook      HookScript
@@ -83,3 +157,12 @@ type HookExecOpts struct {
 	Stderr    io.Writer
 	Exec      ExecInterface
 }
+
+// isStopped returns true if the error is from a command that was stopped early
+func isStopped(err error) bool {
+	var slackErr *slackerror.Error
+	if !errors.As(err, &slackErr) {
+		return false
+	}
+	return slackErr.Code == slackerror.ErrSDKHookTimeout || slackErr.Code == slackerror.ErrProcessInterrupted
+}
@@ -47,6 +47,12 @@ type MockCommand struct {
 }
 
 func (c *MockCommand) Run() error {
+	c.writeOutputs()
+	return c.Err
+}
+
+// writeOutputs writes the mocked outputs to the streams of the command
+func (c *MockCommand) writeOutputs() {
 	if len(c.MockStdout) > 0 {
 		_, _ = c.stdout.Write(c.MockStdout)
 	} else if c.StdoutIO != nil {
@@ -57,11 +63,11 @@ func (c *MockCommand) Run() error {
 	} else if c.StderrIO != nil {
 		_, _ = io.Copy(c.stderr, c.StderrIO)
 	}
-	return c.Err
 }
 
 func (c *MockCommand) Start() error {
-	return c.Err
+	c.writeOutputs()
+	return nil
 }
 
 func (c *MockCommand) Wait() error {
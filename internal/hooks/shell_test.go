@@ -0,0 +1,97 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_execCommander_Kill(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses the sleep command")
+	}
+	cmd := ShellExec{}.Command(os.Environ(), nil, nil, nil, "exec", "sleep", "10")
+	process, ok := cmd.(interface{ Kill() error })
+	require.True(t, ok)
+	assert.NoError(t, process.Kill(), "commands that are not started are not killed")
+	require.NoError(t, cmd.Start())
+	require.NoError(t, process.Kill())
+	assert.Error(t, cmd.Wait())
+}
+
+func Test_runCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses the sleep command")
+	}
+	gracePeriod := stopGracePeriod
+	stopGracePeriod = 100 * time.Millisecond
+	t.Cleanup(func() {
+		stopGracePeriod = gracePeriod
+	})
+	tests := map[string]struct {
+		hook         HookScript
+		cancel       bool
+		expectedCode string
+	}{
+		"returns the result of a command that exits": {
+			hook: HookScript{Name: "GetManifest", Timeout: 10 * time.Second},
+		},
+		"stops a command that runs past the hook timeout": {
+			hook:         HookScript{Name: "GetManifest", Timeout: 50 * time.Millisecond},
+			expectedCode: slackerror.ErrSDKHookTimeout,
+		},
+		"stops a command when the context is canceled": {
+			hook:         HookScript{Name: "Start"},
+			cancel:       true,
+			expectedCode: slackerror.ErrProcessInterrupted,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			if tt.cancel {
+				time.AfterFunc(50*time.Millisecond, cancel)
+			}
+			stdout := &bytes.Buffer{}
+			args := []string{"0"}
+			if tt.expectedCode != "" {
+				// The background process holds the output open unless the
+				// process group is stopped
+				args = []string{"10", "&", "sleep", "10"}
+			}
+			cmd := ShellExec{}.Command(os.Environ(), stdout, nil, nil, "sleep", args...)
+			started := time.Now()
+			err := runCommand(ctx, tt.hook, cmd)
+			assert.Less(t, time.Since(started), 5*time.Second)
+			if tt.expectedCode == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, slackerror.ToSlackError(err).Code)
+		})
+	}
+}
@@ -75,6 +75,8 @@ type IOStreamer interface {
 	Printer
 	// Writer contains implementations of io.Writer to log and output inputs
 	Writer
+	// Outputter contains implementations that write structured data for scripts
+	Outputter
 
 	// SetCmdIO sets the Cobra command I/O to match the IOStream
 	SetCmdIO(cmd *cobra.Command)
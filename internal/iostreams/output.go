@@ -0,0 +1,224 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iostreams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/toughtackle/slack-cli/internal/goutils"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFormat is the format of command output chosen with the --output flag
+type OutputFormat string
+
+const (
+	OutputText     OutputFormat = "text"
+	OutputJSON     OutputFormat = "json"
+	OutputYAML     OutputFormat = "yaml"
+	OutputTemplate OutputFormat = "template"
+)
+
+// Outputter contains implementations that write structured data in the format
+// of the --output flag for scripts to read
+type Outputter interface {
+	// IsStructuredOutput returns true if the output is JSON, YAML, or a template
+	// instead of text
+	IsStructuredOutput() bool
+	// PrintStructured writes data to stdout as a single document
+	PrintStructured(ctx context.Context, data any) error
+	// PrintStructuredLine writes data to stdout as one of many events, with JSON
+	// on a single line and YAML as a separate document
+	PrintStructuredLine(ctx context.Context, data any) error
+}
+
+// ParseOutputFormat returns the format of an --output flag value and the Go
+// template of the template format
+func ParseOutputFormat(flag string) (OutputFormat, string, error) {
+	name, tmpl, hasTemplate := strings.Cut(flag, "=")
+	switch OutputFormat(strings.ToLower(strings.TrimSpace(name))) {
+	case "", OutputText:
+		if !hasTemplate {
+			return OutputText, "", nil
+		}
+	case OutputJSON:
+		if !hasTemplate {
+			return OutputJSON, "", nil
+		}
+	case OutputYAML:
+		if !hasTemplate {
+			return OutputYAML, "", nil
+		}
+	case OutputTemplate:
+		if hasTemplate && tmpl != "" {
+			return OutputTemplate, tmpl, nil
+		}
+		return "", "", slackerror.New(slackerror.ErrInvalidFlag).
+			WithMessage("The --output template is missing").
+			WithRemediation("Provide a Go template such as --output 'template={{.version}}'")
+	}
+	return "", "", slackerror.New(slackerror.ErrInvalidFlag).
+		WithMessage("The --output format \"%s\" is not supported", flag).
+		WithRemediation("Choose an output of text, json, yaml, or template=<go template>")
+}
+
+// IsStructuredOutput returns true if the output is JSON, YAML, or a template
+func (io *IOStreams) IsStructuredOutput() bool {
+	return isStructuredOutput(io.config.OutputFlag)
+}
+
+// PrintStructured writes data to stdout in the format of the --output flag
+func (io *IOStreams) PrintStructured(ctx context.Context, data any) error {
+	return writeStructured(io.WriteOut(), io.config.OutputFlag, data, false)
+}
+
+// PrintStructuredLine writes an event to stdout in the format of the --output
+// flag
+func (io *IOStreams) PrintStructuredLine(ctx context.Context, data any) error {
+	return writeStructured(io.WriteOut(), io.config.OutputFlag, data, true)
+}
+
+func isStructuredOutput(flag string) bool {
+	format, _, err := ParseOutputFormat(flag)
+	return err == nil && format != OutputText
+}
+
+// writeStructured encodes data as JSON then writes it in the output format.
+// YAML keeps the order of JSON keys and templates are executed with values
+// decoded from JSON so fields are named by JSON keys.
+func writeStructured(w io.Writer, flag string, data any, line bool) error {
+	format, tmpl, err := ParseOutputFormat(flag)
+	if err != nil {
+		return err
+	}
+	encoded, err := goutils.JSONMarshalUnescaped(data)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+	}
+	var output string
+	switch format {
+	case OutputYAML:
+		decoded, err := decodeOrderedJSON(json.NewDecoder(strings.NewReader(encoded)))
+		if err != nil {
+			return slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+		}
+		document, err := yaml.Marshal(decoded)
+		if err != nil {
+			return err
+		}
+		output = string(document)
+		if line {
+			output = "---\n" + output
+		}
+	case OutputTemplate:
+		var decoded any
+		decoder := json.NewDecoder(strings.NewReader(encoded))
+		decoder.UseNumber()
+		if err := decoder.Decode(&decoded); err != nil {
+			return slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+		}
+		parsed, err := template.New("output").Funcs(outputTemplateFuncs).Parse(tmpl)
+		if err != nil {
+			return outputTemplateError(err)
+		}
+		var buff bytes.Buffer
+		if err := parsed.Execute(&buff, decoded); err != nil {
+			return outputTemplateError(err)
+		}
+		output = buff.String()
+		if !strings.HasSuffix(output, "\n") {
+			output += "\n"
+		}
+	default:
+		if line {
+			output = encoded
+		} else if output, err = goutils.JSONMarshalUnescapedIndent(data); err != nil {
+			return slackerror.Wrap(err, slackerror.ErrUnableToParseJSON)
+		}
+	}
+	_, err = fmt.Fprint(w, output)
+	return err
+}
+
+// outputTemplateFuncs are functions available to --output templates
+var outputTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		encoded, err := goutils.JSONMarshalUnescaped(v)
+		return strings.TrimSuffix(encoded, "\n"), err
+	},
+}
+
+func outputTemplateError(err error) error {
+	return slackerror.New(slackerror.ErrInvalidFlag).
+		WithMessage("The --output template cannot be used").
+		WithDetails(slackerror.ErrorDetails{
+			slackerror.ErrorDetail{Message: err.Error()},
+		}).
+		WithRemediation("Fields of the template are named by the keys of the --output json format")
+}
+
+// decodeOrderedJSON decodes the next JSON value with objects as a slice of
+// keys and values to keep the order of keys
+func decodeOrderedJSON(decoder *json.Decoder) (any, error) {
+	decoder.UseNumber()
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch value := token.(type) {
+	case json.Delim:
+		switch value {
+		case '{':
+			object := yaml.MapSlice{}
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeOrderedJSON(decoder)
+				if err != nil {
+					return nil, err
+				}
+				object = append(object, yaml.MapItem{Key: key, Value: value})
+			}
+			_, err = decoder.Token()
+			return object, err
+		case '[':
+			array := []any{}
+			for decoder.More() {
+				value, err := decodeOrderedJSON(decoder)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			}
+			_, err = decoder.Token()
+			return array, err
+		}
+	case json.Number:
+		if integer, err := value.Int64(); err == nil {
+			return integer, nil
+		}
+		return value.Float64()
+	}
+	return token, nil
+}
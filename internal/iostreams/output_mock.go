@@ -0,0 +1,34 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iostreams
+
+import (
+	"context"
+)
+
+// IsStructuredOutput returns true if the mocked output flag is not text
+func (m *IOStreamsMock) IsStructuredOutput() bool {
+	return isStructuredOutput(m.config.OutputFlag)
+}
+
+// PrintStructured writes data to the mocked stdout in the output format
+func (m *IOStreamsMock) PrintStructured(ctx context.Context, data any) error {
+	return writeStructured(m.WriteOut(), m.config.OutputFlag, data, false)
+}
+
+// PrintStructuredLine writes an event to the mocked stdout in the output format
+func (m *IOStreamsMock) PrintStructuredLine(ctx context.Context, data any) error {
+	return writeStructured(m.WriteOut(), m.config.OutputFlag, data, true)
+}
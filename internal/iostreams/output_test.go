@@ -0,0 +1,145 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iostreams
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseOutputFormat(t *testing.T) {
+	tests := map[string]struct {
+		flag             string
+		expectedFormat   OutputFormat
+		expectedTemplate string
+		expectedError    bool
+	}{
+		"empty values are text": {
+			flag:           "",
+			expectedFormat: OutputText,
+		},
+		"formats ignore case": {
+			flag:           "JSON",
+			expectedFormat: OutputJSON,
+		},
+		"yaml is a format": {
+			flag:           "yaml",
+			expectedFormat: OutputYAML,
+		},
+		"templates follow an equals sign": {
+			flag:             "template={{.version}}={{.name}}",
+			expectedFormat:   OutputTemplate,
+			expectedTemplate: "{{.version}}={{.name}}",
+		},
+		"templates must not be empty": {
+			flag:          "template=",
+			expectedError: true,
+		},
+		"other formats error": {
+			flag:          "xml",
+			expectedError: true,
+		},
+		"other formats do not take a template": {
+			flag:          "json={{.}}",
+			expectedError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			format, tmpl, err := ParseOutputFormat(tt.flag)
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, format)
+			assert.Equal(t, tt.expectedTemplate, tmpl)
+		})
+	}
+}
+
+func Test_writeStructured(t *testing.T) {
+	type app struct {
+		AppID string   `json:"app_id"`
+		Name  string   `json:"name"`
+		Count int      `json:"count"`
+		Tags  []string `json:"tags"`
+	}
+	data := map[string]any{
+		"apps": []app{
+			{AppID: "A2", Name: "zebra <beta>", Count: 1000000, Tags: []string{"b", "a"}},
+		},
+	}
+	tests := map[string]struct {
+		flag     string
+		line     bool
+		expected string
+	}{
+		"json is indented without escaping html": {
+			flag:     "json",
+			expected: "{\n  \"apps\": [\n    {\n      \"app_id\": \"A2\",\n      \"name\": \"zebra <beta>\",\n      \"count\": 1000000,\n      \"tags\": [\n        \"b\",\n        \"a\"\n      ]\n    }\n  ]\n}\n",
+		},
+		"json lines are compact": {
+			flag:     "json",
+			line:     true,
+			expected: "{\"apps\":[{\"app_id\":\"A2\",\"name\":\"zebra <beta>\",\"count\":1000000,\"tags\":[\"b\",\"a\"]}]}\n",
+		},
+		"yaml keeps the order of fields": {
+			flag:     "yaml",
+			expected: "apps:\n- app_id: A2\n  name: zebra <beta>\n  count: 1000000\n  tags:\n  - b\n  - a\n",
+		},
+		"yaml lines are separate documents": {
+			flag:     "yaml",
+			line:     true,
+			expected: "---\napps:\n- app_id: A2\n  name: zebra <beta>\n  count: 1000000\n  tags:\n  - b\n  - a\n",
+		},
+		"templates use json field names": {
+			flag:     "template={{range .apps}}{{.app_id}} {{.count}} {{json .tags}}{{end}}",
+			expected: "A2 1000000 [\"b\",\"a\"]\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, writeStructured(&buff, tt.flag, data, tt.line))
+			assert.Equal(t, tt.expected, buff.String())
+		})
+	}
+
+	t.Run("errors for templates that fail", func(t *testing.T) {
+		var buff bytes.Buffer
+		err := writeStructured(&buff, "template={{.apps.name", data, false)
+		require.Error(t, err)
+		assert.Equal(t, slackerror.ErrInvalidFlag, slackerror.ToSlackError(err).Code)
+	})
+}
+
+func Test_IOStreams_IsStructuredOutput(t *testing.T) {
+	fsMock := slackdeps.NewFsMock()
+	osMock := slackdeps.NewOsMock()
+	config := config.NewConfig(fsMock, osMock)
+	io := NewIOStreams(config, fsMock, osMock)
+	for flag, expected := range map[string]bool{"": false, "text": false, "json": true, "yaml": true, "template={{.}}": true, "xml": false} {
+		config.OutputFlag = flag
+		assert.Equal(t, expected, io.IsStructuredOutput(), flag)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+)
+
+// TracesPath is the path that OTLP/HTTP collectors receive spans on
+const TracesPath = "/v1/traces"
+
+// exportTimeout is how long an endpoint is given to respond
+const exportTimeout = 10 * time.Second
+
+// Exporter sends spans to an OTLP/HTTP endpoint or appends them to a file as
+// a line of JSON for each export
+type Exporter struct {
+	fs       afero.Fs
+	endpoint string
+	path     string
+	client   *http.Client
+}
+
+// NewExporter returns an exporter for the target. Targets that are http or
+// https URLs are endpoints and other targets are file paths. Endpoints without
+// a path receive spans on TracesPath.
+func NewExporter(fs afero.Fs, target string) *Exporter {
+	exporter := &Exporter{fs: fs, client: &http.Client{Timeout: exportTimeout}}
+	if parsed, err := url.Parse(target); err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" {
+		if parsed.Path == "" || parsed.Path == "/" {
+			parsed.Path = TracesPath
+		}
+		exporter.endpoint = parsed.String()
+		return exporter
+	}
+	exporter.path = target
+	return exporter
+}
+
+// Target returns the endpoint or file that spans are written to
+func (e *Exporter) Target() string {
+	if e.endpoint != "" {
+		return e.endpoint
+	}
+	return e.path
+}
+
+// Export writes the spans of the request. Requests without spans are skipped.
+func (e *Exporter) Export(ctx context.Context, request ExportRequest) error {
+	if countSpans(request) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrOTLPExport)
+	}
+	if e.endpoint != "" {
+		return e.post(ctx, body)
+	}
+	return e.append(body)
+}
+
+// post sends the request body to the endpoint
+func (e *Exporter) post(ctx context.Context, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrOTLPExport)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := e.client.Do(request)
+	if err != nil {
+		return slackerror.New(slackerror.ErrOTLPExport).
+			WithMessage("Couldn't send spans to %s", e.endpoint).
+			WithRootCause(err)
+	}
+	defer response.Body.Close()
+	detail, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	if response.StatusCode >= 300 {
+		err := slackerror.New(slackerror.ErrOTLPExport).
+			WithMessage("The collector at %s responded with status %d", e.endpoint, response.StatusCode)
+		if message := strings.TrimSpace(string(detail)); message != "" {
+			err = err.WithDetails(slackerror.ErrorDetails{{Message: message}})
+		}
+		return err
+	}
+	return nil
+}
+
+// append adds the request body to the end of the file
+func (e *Exporter) append(body []byte) error {
+	if dir := filepath.Dir(e.path); dir != "." {
+		if err := e.fs.MkdirAll(dir, 0o755); err != nil {
+			return slackerror.Wrap(err, slackerror.ErrOTLPExport)
+		}
+	}
+	file, err := e.fs.OpenFile(e.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrOTLPExport)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(body, '\n')); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrOTLPExport)
+	}
+	return nil
+}
+
+// countSpans returns the number of spans in the request
+func countSpans(request ExportRequest) int {
+	count := 0
+	for _, resource := range request.ResourceSpans {
+		for _, scope := range resource.ScopeSpans {
+			count += len(scope.Spans)
+		}
+	}
+	return count
+}
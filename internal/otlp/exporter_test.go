@@ -0,0 +1,140 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockRequest() ExportRequest {
+	started := time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)
+	return ExportRequest{
+		ResourceSpans: []ResourceSpans{
+			{
+				Resource: Resource{Attributes: []KeyValue{Attribute("service.name", "A001")}},
+				ScopeSpans: []ScopeSpans{
+					{
+						Scope: Scope{Name: "test"},
+						Spans: []Span{
+							{
+								TraceID:           TraceID("Tr001"),
+								SpanID:            SpanID("Tr001", "/0"),
+								Name:              "greet",
+								Kind:              SpanKindInternal,
+								StartTimeUnixNano: NewUnixNano(started),
+								EndTimeUnixNano:   NewUnixNano(started.Add(time.Second)),
+								Status:            Status{Code: StatusOK},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewExporter(t *testing.T) {
+	tests := map[string]struct {
+		target         string
+		expectedTarget string
+	}{
+		"adds the traces path to endpoints without a path": {
+			target:         "http://localhost:4318",
+			expectedTarget: "http://localhost:4318/v1/traces",
+		},
+		"keeps the path of endpoints": {
+			target:         "https://collector.example.com/otlp/v1/traces",
+			expectedTarget: "https://collector.example.com/otlp/v1/traces",
+		},
+		"treats other targets as files": {
+			target:         "spans.jsonl",
+			expectedTarget: "spans.jsonl",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedTarget, NewExporter(afero.NewMemMapFs(), tt.target).Target())
+		})
+	}
+}
+
+func TestExporter_Endpoint(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	var received ExportRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, TracesPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	err := NewExporter(afero.NewMemMapFs(), server.URL).Export(ctx, mockRequest())
+	require.NoError(t, err)
+	assert.Equal(t, mockRequest(), received)
+}
+
+func TestExporter_EndpointError(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+	}))
+	defer server.Close()
+
+	err := NewExporter(afero.NewMemMapFs(), server.URL).Export(ctx, mockRequest())
+	require.Error(t, err)
+	slackErr := slackerror.ToSlackError(err)
+	assert.Equal(t, slackerror.ErrOTLPExport, slackErr.Code)
+	assert.Contains(t, slackErr.Message, "responded with status 415")
+	require.Len(t, slackErr.Details, 1)
+	assert.Equal(t, "unsupported content type", slackErr.Details[0].Message)
+}
+
+func TestExporter_File(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	fs := afero.NewMemMapFs()
+	exporter := NewExporter(fs, "traces/spans.jsonl")
+
+	require.NoError(t, exporter.Export(ctx, mockRequest()))
+	require.NoError(t, exporter.Export(ctx, ExportRequest{}))
+	require.NoError(t, exporter.Export(ctx, mockRequest()))
+
+	data, err := afero.ReadFile(fs, "traces/spans.jsonl")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2, "requests without spans are not written")
+	var written ExportRequest
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &written))
+	assert.Equal(t, mockRequest(), written)
+	assert.Contains(t, lines[0], `"startTimeUnixNano":"1741777200000000000"`)
+}
+
+func TestIDs(t *testing.T) {
+	assert.Len(t, TraceID("Tr001"), 32)
+	assert.Equal(t, TraceID("Tr001"), TraceID("Tr001"))
+	assert.NotEqual(t, TraceID("Tr001"), TraceID("Tr002"))
+	assert.Len(t, SpanID("Tr001", "/0"), 16)
+	assert.NotEqual(t, SpanID("Tr001", "/0"), SpanID("Tr001", "/0/0"))
+}
@@ -0,0 +1,145 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlp writes spans in the JSON encoding of the OpenTelemetry protocol
+// to an OTLP/HTTP endpoint or a local file.
+package otlp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SpanKindInternal is the kind of spans for work within an app
+const SpanKindInternal = 1
+
+// Status codes of a span
+const (
+	StatusUnset = 0
+	StatusOK    = 1
+	StatusError = 2
+)
+
+// ExportRequest is the body of a request to export spans
+type ExportRequest struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans"`
+}
+
+// ResourceSpans are the spans of a single resource
+type ResourceSpans struct {
+	Resource   Resource     `json:"resource"`
+	ScopeSpans []ScopeSpans `json:"scopeSpans"`
+}
+
+// Resource describes what produced the spans
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+// ScopeSpans are the spans recorded by a single instrumentation scope
+type ScopeSpans struct {
+	Scope Scope  `json:"scope"`
+	Spans []Span `json:"spans"`
+}
+
+// Scope names the instrumentation that recorded spans
+type Scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Span is a timed operation of a trace. IDs are hex encoded.
+type Span struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              int        `json:"kind"`
+	StartTimeUnixNano UnixNano   `json:"startTimeUnixNano"`
+	EndTimeUnixNano   UnixNano   `json:"endTimeUnixNano"`
+	Attributes        []KeyValue `json:"attributes,omitempty"`
+	Events            []Event    `json:"events,omitempty"`
+	Status            Status     `json:"status"`
+}
+
+// Event is a moment within a span
+type Event struct {
+	TimeUnixNano UnixNano   `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []KeyValue `json:"attributes,omitempty"`
+}
+
+// Status is the outcome of a span
+type Status struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// KeyValue is an attribute with a text value
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue holds the value of an attribute
+type AnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// Attribute returns an attribute with a text value
+func Attribute(key string, value string) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{StringValue: value}}
+}
+
+// UnixNano is a time in nanoseconds since the unix epoch. It is encoded as text
+// since the protocol uses 64 bit integers.
+type UnixNano uint64
+
+// NewUnixNano returns the time in nanoseconds since the unix epoch
+func NewUnixNano(t time.Time) UnixNano {
+	return UnixNano(t.UnixNano())
+}
+
+// MarshalJSON encodes the time as text
+func (n UnixNano) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(n), 10))
+}
+
+// UnmarshalJSON decodes the time from text or a number
+func (n *UnixNano) UnmarshalJSON(data []byte) error {
+	parsed, err := strconv.ParseUint(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*n = UnixNano(parsed)
+	return nil
+}
+
+// TraceID returns a 16 byte trace ID derived from an ID of any format so the
+// same ID always maps to the same trace
+func TraceID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:16])
+}
+
+// SpanID returns an 8 byte span ID derived from the parts that identify a span
+// within a trace
+func SpanID(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
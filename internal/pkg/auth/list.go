@@ -37,6 +37,9 @@ func List(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger
 	}
 
 	sort.SliceStable(auths, func(i, j int) bool {
+		if auths[i].TeamDomain == auths[j].TeamDomain {
+			return auths[i].Profile < auths[j].Profile
+		}
 		return auths[i].TeamDomain < auths[j].TeamDomain
 	})
 
@@ -37,9 +37,21 @@ const InvalidNoPromptFlags = "Invalid arguments, both --ticket and --challenge f
 
 // LoginWithClients ...
 func LoginWithClients(ctx context.Context, clients *shared.ClientFactory, userToken string, noRotation bool) (auth types.SlackAuth, credentialsPath string, err error) {
+	if err := validateLoginProfile(clients); err != nil {
+		return types.SlackAuth{}, "", err
+	}
 	return Login(ctx, clients.APIInterface(), clients.AuthInterface(), clients.IO, userToken, noRotation)
 }
 
+// validateLoginProfile errors before a login starts if the login cannot be
+// saved to the profile of the command
+func validateLoginProfile(clients *shared.ClientFactory) error {
+	if clients.Config.ProfileFlag == "" {
+		return nil
+	}
+	return auth.ValidateProfileName(clients.Config.ProfileFlag)
+}
+
 // Login takes the user through the Slack CLI login process
 func Login(ctx context.Context, apiClient api.APIInterface, authClient auth.AuthInterface, io iostreams.IOStreamer, userToken string, noRotation bool) (auth types.SlackAuth, credentialsPath string, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "cmd.login")
@@ -229,10 +241,11 @@ func saveNewAuth(ctx context.Context, apiClient api.APIInterface, authClient aut
 	// Write to credentials json if serviceTokenFlag is false
 	var filePath string = ""
 	if !noRotation {
-		_, credentialsLocation, err := authClient.SetAuth(ctx, newAuth)
+		savedAuth, credentialsLocation, err := authClient.SetAuth(ctx, newAuth)
 		if err != nil {
 			return types.SlackAuth{}, "", err
 		}
+		newAuth.Profile = savedAuth.Profile
 		filePath = credentialsLocation
 	}
 	// utility logging
@@ -248,6 +261,10 @@ func LoginNoPrompt(ctx context.Context, clients *shared.ClientFactory, ticketArg
 	span, ctx = opentracing.StartSpanFromContext(ctx, "authNoPrompt")
 	defer span.Finish()
 
+	if err := validateLoginProfile(clients); err != nil {
+		return types.SlackAuth{}, "", err
+	}
+
 	// existing ticket request, try to exchange
 	if ticketArg != "" && challengeCodeArg != "" {
 		authExchangeRes, err := clients.APIInterface().ExchangeAuthTicket(ctx, ticketArg, challengeCodeArg, version.Get())
@@ -0,0 +1,89 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// EmulatorDirName is the directory within the project config directory that
+// emulated datastore items are saved to
+const EmulatorDirName = "datastores"
+
+// NewEmulator creates a local datastore emulator for the datastores defined in
+// the project manifest
+func NewEmulator(ctx context.Context, clients *shared.ClientFactory) (*api.DatastoreEmulator, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.datastore.emulator")
+	defer span.Finish()
+
+	manifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(clients.SDKConfig.WorkingDirectory, config.ProjectConfigDirName, EmulatorDirName)
+	clients.IO.PrintDebug(ctx, "emulating %d datastores in %s", len(manifest.Datastores), dir)
+	return api.NewDatastoreEmulator(clients.APIInterface(), clients.Fs, dir, manifest.Datastores), nil
+}
+
+// UseEmulator replaces datastore methods of the API client with the emulator
+func UseEmulator(ctx context.Context, clients *shared.ClientFactory) error {
+	emulator, err := NewEmulator(ctx, clients)
+	if err != nil {
+		return err
+	}
+	clients.APIInterface = func() api.APIInterface { return emulator }
+	return nil
+}
+
+// ServeEmulator starts a local server that answers datastore requests from the
+// emulator and forwards other API requests to the API host. The returned URL is
+// used in place of SLACK_API_URL and the server stops when ctx is canceled.
+func ServeEmulator(ctx context.Context, clients *shared.ClientFactory, emulator *api.DatastoreEmulator) (string, error) {
+	upstream, err := url.Parse(clients.Config.APIHostResolved)
+	if err != nil {
+		return "", slackerror.Wrap(err, slackerror.ErrDatastore)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", slackerror.Wrap(err, slackerror.ErrDatastore)
+	}
+	server := &http.Server{
+		Handler:           emulator.Handler(upstream),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			clients.IO.PrintDebug(ctx, "datastore emulator stopped: %s", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+	return fmt.Sprintf("http://%s/api/", listener.Addr().String()), nil
+}
@@ -0,0 +1,549 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/datastore/format"
+	"github.com/toughtackle/slack-cli/internal/goutils"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+)
+
+// ImportCheckpointDirName is the directory within the project config directory
+// that checkpoints of bulk imports are saved to
+const ImportCheckpointDirName = "imports"
+
+// maxImportAttempts is the number of times items are sent before the import
+// stops on errors or saves items that keep failing to the checkpoint
+const maxImportAttempts = 5
+
+// ImportCheckpoint records the progress of a bulk import from a file so that an
+// interrupted import can continue from the last processed row
+type ImportCheckpoint struct {
+	Datastore string `json:"datastore"`
+	App       string `json:"app"`
+	File      string `json:"file"`
+	FileSize  int64  `json:"file_size"`
+	Format    string `json:"format"`
+	// Offset is the number of rows from the start of the file that were stored
+	// or failed to be imported
+	Offset   int `json:"offset"`
+	Imported int `json:"imported"`
+	Failed   int `json:"failed"`
+	// FailedItems could not be stored after retries and are sent again when
+	// the import is resumed
+	FailedItems []map[string]interface{} `json:"failed_items,omitempty"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+// ImportCheckpointPath returns the path of the checkpoint for importing a file
+// to a datastore of the project
+func ImportCheckpointPath(clients *shared.ClientFactory, datastore string, file string) string {
+	sum := sha256.Sum256([]byte(file))
+	name := fmt.Sprintf("%s-%s.json", datastore, hex.EncodeToString(sum[:6]))
+	return filepath.Join(clients.SDKConfig.WorkingDirectory, config.ProjectConfigDirName, ImportCheckpointDirName, name)
+}
+
+// LoadImportCheckpoint reads a saved checkpoint and returns nil if none exists
+func LoadImportCheckpoint(fs afero.Fs, path string) (*ImportCheckpoint, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint)
+	}
+	var checkpoint ImportCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint).
+			WithMessage("The checkpoint at %s could not be read", path)
+	}
+	return &checkpoint, nil
+}
+
+// SaveImportCheckpoint replaces the saved checkpoint with a temporary file so
+// an interruption does not leave a partial checkpoint
+func SaveImportCheckpoint(fs afero.Fs, path string, checkpoint ImportCheckpoint) error {
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint)
+	}
+	if err := fs.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint)
+	}
+	temporary := path + ".tmp"
+	if err := afero.WriteFile(fs, temporary, data, 0o644); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint)
+	}
+	if err := fs.Rename(temporary, path); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint)
+	}
+	return nil
+}
+
+// RemoveImportCheckpoint deletes the checkpoint of a finished import
+func RemoveImportCheckpoint(fs afero.Fs, path string) error {
+	if err := fs.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return slackerror.Wrap(err, slackerror.ErrDatastoreImportCheckpoint)
+	}
+	return nil
+}
+
+// ImportProgress counts the items of an import including earlier attempts
+// saved to the checkpoint
+type ImportProgress struct {
+	Imported int
+	Failed   int
+}
+
+// Processed returns the number of items that were imported or failed
+func (p ImportProgress) Processed() int {
+	return p.Imported + p.Failed
+}
+
+// ImportResult describes an import that stopped
+type ImportResult struct {
+	Progress   ImportProgress
+	Checkpoint ImportCheckpoint
+	// Finished is true if every row of the file was read
+	Finished bool
+}
+
+// Import stores items read from a file with a number of bulk put requests at
+// once. The checkpoint is saved after each batch of items is stored.
+type Import struct {
+	Request        types.AppDatastoreBulkPut
+	PrimaryKey     string
+	Reader         format.Reader
+	Checkpoint     ImportCheckpoint
+	CheckpointPath string
+	// BatchSize is the number of items sent with each request
+	BatchSize int
+	// MaxItems limits the number of rows read from the file if set
+	MaxItems int
+	// Workers is the number of requests sent at once
+	Workers int
+	// RequestInterval is the least amount of time between starting requests
+	RequestInterval time.Duration
+	// RetryDelay is the delay before failed items are sent again and doubles
+	// with each attempt
+	RetryDelay time.Duration
+	// OnFailure is called with the text of each item that fails to import
+	OnFailure func(text string, reason string) error
+	// OnProgress is called after items are imported or fail
+	OnProgress func(progress ImportProgress)
+}
+
+// importBatch is a set of items read from rows of the file between start and
+// end. Batches of failed items from a checkpoint have a negative start.
+type importBatch struct {
+	start     int
+	end       int
+	items     []map[string]interface{}
+	imported  int
+	failed    int
+	exhausted []map[string]interface{}
+}
+
+// Run imports rows of the file that follow the checkpoint offset and returns
+// once the file is read or an error stops the import
+func (i *Import) Run(ctx context.Context, clients *shared.ClientFactory) (ImportResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.datastore.import")
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	state := newImportState(i, clients.Fs)
+	if err := i.skipRows(); err != nil {
+		return state.result(false), err
+	}
+
+	var limiter <-chan time.Time
+	if i.RequestInterval > 0 {
+		ticker := time.NewTicker(i.RequestInterval)
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	var stopErr error
+	var stopOnce sync.Once
+	stop := func(err error) {
+		stopOnce.Do(func() {
+			stopErr = err
+			cancel()
+		})
+	}
+
+	batches := make(chan *importBatch)
+	var wg sync.WaitGroup
+	for range max(i.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				if ctx.Err() != nil {
+					continue
+				}
+				if err := i.store(ctx, clients, state, batch, limiter); err != nil {
+					stop(err)
+				}
+			}
+		}()
+	}
+	finished, err := i.dispatch(ctx, state, batches)
+	if err != nil {
+		stop(err)
+	}
+	wg.Wait()
+
+	if stopErr != nil {
+		if err := state.save(); err != nil {
+			clients.IO.PrintDebug(ctx, "failed to save the import checkpoint: %s", err)
+		}
+		return state.result(false), stopErr
+	}
+	return state.result(finished), nil
+}
+
+// skipRows reads past rows of the file that were processed before
+func (i *Import) skipRows() error {
+	for row := 0; row < i.Checkpoint.Offset; row++ {
+		_, _, err := i.Reader.Read()
+		if errors.Is(err, io.EOF) {
+			return slackerror.New(slackerror.ErrDatastoreImportCheckpoint).
+				WithMessage("The file has fewer rows than the %d rows already imported", i.Checkpoint.Offset)
+		}
+		var rowErr *format.RowError
+		if err != nil && !errors.As(err, &rowErr) {
+			return err
+		}
+	}
+	return nil
+}
+
+// dispatch sends failed items from the checkpoint then batches of rows from the
+// file to workers and returns true if every row of the file was read
+func (i *Import) dispatch(ctx context.Context, state *importState, batches chan<- *importBatch) (bool, error) {
+	defer close(batches)
+	send := func(batch *importBatch) bool {
+		select {
+		case batches <- batch:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for start := 0; start < len(i.Checkpoint.FailedItems); start += i.BatchSize {
+		end := min(start+i.BatchSize, len(i.Checkpoint.FailedItems))
+		batch := &importBatch{start: -1, items: i.Checkpoint.FailedItems[start:end]}
+		state.requeue(batch)
+		if !send(batch) {
+			return false, nil
+		}
+	}
+
+	row := i.Checkpoint.Offset
+	read := 0
+	batch := &importBatch{start: row, end: row}
+	for {
+		if i.MaxItems > 0 && read >= i.MaxItems {
+			break
+		}
+		item, text, err := i.Reader.Read()
+		if errors.Is(err, io.EOF) {
+			if batch.end > batch.start {
+				if len(batch.items) == 0 {
+					return true, state.finish(batch)
+				}
+				return send(batch), nil
+			}
+			return true, nil
+		}
+		var rowErr *format.RowError
+		if err != nil && !errors.As(err, &rowErr) {
+			return false, err
+		}
+		read++
+		row++
+		batch.end = row
+		if rowErr != nil {
+			if err := state.fail(batch, text, rowErr.Reason); err != nil {
+				return false, err
+			}
+			continue
+		}
+		if _, exists := item[i.PrimaryKey]; !exists {
+			if err := state.fail(batch, text, "primary key not found"); err != nil {
+				return false, err
+			}
+			continue
+		}
+		if slices.IndexFunc(batch.items, func(other map[string]interface{}) bool { return other[i.PrimaryKey] == item[i.PrimaryKey] }) != -1 {
+			if err := state.fail(batch, text, "item with the same primary key already exists"); err != nil {
+				return false, err
+			}
+			continue
+		}
+		batch.items = append(batch.items, item)
+		if len(batch.items) == i.BatchSize {
+			if !send(batch) {
+				return false, nil
+			}
+			batch = &importBatch{start: row, end: row}
+		}
+	}
+	if batch.end > batch.start {
+		if len(batch.items) == 0 {
+			return false, state.finish(batch)
+		}
+		send(batch)
+	}
+	return false, nil
+}
+
+// store sends the items of a batch until each is stored, fails validation, or
+// keeps failing after retries
+func (i *Import) store(ctx context.Context, clients *shared.ClientFactory, state *importState, batch *importBatch, limiter <-chan time.Time) error {
+	token := config.GetContextToken(ctx)
+	items := slices.Clone(batch.items)
+	for attempt := 1; len(items) > 0; {
+		if limiter != nil {
+			select {
+			case <-limiter:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		request := i.Request
+		request.Items = items
+		result, err := clients.APIInterface().AppsDatastoreBulkPut(ctx, token, request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var slackErr *slackerror.Error
+			if errors.As(err, &slackErr) && len(slackErr.Details) > 0 {
+				// Items with details are invalid and are not sent again
+				for _, detail := range slackErr.Details {
+					idx := slices.IndexFunc(items, func(item map[string]interface{}) bool { return item[i.PrimaryKey] == detail.Item[i.PrimaryKey] })
+					if idx == -1 {
+						return err
+					}
+					items = slices.Delete(items, idx, idx+1)
+					text, err := importItemText(detail.Item)
+					if err != nil {
+						return err
+					}
+					if err := state.fail(batch, text, detail.Message); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if attempt >= maxImportAttempts {
+				return err
+			}
+			clients.IO.PrintDebug(ctx, "bulk put attempt %d failed: %s", attempt, err)
+		} else {
+			state.imported(batch, len(items)-len(result.FailedItems))
+			items = result.FailedItems
+			if len(items) == 0 {
+				break
+			}
+			if attempt >= maxImportAttempts {
+				for _, item := range items {
+					text, err := importItemText(item)
+					if err != nil {
+						return err
+					}
+					if err := state.exhaust(batch, item, text, fmt.Sprintf("item was not stored after %d attempts", maxImportAttempts)); err != nil {
+						return err
+					}
+				}
+				break
+			}
+		}
+		select {
+		case <-time.After(i.RetryDelay * time.Duration(1<<(attempt-1))):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		attempt++
+	}
+	return state.finish(batch)
+}
+
+// importItemText returns an item as a line of JSON for the error log
+func importItemText(item map[string]interface{}) (string, error) {
+	text, err := goutils.JSONMarshalUnescaped(item)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(text, "\n"), nil
+}
+
+// importState tracks progress of the batches sent by workers. Totals of the
+// checkpoint only include batches before the offset so a resumed import does
+// not count rows twice.
+type importState struct {
+	mu         sync.Mutex
+	job        *Import
+	fs         afero.Fs
+	checkpoint ImportCheckpoint
+	completed  map[int]*importBatch
+	requeued   map[*importBatch]bool
+	progress   ImportProgress
+}
+
+func newImportState(job *Import, fs afero.Fs) *importState {
+	checkpoint := job.Checkpoint
+	checkpoint.FailedItems = nil
+	return &importState{
+		job:        job,
+		fs:         fs,
+		checkpoint: checkpoint,
+		completed:  map[int]*importBatch{},
+		requeued:   map[*importBatch]bool{},
+		progress:   ImportProgress{Imported: checkpoint.Imported, Failed: checkpoint.Failed},
+	}
+}
+
+func (s *importState) requeue(batch *importBatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requeued[batch] = true
+}
+
+func (s *importState) imported(batch *importBatch, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch.imported += count
+	s.progress.Imported += count
+	s.notify()
+}
+
+func (s *importState) fail(batch *importBatch, text string, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch.failed++
+	s.progress.Failed++
+	s.notify()
+	if s.job.OnFailure != nil {
+		return s.job.OnFailure(text, reason)
+	}
+	return nil
+}
+
+// exhaust saves an item that keeps failing to the checkpoint to be sent again
+// when the import is resumed
+func (s *importState) exhaust(batch *importBatch, item map[string]interface{}, text string, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch.exhausted = append(batch.exhausted, item)
+	s.progress.Failed++
+	s.notify()
+	if s.job.OnFailure != nil {
+		return s.job.OnFailure(text, reason)
+	}
+	return nil
+}
+
+// finish adds the totals of a batch to the checkpoint and moves the offset past
+// every batch that finished in order
+func (s *importState) finish(batch *importBatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if batch.start < 0 {
+		delete(s.requeued, batch)
+		s.commit(batch)
+	} else {
+		s.completed[batch.start] = batch
+		for {
+			next, ok := s.completed[s.checkpoint.Offset]
+			if !ok {
+				break
+			}
+			delete(s.completed, s.checkpoint.Offset)
+			s.checkpoint.Offset = next.end
+			s.commit(next)
+		}
+	}
+	return s.saveLocked()
+}
+
+func (s *importState) commit(batch *importBatch) {
+	s.checkpoint.Imported += batch.imported
+	s.checkpoint.Failed += batch.failed
+	s.checkpoint.FailedItems = append(s.checkpoint.FailedItems, batch.exhausted...)
+}
+
+func (s *importState) notify() {
+	if s.job.OnProgress != nil {
+		s.job.OnProgress(s.progress)
+	}
+}
+
+func (s *importState) save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.saveLocked()
+}
+
+// saveLocked writes the checkpoint with failed items from a previous attempt
+// that are not yet stored
+func (s *importState) saveLocked() error {
+	checkpoint := s.snapshot()
+	checkpoint.UpdatedAt = time.Now().UTC()
+	return SaveImportCheckpoint(s.fs, s.job.CheckpointPath, checkpoint)
+}
+
+func (s *importState) snapshot() ImportCheckpoint {
+	checkpoint := s.checkpoint
+	checkpoint.FailedItems = slices.Clone(s.checkpoint.FailedItems)
+	for batch := range s.requeued {
+		checkpoint.FailedItems = append(checkpoint.FailedItems, batch.items...)
+	}
+	return checkpoint
+}
+
+func (s *importState) result(finished bool) ImportResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ImportResult{
+		Progress:   s.progress,
+		Checkpoint: s.snapshot(),
+		Finished:   finished,
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/datastore/format"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockImportReader(t *testing.T, rows int) format.Reader {
+	lines := []string{}
+	for i := 1; i <= rows; i++ {
+		lines = append(lines, fmt.Sprintf(`{"id":"%04d"}`, i))
+	}
+	reader, err := format.NewReader(format.JSONL, strings.NewReader(strings.Join(lines, "\n")), nil)
+	require.NoError(t, err)
+	return reader
+}
+
+func TestImportCheckpoint(t *testing.T) {
+	clientsMock := shared.NewClientsMock()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	path := ImportCheckpointPath(clients, "Todos", "/tasks.jsonl")
+	assert.Contains(t, path, ".slack/imports/Todos-")
+
+	checkpoint, err := LoadImportCheckpoint(clients.Fs, path)
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	expected := ImportCheckpoint{
+		Datastore:   "Todos",
+		File:        "/tasks.jsonl",
+		Offset:      50,
+		Imported:    49,
+		FailedItems: []map[string]interface{}{{"id": "0007"}},
+	}
+	require.NoError(t, SaveImportCheckpoint(clients.Fs, path, expected))
+	checkpoint, err = LoadImportCheckpoint(clients.Fs, path)
+	require.NoError(t, err)
+	assert.Equal(t, expected, *checkpoint)
+
+	require.NoError(t, RemoveImportCheckpoint(clients.Fs, path))
+	require.NoError(t, RemoveImportCheckpoint(clients.Fs, path))
+	checkpoint, err = LoadImportCheckpoint(clients.Fs, path)
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+}
+
+func TestImportRun(t *testing.T) {
+	tests := map[string]struct {
+		rows               int
+		checkpoint         ImportCheckpoint
+		maxItems           int
+		setup              func(cm *shared.ClientsMock)
+		expectedProgress   ImportProgress
+		expectedCheckpoint ImportCheckpoint
+		expectedFinished   bool
+		expectedError      string
+	}{
+		"imports every row with parallel workers": {
+			rows: 95,
+			setup: func(cm *shared.ClientsMock) {
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+			},
+			expectedProgress:   ImportProgress{Imported: 95},
+			expectedCheckpoint: ImportCheckpoint{Offset: 95, Imported: 95},
+			expectedFinished:   true,
+		},
+		"stops after the max items are read": {
+			rows:     60,
+			maxItems: 30,
+			setup: func(cm *shared.ClientsMock) {
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+			},
+			expectedProgress:   ImportProgress{Imported: 30},
+			expectedCheckpoint: ImportCheckpoint{Offset: 30, Imported: 30},
+		},
+		"continues after the offset and sends failed items again": {
+			rows:       30,
+			checkpoint: ImportCheckpoint{Offset: 25, Imported: 24, FailedItems: []map[string]interface{}{{"id": "0003"}}},
+			setup: func(cm *shared.ClientsMock) {
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+			},
+			expectedProgress:   ImportProgress{Imported: 30},
+			expectedCheckpoint: ImportCheckpoint{Offset: 30, Imported: 30},
+			expectedFinished:   true,
+		},
+		"saves items that keep failing to the checkpoint": {
+			rows: 2,
+			setup: func(cm *shared.ClientsMock) {
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{FailedItems: []map[string]interface{}{{"id": "0002"}}}, nil)
+			},
+			expectedProgress: ImportProgress{Imported: 1, Failed: 1},
+			expectedCheckpoint: ImportCheckpoint{
+				Offset:      2,
+				Imported:    1,
+				FailedItems: []map[string]interface{}{{"id": "0002"}},
+			},
+			expectedFinished: true,
+		},
+		"removes invalid items and stores the others": {
+			rows: 3,
+			setup: func(cm *shared.ClientsMock) {
+				details := slackerror.ErrorDetails{{Message: "invalid attribute", Item: map[string]interface{}{"id": "0002"}}}
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, slackerror.NewAPIError("datastore_error", "", details, "apps.datastore.bulkPut")).Once()
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, nil)
+			},
+			expectedProgress:   ImportProgress{Imported: 2, Failed: 1},
+			expectedCheckpoint: ImportCheckpoint{Offset: 3, Imported: 2, Failed: 1},
+			expectedFinished:   true,
+		},
+		"errors after requests keep failing": {
+			rows: 3,
+			setup: func(cm *shared.ClientsMock) {
+				cm.APIInterface.On("AppsDatastoreBulkPut", mock.Anything, mock.Anything, mock.Anything).
+					Return(types.AppDatastoreBulkPutResult{}, slackerror.New(slackerror.ErrHTTPRequestFailed))
+			},
+			expectedCheckpoint: ImportCheckpoint{},
+			expectedError:      slackerror.ErrHTTPRequestFailed,
+		},
+		"errors if the file has fewer rows than the offset": {
+			rows:               2,
+			checkpoint:         ImportCheckpoint{Offset: 5, Imported: 5},
+			setup:              func(cm *shared.ClientsMock) {},
+			expectedProgress:   ImportProgress{Imported: 5},
+			expectedCheckpoint: ImportCheckpoint{Offset: 5, Imported: 5},
+			expectedError:      slackerror.ErrDatastoreImportCheckpoint,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			tt.setup(clientsMock)
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			failures := []string{}
+			job := Import{
+				Request:        types.AppDatastoreBulkPut{Datastore: "Todos"},
+				PrimaryKey:     "id",
+				Reader:         mockImportReader(t, tt.rows),
+				Checkpoint:     tt.checkpoint,
+				CheckpointPath: ImportCheckpointPath(clients, "Todos", "/tasks.jsonl"),
+				BatchSize:      10,
+				MaxItems:       tt.maxItems,
+				Workers:        3,
+				OnFailure: func(text string, reason string) error {
+					failures = append(failures, text)
+					return nil
+				},
+			}
+
+			result, err := job.Run(ctx, clients)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedProgress, result.Progress)
+			assert.Equal(t, tt.expectedFinished, result.Finished)
+			assert.Equal(t, tt.expectedCheckpoint.Offset, result.Checkpoint.Offset)
+			assert.Equal(t, tt.expectedCheckpoint.Imported, result.Checkpoint.Imported)
+			assert.Equal(t, tt.expectedCheckpoint.Failed, result.Checkpoint.Failed)
+			assert.Equal(t, tt.expectedCheckpoint.FailedItems, result.Checkpoint.FailedItems)
+			assert.Len(t, failures, tt.expectedProgress.Failed)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// completion is the outcome of a function execution reported by the app
+type completion struct {
+	Outputs map[string]interface{}
+	Error   string
+}
+
+// completionServer answers API calls an app makes while a function runs
+// locally. Function completions are recorded and other methods respond as if
+// they succeeded so functions run without a workspace.
+type completionServer struct {
+	mu          sync.Mutex
+	completions map[string]completion
+	calls       []string
+}
+
+// newCompletionServer returns a server without recorded calls
+func newCompletionServer() *completionServer {
+	return &completionServer{
+		completions: map[string]completion{},
+		calls:       []string{},
+	}
+}
+
+// serve starts answering API calls and returns the URL used in place of
+// SLACK_API_URL. The server stops when ctx is canceled.
+func (s *completionServer) serve(ctx context.Context) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	server := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+	return fmt.Sprintf("http://%s/api/", listener.Addr().String()), nil
+}
+
+// ServeHTTP records the API call and responds with success
+func (s *completionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := strings.TrimPrefix(r.URL.Path, "/api/")
+	params, err := requestParams(r)
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "error": "invalid_arguments"})
+		return
+	}
+	executionID, _ := params["function_execution_id"].(string)
+	s.mu.Lock()
+	s.calls = append(s.calls, method)
+	switch method {
+	case "functions.completeSuccess":
+		outputs, _ := params["outputs"].(map[string]interface{})
+		s.completions[executionID] = completion{Outputs: outputs}
+	case "functions.completeError":
+		message, _ := params["error"].(string)
+		s.completions[executionID] = completion{Error: message}
+	}
+	s.mu.Unlock()
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"ok": true})
+}
+
+// completion returns the outcome reported for a function execution
+func (s *completionServer) completion(executionID string) (completion, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result, ok := s.completions[executionID]
+	return result, ok
+}
+
+// methods returns the API methods called in order
+func (s *completionServer) methods() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.calls...)
+}
+
+// requestParams returns the arguments of an API call sent as JSON or a form.
+// Form values that hold JSON objects are decoded.
+func requestParams(r *http.Request) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &params); err != nil {
+				return nil, err
+			}
+		}
+		return params, nil
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	for key := range r.Form {
+		value := r.Form.Get(key)
+		var object map[string]interface{}
+		if strings.HasPrefix(value, "{") && json.Unmarshal([]byte(value), &object) == nil {
+			params[key] = object
+			continue
+		}
+		params[key] = value
+	}
+	return params, nil
+}
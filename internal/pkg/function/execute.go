@@ -0,0 +1,195 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package function executes the custom functions of an app on this machine by
+// piping function_executed events through the start hook.
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// FunctionExecutedEventType is the event type that starts a function
+const FunctionExecutedEventType = "function_executed"
+
+// Execution is the outcome of a function that the start hook ran
+type Execution struct {
+	// Outputs are the outputs the function completed with
+	Outputs map[string]interface{}
+	// Error is the message the function completed with on error
+	Error string
+	// Methods are the API methods called by the function
+	Methods []string
+}
+
+// ExecutedEventArgs describe the function_executed event of a step
+type ExecutedEventArgs struct {
+	CallbackID          string
+	Function            types.ManifestFunction
+	Inputs              map[string]interface{}
+	ExecutionID         string
+	WorkflowExecutionID string
+}
+
+// executedEvent is the body of the socket event that an app receives when a
+// function starts
+type executedEvent struct {
+	Type      string                 `json:"type"`
+	EventTime int64                  `json:"event_time"`
+	Event     map[string]interface{} `json:"event"`
+}
+
+// hookEvent is the input of the start hook and matches the socket events of a
+// local run
+type hookEvent struct {
+	Body    json.RawMessage `json:"body"`
+	Context hookContext     `json:"context"`
+}
+
+// hookContext holds the environment variables of the app
+type hookContext struct {
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// hookResponse holds outputs an app writes in response to an event instead of
+// completing the function through the API
+type hookResponse struct {
+	Outputs map[string]interface{} `json:"outputs"`
+	Error   string                 `json:"error"`
+}
+
+// NewExecutedEvent returns the body of a function_executed event that starts
+// a custom function with inputs
+func NewExecutedEvent(args ExecutedEventArgs) (json.RawMessage, error) {
+	now := time.Now()
+	body, err := json.Marshal(executedEvent{
+		Type:      "event_callback",
+		EventTime: now.Unix(),
+		Event: map[string]interface{}{
+			"type": FunctionExecutedEventType,
+			"function": map[string]interface{}{
+				"id":          "Fn_LOCAL_" + args.CallbackID,
+				"callback_id": args.CallbackID,
+				"title":       args.Function.Title,
+				"description": args.Function.Description,
+				"type":        "app",
+			},
+			"inputs":                args.Inputs,
+			"function_execution_id": args.ExecutionID,
+			"workflow_execution_id": args.WorkflowExecutionID,
+			"event_ts":              fmt.Sprintf("%d.%06d", now.Unix(), now.Nanosecond()/1000),
+		},
+	})
+	if err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	return body, nil
+}
+
+// ExecutedEvent is the part of a function_executed event used to replay it
+type ExecutedEvent struct {
+	Type     string `json:"type"`
+	Function struct {
+		CallbackID string `json:"callback_id"`
+	} `json:"function"`
+	Inputs      map[string]interface{} `json:"inputs"`
+	ExecutionID string                 `json:"function_execution_id"`
+}
+
+// ParseExecutedEvent returns the function_executed event within an event body
+func ParseExecutedEvent(body json.RawMessage) (ExecutedEvent, error) {
+	var envelope struct {
+		Event ExecutedEvent `json:"event"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return ExecutedEvent{}, slackerror.Wrap(err, slackerror.ErrFunctionInvoke).
+			WithMessage("The event is not valid JSON")
+	}
+	if envelope.Event.Type != FunctionExecutedEventType {
+		return ExecutedEvent{}, slackerror.New(slackerror.ErrFunctionInvoke).
+			WithMessage("The event is not a %s event", FunctionExecutedEventType)
+	}
+	if envelope.Event.Inputs == nil {
+		envelope.Event.Inputs = map[string]interface{}{}
+	}
+	return envelope.Event, nil
+}
+
+// Execute pipes the body of a function_executed event through the start hook
+// in the same way as a local run. Functions complete through API calls to a
+// local server that replaces SLACK_API_URL or with the outputs of the hook
+// response.
+func Execute(ctx context.Context, clients *shared.ClientFactory, body json.RawMessage, variables map[string]string) (Execution, error) {
+	event, err := ParseExecutedEvent(body)
+	if err != nil {
+		return Execution{}, err
+	}
+	if _, err := clients.SDKConfig.Hooks.Start.Get(); err != nil {
+		return Execution{}, err
+	}
+
+	completions := newCompletionServer()
+	serverCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	apiURL, err := completions.serve(serverCtx)
+	if err != nil {
+		return Execution{}, slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	clients.IO.PrintDebug(ctx, "answering API calls of function %s at %s", event.Function.CallbackID, apiURL)
+	hookVariables := map[string]string{}
+	maps.Copy(hookVariables, variables)
+	hookVariables["SLACK_API_URL"] = apiURL
+
+	input, err := json.Marshal(hookEvent{Body: body, Context: hookContext{Variables: hookVariables}})
+	if err != nil {
+		return Execution{}, slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	out, err := clients.HookExecutor.Execute(ctx, hooks.HookExecOpts{
+		Hook:   clients.SDKConfig.Hooks.Start,
+		Env:    map[string]string{"SLACK_API_URL": apiURL},
+		Stdin:  bytes.NewBuffer(input),
+		Stdout: clients.IO.WriteSecondary(clients.IO.WriteOut()),
+		Stderr: clients.IO.WriteSecondary(clients.IO.WriteErr()),
+	})
+	if err != nil {
+		return Execution{}, slackerror.Wrap(err, slackerror.ErrFunctionInvoke).
+			WithMessage("The 'start' hook failed to run the function \"%s\"", event.Function.CallbackID)
+	}
+
+	execution := Execution{Methods: completions.methods()}
+	if completed, ok := completions.completion(event.ExecutionID); ok {
+		execution.Outputs, execution.Error = completed.Outputs, completed.Error
+	} else if strings.TrimSpace(out) != "" {
+		var response hookResponse
+		if err := json.Unmarshal([]byte(out), &response); err != nil {
+			clients.IO.PrintDebug(ctx, "the response of function %s is not JSON: %s", event.Function.CallbackID, out)
+		}
+		execution.Outputs, execution.Error = response.Outputs, response.Error
+	}
+	if execution.Outputs == nil {
+		execution.Outputs = map[string]interface{}{}
+	}
+	return execution, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/socketsession"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+// FixturesDirName is the directory of recorded fixtures in the project config
+// directory
+const FixturesDirName = "fixtures"
+
+// fixtureNamePattern matches characters that are replaced in fixture names
+var fixtureNamePattern = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// Fixture is a socket event recorded during a local run that can be replayed.
+// Fixtures are saved in the envelope format of recorded sessions.
+type Fixture = socketsession.Envelope
+
+// WriteFixture saves a fixture in the directory and returns the path. Events
+// of functions are named after the function callback ID. Tokens of the payload
+// are redacted so fixtures are safe to share.
+func WriteFixture(fs afero.Fs, dir string, fixture Fixture) (string, error) {
+	fixture = fixture.Redacted()
+	name := fixture.Type
+	if event, err := ParseExecutedEvent(fixture.Payload); err == nil && event.Function.CallbackID != "" {
+		name = event.Function.CallbackID
+	}
+	name = fixtureNamePattern.ReplaceAllString(name, "_")
+	path := filepath.Join(dir, fmt.Sprintf("%s-%d.json", name, fixture.ReceivedAt.UnixNano()))
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return "", slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return "", slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	if err := afero.WriteFile(fs, path, data, 0600); err != nil {
+		return "", slackerror.Wrap(err, slackerror.ErrFunctionInvoke)
+	}
+	return path, nil
+}
+
+// ReadFixture returns the fixture saved at the path
+func ReadFixture(fs afero.Fs, path string) (Fixture, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return Fixture{}, slackerror.Wrap(err, slackerror.ErrFunctionInvoke).
+			WithMessage("The fixture \"%s\" could not be read", path)
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil || len(fixture.Payload) == 0 {
+		return Fixture{}, slackerror.New(slackerror.ErrFunctionInvoke).
+			WithMessage("The fixture \"%s\" is not a recorded event", path).
+			WithRemediation("Record events with %s", style.Commandf("run --record", false))
+	}
+	return fixture, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFixture(t *testing.T) {
+	recordedAt := time.Unix(1700000000, 0)
+	tests := map[string]struct {
+		fixture         Fixture
+		expectedPath    string
+		expectedPayload string
+	}{
+		"names function events after the callback ID": {
+			fixture: Fixture{
+				Type:       "events_api",
+				ReceivedAt: recordedAt,
+				Payload:    json.RawMessage(`{"event":{"type":"function_executed","function":{"callback_id":"greet"}}}`),
+			},
+			expectedPath:    filepath.Join("fixtures", "greet-1700000000000000000.json"),
+			expectedPayload: `{"event":{"type":"function_executed","function":{"callback_id":"greet"}}}`,
+		},
+		"redacts tokens of the payload": {
+			fixture: Fixture{
+				Type:       "events_api",
+				ReceivedAt: recordedAt,
+				Payload:    json.RawMessage(`{"event":{"type":"function_executed","function":{"callback_id":"greet"},"bot_access_token":"xoxb-123"}}`),
+			},
+			expectedPath:    filepath.Join("fixtures", "greet-1700000000000000000.json"),
+			expectedPayload: `{"event":{"type":"function_executed","function":{"callback_id":"greet"},"bot_access_token":"..."}}`,
+		},
+		"names other events after the message type": {
+			fixture: Fixture{
+				Type:       "interactive",
+				ReceivedAt: recordedAt,
+				Payload:    json.RawMessage(`{"type":"block_actions","token":"verification"}`),
+			},
+			expectedPath:    filepath.Join("fixtures", "interactive-1700000000000000000.json"),
+			expectedPayload: `{"type":"block_actions","token":"..."}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			path, err := WriteFixture(fs, "fixtures", tt.fixture)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPath, path)
+			fixture, err := ReadFixture(fs, path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.fixture.Type, fixture.Type)
+			assert.True(t, tt.fixture.ReceivedAt.Equal(fixture.ReceivedAt))
+			assert.JSONEq(t, tt.expectedPayload, string(fixture.Payload))
+		})
+	}
+}
+
+func TestReadFixture_Errors(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "inputs.json", []byte(`{"name": "Ada"}`), 0600))
+
+	_, err := ReadFixture(fs, "inputs.json")
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrFunctionInvoke, slackerror.ToSlackError(err).Code)
+	assert.Contains(t, slackerror.ToSlackError(err).Message, "is not a recorded event")
+
+	_, err = ReadFixture(fs, "missing.json")
+	require.Error(t, err)
+	assert.Contains(t, slackerror.ToSlackError(err).Message, "could not be read")
+}
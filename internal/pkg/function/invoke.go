@@ -0,0 +1,124 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+const (
+	// localExecutionID identifies the function execution of an invoke
+	localExecutionID = "Fx_LOCAL_INVOKE"
+	// localWorkflowExecutionID identifies the workflow execution of an invoke
+	localWorkflowExecutionID = "Wx_LOCAL_INVOKE"
+)
+
+// InvokeArgs are the options of invoking a function
+type InvokeArgs struct {
+	// CallbackID is the function to invoke
+	CallbackID string
+	// Inputs are the input parameters of the function
+	Inputs map[string]interface{}
+	// Fixture is a recorded event to replay in place of inputs
+	Fixture *Fixture
+}
+
+// InvokeResult is the outcome of invoking a function
+type InvokeResult struct {
+	CallbackID string                 `json:"callback_id"`
+	Inputs     map[string]interface{} `json:"inputs"`
+	Outputs    map[string]interface{} `json:"outputs"`
+	// Methods are the API methods called by the function
+	Methods []string `json:"methods,omitempty"`
+}
+
+// Invoke runs a custom function of the app with a function_executed event
+// built from inputs or replayed from a fixture. Inputs and outputs are
+// validated against the parameters of the function in the app manifest.
+func Invoke(ctx context.Context, clients *shared.ClientFactory, args InvokeArgs) (InvokeResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.function.invoke")
+	defer span.Finish()
+
+	manifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	if err != nil {
+		return InvokeResult{}, err
+	}
+	function, ok := manifest.Functions[args.CallbackID]
+	if !ok {
+		return InvokeResult{}, slackerror.New(slackerror.ErrFunctionNotFound).
+			WithMessage("No function with the callback ID \"%s\" is in the app manifest", args.CallbackID)
+	}
+
+	var body json.RawMessage
+	inputs := args.Inputs
+	if args.Fixture != nil {
+		event, err := ParseExecutedEvent(args.Fixture.Payload)
+		if err != nil {
+			return InvokeResult{}, err
+		}
+		if event.Function.CallbackID != args.CallbackID {
+			return InvokeResult{}, slackerror.New(slackerror.ErrFunctionInvoke).
+				WithMessage("The fixture is an event of the function \"%s\"", event.Function.CallbackID)
+		}
+		body, inputs = args.Fixture.Payload, event.Inputs
+	}
+	if inputs == nil {
+		inputs = map[string]interface{}{}
+	}
+	if err := ValidateParameters("input", function.InputParameters, inputs); err != nil {
+		return InvokeResult{}, err
+	}
+	if body == nil {
+		body, err = NewExecutedEvent(ExecutedEventArgs{
+			CallbackID:          args.CallbackID,
+			Function:            function,
+			Inputs:              inputs,
+			ExecutionID:         localExecutionID,
+			WorkflowExecutionID: localWorkflowExecutionID,
+		})
+		if err != nil {
+			return InvokeResult{}, err
+		}
+	}
+
+	variables, err := clients.Config.GetDotEnvFileVariables()
+	if err != nil {
+		return InvokeResult{}, slackerror.Wrap(err, slackerror.ErrFunctionInvoke).
+			WithMessage("Failed to read the local .env file")
+	}
+	execution, err := Execute(ctx, clients, body, variables)
+	if err != nil {
+		return InvokeResult{}, err
+	}
+	result := InvokeResult{
+		CallbackID: args.CallbackID,
+		Inputs:     inputs,
+		Outputs:    execution.Outputs,
+		Methods:    execution.Methods,
+	}
+	if execution.Error != "" {
+		return result, slackerror.New(slackerror.ErrFunctionInvoke).
+			WithMessage("The function completed with an error: %s", execution.Error)
+	}
+	if err := ValidateParameters("output", function.OutputParameters, execution.Outputs); err != nil {
+		return result, err
+	}
+	return result, nil
+}
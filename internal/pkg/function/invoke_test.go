@@ -0,0 +1,211 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/app"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// appExecutor acts as an app that greets the name of the inputs
+type appExecutor struct {
+	// respond writes outputs to the hook response instead of the API
+	respond bool
+	// outputs replace the greeting outputs if set
+	outputs map[string]interface{}
+	// fail completes the function with an error
+	fail   string
+	events []hookEvent
+}
+
+func (e *appExecutor) Execute(ctx context.Context, opts hooks.HookExecOpts) (string, error) {
+	var event hookEvent
+	input, err := io.ReadAll(opts.Stdin)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(input, &event); err != nil {
+		return "", err
+	}
+	e.events = append(e.events, event)
+	executed, err := ParseExecutedEvent(event.Body)
+	if err != nil {
+		return "", err
+	}
+	outputs := e.outputs
+	if outputs == nil {
+		outputs = map[string]interface{}{"greeting": fmt.Sprintf("Hello %s", executed.Inputs["name"])}
+	}
+	if e.respond {
+		response, err := json.Marshal(hookResponse{Outputs: outputs, Error: e.fail})
+		return string(response), err
+	}
+	method, params := "functions.completeSuccess", url.Values{}
+	if e.fail != "" {
+		method = "functions.completeError"
+		params.Set("error", e.fail)
+	}
+	encoded, _ := json.Marshal(outputs)
+	params.Set("outputs", string(encoded))
+	params.Set("function_execution_id", executed.ExecutionID)
+	response, err := http.PostForm(opts.Env["SLACK_API_URL"]+method, params)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	return "{}", nil
+}
+
+func setupInvoke(t *testing.T, executor hooks.HookExecutor) (context.Context, *shared.ClientFactory) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	manifest := types.SlackYaml{}
+	manifest.Functions = map[string]types.ManifestFunction{
+		"greet": {
+			Title:            "Greet",
+			InputParameters:  types.ToRawJSON(`{"properties": {"name": {"type": "string"}}, "required": ["name"]}`),
+			OutputParameters: types.ToRawJSON(`{"properties": {"greeting": {"type": "string"}}, "required": ["greeting"]}`),
+		},
+	}
+	manifestMock := &app.ManifestMockObject{}
+	manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(manifest, nil)
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	clients.AppClient().Manifest = manifestMock
+	clients.HookExecutor = executor
+	clients.SDKConfig.Hooks.Start = hooks.HookScript{Name: "Start", Command: "deno run start.ts"}
+	return ctx, clients
+}
+
+func TestInvoke(t *testing.T) {
+	tests := map[string]struct {
+		respond bool
+	}{
+		"completes with outputs through the API":      {respond: false},
+		"completes with outputs of the hook response": {respond: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			executor := &appExecutor{respond: tt.respond}
+			ctx, clients := setupInvoke(t, executor)
+
+			result, err := Invoke(ctx, clients, InvokeArgs{
+				CallbackID: "greet",
+				Inputs:     map[string]interface{}{"name": "Ada"},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"greeting": "Hello Ada"}, result.Outputs)
+			require.Len(t, executor.events, 1)
+			executed, err := ParseExecutedEvent(executor.events[0].Body)
+			require.NoError(t, err)
+			assert.Equal(t, "greet", executed.Function.CallbackID)
+			assert.Equal(t, localExecutionID, executed.ExecutionID)
+			assert.NotEmpty(t, executor.events[0].Context.Variables["SLACK_API_URL"])
+			if !tt.respond {
+				assert.Equal(t, []string{"functions.completeSuccess"}, result.Methods)
+			}
+		})
+	}
+}
+
+func TestInvoke_Replay(t *testing.T) {
+	executor := &appExecutor{}
+	ctx, clients := setupInvoke(t, executor)
+	payload := json.RawMessage(`{"type":"event_callback","event":{"type":"function_executed","function":{"callback_id":"greet"},"inputs":{"name":"Grace"},"function_execution_id":"Fx_RECORDED"}}`)
+
+	result, err := Invoke(ctx, clients, InvokeArgs{
+		CallbackID: "greet",
+		Fixture:    &Fixture{Type: "events_api", ReceivedAt: time.Now(), Payload: payload},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "Grace"}, result.Inputs)
+	assert.Equal(t, map[string]interface{}{"greeting": "Hello Grace"}, result.Outputs)
+	require.Len(t, executor.events, 1)
+	assert.JSONEq(t, string(payload), string(executor.events[0].Body))
+}
+
+func TestInvoke_Errors(t *testing.T) {
+	tests := map[string]struct {
+		args            InvokeArgs
+		executor        *appExecutor
+		expectedCode    string
+		expectedMessage string
+	}{
+		"errors for an unknown function": {
+			args:         InvokeArgs{CallbackID: "goodbye"},
+			expectedCode: slackerror.ErrFunctionNotFound,
+		},
+		"errors for inputs that do not match the manifest": {
+			args:            InvokeArgs{CallbackID: "greet"},
+			expectedCode:    slackerror.ErrInvalidFunctionParameters,
+			expectedMessage: "The input parameters do not match the app manifest",
+		},
+		"errors for outputs that do not match the manifest": {
+			args:            InvokeArgs{CallbackID: "greet", Inputs: map[string]interface{}{"name": "Ada"}},
+			executor:        &appExecutor{outputs: map[string]interface{}{"greeting": float64(1)}},
+			expectedCode:    slackerror.ErrInvalidFunctionParameters,
+			expectedMessage: "The output parameters do not match the app manifest",
+		},
+		"errors when the function completes with an error": {
+			args:            InvokeArgs{CallbackID: "greet", Inputs: map[string]interface{}{"name": "Ada"}},
+			executor:        &appExecutor{fail: "name is too short"},
+			expectedCode:    slackerror.ErrFunctionInvoke,
+			expectedMessage: "The function completed with an error: name is too short",
+		},
+		"errors when a fixture is an event of another function": {
+			args: InvokeArgs{CallbackID: "greet", Fixture: &Fixture{
+				Payload: json.RawMessage(`{"event":{"type":"function_executed","function":{"callback_id":"farewell"}}}`),
+			}},
+			expectedCode:    slackerror.ErrFunctionInvoke,
+			expectedMessage: "The fixture is an event of the function \"farewell\"",
+		},
+		"errors when a fixture is not a function event": {
+			args: InvokeArgs{CallbackID: "greet", Fixture: &Fixture{
+				Payload: json.RawMessage(`{"event":{"type":"app_mention"}}`),
+			}},
+			expectedCode:    slackerror.ErrFunctionInvoke,
+			expectedMessage: "The event is not a function_executed event",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			executor := tt.executor
+			if executor == nil {
+				executor = &appExecutor{}
+			}
+			ctx, clients := setupInvoke(t, executor)
+			_, err := Invoke(ctx, clients, tt.args)
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, slackerror.ToSlackError(err).Code)
+			assert.Contains(t, slackerror.ToSlackError(err).Message, tt.expectedMessage)
+		})
+	}
+}
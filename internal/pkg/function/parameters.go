@@ -0,0 +1,134 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+// parameterSchema is the definition of parameters in the app manifest
+type parameterSchema struct {
+	Properties map[string]struct {
+		Type string `json:"type"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+// slackTypeKinds are the JSON kinds of Slack types that can be checked. Other
+// types accept any value.
+var slackTypeKinds = map[string]string{
+	"slack#/types/channel_id":      "string",
+	"slack#/types/date":            "string",
+	"slack#/types/message_context": "object",
+	"slack#/types/message_ts":      "string",
+	"slack#/types/interactivity":   "object",
+	"slack#/types/timestamp":       "integer",
+	"slack#/types/user_context":    "object",
+	"slack#/types/user_id":         "string",
+	"slack#/types/usergroup_id":    "string",
+}
+
+// ValidateParameters errors if values are missing a required parameter, hold a
+// parameter that is not defined, or hold a value of the wrong type. The name
+// describes the parameters in error messages, such as "input".
+func ValidateParameters(name string, parameters *types.RawJSON, values map[string]interface{}) error {
+	schema := parameterSchema{}
+	if parameters != nil && (parameters.JSONData != nil || parameters.Data != nil) {
+		data, err := json.Marshal(parameters)
+		if err != nil {
+			return slackerror.Wrap(err, slackerror.ErrInvalidFunctionParameters)
+		}
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return slackerror.Wrap(err, slackerror.ErrInvalidFunctionParameters)
+		}
+	}
+	details := slackerror.ErrorDetails{}
+	for _, required := range schema.Required {
+		if _, ok := values[required]; !ok {
+			details = append(details, slackerror.ErrorDetail{
+				Message: fmt.Sprintf("The %s \"%s\" is required", name, required),
+			})
+		}
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		property, ok := schema.Properties[key]
+		if !ok {
+			details = append(details, slackerror.ErrorDetail{
+				Message: fmt.Sprintf("The %s \"%s\" is not a parameter of the function", name, key),
+			})
+			continue
+		}
+		if values[key] == nil {
+			continue
+		}
+		if kind := parameterKind(property.Type); kind != "" && !isKind(values[key], kind) {
+			details = append(details, slackerror.ErrorDetail{
+				Message: fmt.Sprintf("The %s \"%s\" must be of type %s", name, key, property.Type),
+			})
+		}
+	}
+	if len(details) > 0 {
+		return slackerror.New(slackerror.ErrInvalidFunctionParameters).
+			WithMessage("The %s parameters do not match the app manifest", name).
+			WithDetails(details)
+	}
+	return nil
+}
+
+// parameterKind returns the JSON kind of a parameter type or an empty string
+// if values of the type are not checked
+func parameterKind(parameterType string) string {
+	switch parameterType {
+	case "string", "integer", "number", "boolean", "array", "object":
+		return parameterType
+	}
+	return slackTypeKinds[parameterType]
+}
+
+// isKind returns if a decoded JSON value is of a kind
+func isKind(value interface{}, kind string) bool {
+	switch kind {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParameters(t *testing.T) {
+	parameters := types.ToRawJSON(`{
+		"properties": {
+			"name": {"type": "string"},
+			"count": {"type": "integer"},
+			"user": {"type": "slack#/types/user_id"},
+			"tags": {"type": "array"},
+			"custom": {"type": "#/types/custom"}
+		},
+		"required": ["name"]
+	}`)
+	tests := map[string]struct {
+		parameters       *types.RawJSON
+		values           map[string]interface{}
+		expectedMessages []string
+	}{
+		"accepts values that match the parameters": {
+			parameters: parameters,
+			values: map[string]interface{}{
+				"name":   "Ada",
+				"count":  float64(2),
+				"user":   "U001",
+				"tags":   []interface{}{"a"},
+				"custom": map[string]interface{}{"any": true},
+			},
+		},
+		"accepts missing optional values and null values": {
+			parameters: parameters,
+			values:     map[string]interface{}{"name": "Ada", "count": nil},
+		},
+		"accepts no values without parameters": {
+			values: map[string]interface{}{},
+		},
+		"errors for missing required values": {
+			parameters:       parameters,
+			values:           map[string]interface{}{},
+			expectedMessages: []string{`The input "name" is required`},
+		},
+		"errors for values that are not parameters": {
+			parameters:       parameters,
+			values:           map[string]interface{}{"name": "Ada", "nickname": "A"},
+			expectedMessages: []string{`The input "nickname" is not a parameter of the function`},
+		},
+		"errors for values of the wrong type": {
+			parameters: parameters,
+			values:     map[string]interface{}{"name": "Ada", "count": 1.5, "user": float64(1), "tags": "a"},
+			expectedMessages: []string{
+				`The input "count" must be of type integer`,
+				`The input "tags" must be of type array`,
+				`The input "user" must be of type slack#/types/user_id`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateParameters("input", tt.parameters, tt.values)
+			if len(tt.expectedMessages) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			slackErr := slackerror.ToSlackError(err)
+			assert.Equal(t, slackerror.ErrInvalidFunctionParameters, slackErr.Code)
+			messages := []string{}
+			for _, detail := range slackErr.Details {
+				messages = append(messages, detail.Message)
+			}
+			assert.Equal(t, tt.expectedMessages, messages)
+		})
+	}
+}
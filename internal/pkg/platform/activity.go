@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/activityalert"
+	"github.com/toughtackle/slack-cli/internal/activitylog"
 	"github.com/toughtackle/slack-cli/internal/api"
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/logger"
@@ -68,7 +70,7 @@ func Activity(
 	if err != nil {
 		return err
 	}
-	if authSession.UserName != nil && authSession.TeamName != nil {
+	if authSession.UserName != nil && authSession.TeamName != nil && !clients.IO.IsStructuredOutput() {
 		clients.IO.PrintInfo(ctx, false, "%s%s", style.Emoji("sparkles"), style.Secondary(fmt.Sprintf("%s of %s", *authSession.UserName, *authSession.TeamName)))
 	}
 
@@ -87,7 +89,38 @@ func Activity(
 		TraceID:            args.TraceID,
 	}
 
-	latestCreatedTimestamp, _, err := printLatestActivity(ctx, clients, token, activityRequest, token)
+	var sinks activitySinks
+	if args.Save {
+		sinks.store = newActivityStore(clients)
+		clients.IO.PrintDebug(ctx, "saving activity logs to %s", sinks.store.Dir())
+	}
+
+	if args.Alerts != "" {
+		sinks.alerter, err = newActivityAlerter(ctx, clients, args.Alerts)
+		if err != nil {
+			return err
+		}
+		defer sinks.alerter.Wait()
+	}
+
+	if args.OTLP != "" {
+		sinks.exporter = newTraceExporter(clients, args.AppID, args.OTLP)
+		clients.IO.PrintDebug(ctx, "exporting activity logs as spans to %s", sinks.exporter.exporter.Target())
+		defer func() {
+			if err := sinks.exporter.flush(ctx); err != nil {
+				clients.IO.PrintWarning(ctx, "%s", err)
+			}
+		}()
+	}
+
+	if args.GroupByTrace {
+		sinks.groups = newTraceGroups()
+		defer func() {
+			printTraces(ctx, clients, sinks.groups.flush())
+		}()
+	}
+
+	latestCreatedTimestamp, _, err := printLatestActivity(ctx, clients, token, activityRequest, token, sinks)
 	if err != nil {
 		return err
 	}
@@ -108,7 +141,7 @@ func Activity(
 		case <-ticker.C:
 			// Try to grab new logs using the last logs timestamp
 			activityRequest.MinimumDateCreated = latestCreatedTimestamp + 1
-			newLatestCreatedTimestamp, count, err := printLatestActivity(ctx, clients, token, activityRequest, token)
+			newLatestCreatedTimestamp, count, err := printLatestActivity(ctx, clients, token, activityRequest, token, sinks)
 			if err != nil {
 				return slackerror.New(slackerror.ErrStreamingActivityLogs).WithRootCause(err)
 			}
@@ -131,7 +164,23 @@ func Activity(
 	}
 }
 
-func printLatestActivity(ctx context.Context, clients *shared.ClientFactory, token string, args types.ActivityRequest, xoxpToken string) (latestCreated int64, num int, e error) {
+// activitySinks are the optional destinations of activity logs besides the
+// output. Sinks that are nil are skipped.
+type activitySinks struct {
+	// store saves logs to the project
+	store *activitylog.Store
+	// groups hold logs of workflow and function executions until the trace is
+	// complete
+	groups *traceGroups
+	// alerter checks logs against alert rules
+	alerter *activityalert.Alerter
+	// exporter exports logs as spans once a trace is complete
+	exporter *traceExporter
+}
+
+// printLatestActivity outputs activity logs created since the request minimum
+// and passes these logs to the sinks
+func printLatestActivity(ctx context.Context, clients *shared.ClientFactory, token string, args types.ActivityRequest, xoxpToken string, sinks activitySinks) (latestCreated int64, num int, e error) {
 	var span opentracing.Span
 	span, ctx = opentracing.StartSpanFromContext(ctx, "getLatestActivity")
 	defer span.Finish()
@@ -144,6 +193,12 @@ func printLatestActivity(ctx context.Context, clients *shared.ClientFactory, tok
 	var activities = result.Activities
 	latestTimestamp := args.MinimumDateCreated
 
+	if sinks.store != nil {
+		if _, err := sinks.store.Append(args.AppID, activities); err != nil {
+			return 0, 0, err
+		}
+	}
+
 	// process in reverse
 	for i := len(activities) - 1; i >= 0; i-- {
 		var activity = activities[i]
@@ -152,12 +207,76 @@ func printLatestActivity(ctx context.Context, clients *shared.ClientFactory, tok
 			latestTimestamp = activity.Created
 		}
 
+		if sinks.alerter != nil {
+			for _, rule := range sinks.alerter.Handle(ctx, activity) {
+				clients.IO.PrintDebug(ctx, "activity alert rule %s matched trace %s", rule, activity.TraceID)
+			}
+		}
+
+		if sinks.exporter != nil {
+			if err := sinks.exporter.add(ctx, activity); err != nil {
+				clients.IO.PrintWarning(ctx, "%s", err)
+			}
+		}
+
+		if sinks.groups != nil && activity.TraceID != "" && isTraceEvent(activity.EventType) {
+			printTraces(ctx, clients, sinks.groups.add(activity))
+			continue
+		}
+		if clients.IO.IsStructuredOutput() {
+			if err := clients.IO.PrintStructuredLine(ctx, newActivityOutput(activity)); err != nil {
+				return 0, 0, err
+			}
+			continue
+		}
 		clients.IO.PrintInfo(ctx, false, prettifyActivity(activity))
 	}
 
 	return latestTimestamp, len(result.Activities), nil
 }
 
+// printTraces outputs the execution tree of each trace
+func printTraces(ctx context.Context, clients *shared.ClientFactory, traces []Trace) {
+	for _, trace := range traces {
+		if clients.IO.IsStructuredOutput() {
+			_ = clients.IO.PrintStructuredLine(ctx, trace)
+			continue
+		}
+		clients.IO.PrintInfo(ctx, false, "%s\n", FormatTrace(trace))
+	}
+}
+
+// activityOutput is the structured output of an activity log. Each log is
+// written as a line of its own.
+type activityOutput struct {
+	Created       time.Time              `json:"created"`
+	Level         string                 `json:"level"`
+	EventType     string                 `json:"event_type"`
+	Source        string                 `json:"source"`
+	ComponentType string                 `json:"component_type"`
+	ComponentID   string                 `json:"component_id"`
+	TraceID       string                 `json:"trace_id"`
+	Payload       map[string]interface{} `json:"payload"`
+}
+
+// newActivityOutput returns the structured output of an activity log
+func newActivityOutput(activity api.Activity) activityOutput {
+	payload := activity.Payload
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	return activityOutput{
+		Created:       time.UnixMicro(activity.Created).UTC(),
+		Level:         string(activity.Level),
+		EventType:     string(activity.EventType),
+		Source:        activity.Source,
+		ComponentType: activity.ComponentType,
+		ComponentID:   activity.ComponentID,
+		TraceID:       activity.TraceID,
+		Payload:       payload,
+	}
+}
+
 func prettifyActivity(activity api.Activity) (log string) {
 	msg := ""
 
@@ -94,6 +94,28 @@ func Test_prettifyActivity(t *testing.T) {
 	}
 }
 
+func Test_newActivityOutput(t *testing.T) {
+	output := newActivityOutput(api.Activity{
+		TraceID:       "a123",
+		Level:         types.INFO,
+		EventType:     types.DatastoreRequestResult,
+		Source:        "slack",
+		ComponentType: "new_thing",
+		ComponentID:   "fn001",
+		Created:       1686939542000000,
+	})
+	assert.Equal(t, activityOutput{
+		Created:       time.UnixMicro(1686939542000000).UTC(),
+		Level:         string(types.INFO),
+		EventType:     string(types.DatastoreRequestResult),
+		Source:        "slack",
+		ComponentType: "new_thing",
+		ComponentID:   "fn001",
+		TraceID:       "a123",
+		Payload:       map[string]interface{}{},
+	}, output)
+}
+
 func TestPlatformActivity_StreamingLogs(t *testing.T) {
 	for name, tt := range map[string]struct {
 		Setup           func(t *testing.T, ctx context.Context, cm *shared.ClientsMock) context.Context
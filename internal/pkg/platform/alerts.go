@@ -0,0 +1,45 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/toughtackle/slack-cli/internal/activityalert"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/shared"
+)
+
+// activityAlertExec runs the commands of alert rules
+var activityAlertExec hooks.ExecInterface = hooks.ShellExec{}
+
+// newActivityAlerter returns an alerter for the rules file at path. Relative
+// paths are found from the project directory.
+func newActivityAlerter(ctx context.Context, clients *shared.ClientFactory, path string) (*activityalert.Alerter, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(clients.SDKConfig.WorkingDirectory, path)
+	}
+	rules, err := activityalert.LoadRules(clients.Fs, path)
+	if err != nil {
+		return nil, err
+	}
+	clients.IO.PrintDebug(ctx, "loaded %d activity alert rules from %s", len(rules.Rules), path)
+	alerter := activityalert.NewAlerter(rules, activityAlertExec, clients.IO.WriteErr())
+	alerter.OnError = func(rule string, err error) {
+		clients.IO.PrintWarning(ctx, "The activity alert rule \"%s\" failed: %s", rule, err)
+	}
+	return alerter, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"io"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// alertExec records the commands of alert rules
+type alertExec struct {
+	mu       sync.Mutex
+	commands []string
+}
+
+func (e *alertExec) Command(env []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, name string, arg ...string) hooks.ShellCommand {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.commands = append(e.commands, name)
+	return &hooks.MockCommand{}
+}
+
+func TestActivity_Alerts(t *testing.T) {
+	tests := map[string]struct {
+		rules            string
+		expectedCommands []string
+		expectedError    string
+	}{
+		"runs the command of matching rules": {
+			rules:            `{"rules": [{"name": "errors", "match": {"level": "error", "event_type": "function_execution_result"}, "command": "./page.sh"}]}`,
+			expectedCommands: []string{"./page.sh"},
+		},
+		"skips rules that do not match": {
+			rules:            `{"rules": [{"name": "others", "match": {"component_id": "Fn999"}, "command": "./page.sh"}]}`,
+			expectedCommands: nil,
+		},
+		"errors for rules that are not valid": {
+			rules:         `{"rules": [{"name": "errors"}]}`,
+			expectedError: slackerror.ErrActivityAlertRules,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			ctx = config.SetContextToken(ctx, "xoxp-example")
+			clientsMock := shared.NewClientsMock()
+			clientsMock.APIInterface.On("ValidateSession", mock.Anything, mock.Anything).Return(api.AuthSession{}, nil)
+			clientsMock.APIInterface.On("Activity", mock.Anything, mock.Anything, mock.Anything).Return(api.ActivityResult{
+				Activities: []api.Activity{
+					{TraceID: "Tr001", Level: types.INFO, EventType: types.FunctionExecutionStarted, ComponentID: "Fn001", Created: 1686939542000000, Payload: map[string]interface{}{"function_name": "greet", "function_type": "app"}},
+					{TraceID: "Tr001", Level: types.ERROR, EventType: types.FunctionExecutionResult, ComponentID: "Fn001", Created: 1686939543000000, Payload: map[string]interface{}{"function_name": "greet", "function_type": "app", "error": "timeout"}},
+				},
+			}, nil)
+			clientsMock.AddDefaultMocks()
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			clients.SDKConfig.WorkingDirectory = "/project"
+			path := filepath.Join(config.ProjectConfigDirName, "alerts.json")
+			require.NoError(t, afero.WriteFile(clients.Fs, filepath.Join("/project", path), []byte(tt.rules), 0o644))
+			exec := &alertExec{}
+			activityAlertExec = exec
+			defer func() { activityAlertExec = hooks.ShellExec{} }()
+
+			err := Activity(ctx, clients, &logger.Logger{}, types.ActivityArgs{AppID: "A001", Alerts: path})
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, slackerror.ToSlackError(err).Code)
+				clientsMock.APIInterface.AssertNotCalled(t, "Activity", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCommands, exec.commands)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/style"
+)
+
+// Reconnects back off exponentially from the base delay up to the max delay
+// and give up after the max attempts fail in a row
+var (
+	reconnectBaseDelay   = time.Second
+	reconnectMaxDelay    = 30 * time.Second
+	reconnectMaxAttempts = 10
+)
+
+// Pings are sent on an interval and the connection is considered lost if no
+// pong arrives before the next ping is due plus the pong timeout
+var (
+	pingInterval = 30 * time.Second
+	pongTimeout  = 10 * time.Second
+)
+
+// reconnectDelay returns the delay before an attempt to reconnect. The delay
+// doubles with each attempt and is jittered so clients don't retry in step.
+func reconnectDelay(attempt int) time.Duration {
+	delay := reconnectBaseDelay
+	for i := 1; i < attempt && delay < reconnectMaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, reconnectMaxDelay)
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// extendReadDeadline gives the connection until the next ping is due plus the
+// pong timeout to read a message
+func extendReadDeadline(conn WebSocketConnection) error {
+	return conn.SetReadDeadline(time.Now().Add(pingInterval + pongTimeout))
+}
+
+// keepAlive pings the connection until stop is closed. The read deadline is
+// extended with each pong so that a silent connection fails the next read.
+func keepAlive(ctx context.Context, clients *shared.ClientFactory, conn WebSocketConnection, stop <-chan struct{}) {
+	if err := extendReadDeadline(conn); err != nil {
+		clients.IO.PrintDebug(ctx, "Failed to set the socket read deadline: %s", err)
+	}
+	conn.SetPongHandler(func(string) error {
+		return extendReadDeadline(conn)
+	})
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pongTimeout)); err != nil {
+					clients.IO.PrintDebug(ctx, "Failed to ping the socket connection: %s", err)
+				}
+			}
+		}
+	}()
+}
+
+// connectionStatus shows the state of the socket connection on a status line
+// that updates in place
+type connectionStatus struct {
+	mu         sync.Mutex
+	spinner    *style.Spinner
+	state      string
+	reconnects int
+	lastEvent  time.Time
+	// lastMessage is the time any message was read from the connection
+	lastMessage time.Time
+}
+
+// newConnectionStatus returns a status line written to stderr
+func newConnectionStatus(clients *shared.ClientFactory) *connectionStatus {
+	return &connectionStatus{
+		spinner: style.NewSpinner(clients.IO.WriteErr()),
+	}
+}
+
+// setState changes the connection state shown on the status line
+func (s *connectionStatus) setState(state string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	s.update()
+}
+
+// reconnected counts a connection after the first
+func (s *connectionStatus) reconnected() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reconnects++
+}
+
+// heard marks the time of the latest message from the connection
+func (s *connectionStatus) heard() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastMessage = time.Now()
+}
+
+// heardSince returns if a message was read from the connection after t
+func (s *connectionStatus) heardSince(t time.Time) bool {
+	if s == nil || t.IsZero() {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.lastMessage.Before(t)
+}
+
+// received marks the time of the latest event. The status line is only
+// redrawn when it animates to avoid printing a line for each event.
+func (s *connectionStatus) received() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastEvent = time.Now()
+	if style.IsSpinnerEnabled() {
+		s.update()
+	}
+}
+
+// stop clears the status line
+func (s *connectionStatus) stop() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.spinner.Active() {
+		s.spinner.Stop()
+	}
+}
+
+// text returns the status line
+func (s *connectionStatus) text() string {
+	details := []string{}
+	if s.reconnects > 0 {
+		details = append(details, fmt.Sprintf("%d %s", s.reconnects, style.Pluralize("reconnect", "reconnects", s.reconnects)))
+	}
+	if !s.lastEvent.IsZero() {
+		details = append(details, fmt.Sprintf("last event at %s", s.lastEvent.Format(time.TimeOnly)))
+	}
+	if len(details) == 0 {
+		return s.state
+	}
+	return fmt.Sprintf("%s %s", s.state, style.Secondary("("+strings.Join(details, ", ")+")"))
+}
+
+// update redraws the status line and must be called with the lock held
+func (s *connectionStatus) update() {
+	s.spinner.Update(s.text(), "")
+	if !s.spinner.Active() {
+		s.spinner.Start()
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_reconnectDelay(t *testing.T) {
+	tests := map[string]struct {
+		attempt int
+		least   time.Duration
+		most    time.Duration
+	}{
+		"waits around the base delay on the first attempt": {
+			attempt: 1,
+			least:   500 * time.Millisecond,
+			most:    time.Second,
+		},
+		"doubles the delay with each attempt": {
+			attempt: 3,
+			least:   2 * time.Second,
+			most:    4 * time.Second,
+		},
+		"caps the delay at the max delay": {
+			attempt: 12,
+			least:   15 * time.Second,
+			most:    30 * time.Second,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for range 20 {
+				delay := reconnectDelay(tt.attempt)
+				assert.GreaterOrEqual(t, delay, tt.least)
+				assert.LessOrEqual(t, delay, tt.most)
+			}
+		})
+	}
+}
+
+func Test_connectionStatus(t *testing.T) {
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+	status := newConnectionStatus(clients)
+	status.setState("Connected to Slack")
+	assert.Equal(t, "Connected to Slack", status.text())
+
+	status.reconnected()
+	status.reconnected()
+	status.received()
+	assert.Regexp(t, `^Connected to Slack \(2 reconnects, last event at \d{2}:\d{2}:\d{2}\)$`, status.text())
+	status.stop()
+
+	dialed := time.Now()
+	assert.False(t, status.heardSince(dialed))
+	status.heard()
+	assert.True(t, status.heardSince(dialed))
+
+	var missing *connectionStatus
+	assert.NotPanics(t, func() {
+		missing.setState("Connecting to Slack")
+		missing.received()
+		missing.heard()
+		missing.stop()
+	})
+}
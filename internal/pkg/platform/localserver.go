@@ -19,26 +19,76 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/exec"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/radovskyb/watcher"
+	"github.com/toughtackle/slack-cli/internal/cache"
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/goutils"
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/apps"
+	"github.com/toughtackle/slack-cli/internal/pkg/function"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/socketsession"
 )
 
+// defaultEventConcurrency is the number of events handled at once when the
+// hooks.json config does not set "event-concurrency"
+const defaultEventConcurrency = 10
+
+// slackAckTimeout is how long Slack waits for an envelope to be acknowledged
+// before the event is retried
+var slackAckTimeout = 3 * time.Second
+
+// eventDispatcher bounds the number of events handled at once and serializes
+// writes to the socket connection
+type eventDispatcher struct {
+	slots   chan struct{}
+	failed  chan error
+	wg      sync.WaitGroup
+	logMu   sync.Mutex
+	writeMu sync.Mutex
+}
+
+// newEventDispatcher returns a dispatcher for the concurrency of the config
+func newEventDispatcher(concurrency int) *eventDispatcher {
+	if concurrency <= 0 {
+		concurrency = defaultEventConcurrency
+	}
+	return &eventDispatcher{
+		slots:  make(chan struct{}, concurrency),
+		failed: make(chan error, 1),
+	}
+}
+
+// fail reports the first error of a worker without blocking
+func (d *eventDispatcher) fail(err error) {
+	select {
+	case d.failed <- err:
+	default:
+	}
+}
+
+// wait blocks until events being handled are finished
+func (d *eventDispatcher) wait() {
+	d.wg.Wait()
+}
+
+// installLocalApp reinstalls the app when the manifest changes
+var installLocalApp = apps.InstallLocalApp
+
 // for lazy testing
 var websocketDialerDial = func(d *websocket.Dialer, urlStr string,
 	requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
@@ -50,6 +100,8 @@ type WebSocketConnection interface {
 	ReadMessage() (messageType int, p []byte, err error)
 	WriteMessage(messageType int, data []byte) error
 	WriteControl(messageType int, data []byte, deadline time.Time) error
+	SetReadDeadline(t time.Time) error
+	SetPongHandler(h func(appData string) error)
 	Close() error
 }
 
@@ -82,11 +134,26 @@ type LocalServer struct {
 	localHostedContext LocalHostedContext
 	cliConfig          hooks.SDKCLIConfig
 	Connection         WebSocketConnection
+	// recordDir saves each incoming event as a fixture if set
+	recordDir string
+	// session records each envelope and response if set
+	session *socketsession.Recorder
+	// status shows the state of the socket connection while started
+	status *connectionStatus
+	// restart signals an SDK managed process to restart after a file change
+	restart chan struct{}
 }
 
 // Start establishes a socket connection to Slack, which will receive app-relevant events. It does so in a loop to support for re-establishing the socket connection.
+// Connections that fail are retried with an exponential backoff until the attempts run out.
 func (r *LocalServer) Start(ctx context.Context) error {
+	r.status = newConnectionStatus(r.clients)
+	defer r.status.stop()
+	connected := false
+	failures := 0
 	for {
+		var dialed time.Time
+		r.status.setState("Connecting to Slack")
 		// Wrapping in an error function so that we can `defer` closing the TCP connection within the loop in the case of a restart
 		err := func() error {
 			// Get a socket connection address
@@ -102,15 +169,24 @@ func (r *LocalServer) Start(ctx context.Context) error {
 				return slackerror.Wrap(err, slackerror.ErrSocketConnection).WithMessage("Error establishing socket connection")
 			}
 			r.Connection = c
+			if connected {
+				r.status.reconnected()
+			}
+			connected = true
+			dialed = time.Now()
+			r.status.setState("Connected to Slack")
 			// Signal to CLI that this command will need to do additional cleanup of I/O (closing socket connection cleanly); matching Done() in defer function below
 			r.clients.CleanupWaitGroup.Add(1)
 			// Two channels to communicate with Listen(): errChan for errors, and done for signaling restarting the connection
 			// A special "clean exit" error exists for signaling a graceful exit; run.go handles this special error
 			errChan := make(chan error)
 			done := make(chan bool)
+			stopKeepAlive := make(chan struct{})
+			keepAlive(ctx, r.clients, c, stopKeepAlive)
 			go r.Listen(ctx, errChan, done)
 			// Cleanup routine: close TCP connection and notify global waitgroup that we are done.
 			defer func() {
+				close(stopKeepAlive)
 				close(errChan)
 				close(done)
 				r.clients.IO.PrintDebug(ctx, "LocalServer.Start closing websocket TCP connection")
@@ -126,21 +202,47 @@ func (r *LocalServer) Start(ctx context.Context) error {
 				}
 				r.clients.IO.PrintDebug(ctx, "LocalServer.Listen errored: %s", err.Error())
 				sendWebSocketCloseControlMessage(ctx, r.clients, r.Connection)
-				return slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+				return err
 			case <-done:
 				r.clients.IO.PrintDebug(ctx, "LocalServer.Listen signalled for restart")
 				return nil
 			}
 		}()
-		if err != nil {
-			return err
+		if err == nil {
+			continue
+		}
+		if slackerror.ToSlackError(err).Code != slackerror.ErrSocketConnection {
+			if slackerror.Is(err, slackerror.ErrLocalAppRunCleanExit) {
+				return err
+			}
+			return slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+		}
+		// Connection errors might pass so retry after a delay. The attempts are
+		// counted from the last connection that received a message.
+		if r.status.heardSince(dialed) {
+			failures = 0
+		}
+		failures++
+		if failures > reconnectMaxAttempts {
+			r.status.setState(fmt.Sprintf("Failed to connect to Slack after %d attempts", reconnectMaxAttempts))
+			return slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+		}
+		delay := reconnectDelay(failures)
+		r.clients.IO.PrintDebug(ctx, "Reconnecting in %s after a connection error: %s", delay, err)
+		r.status.setState(fmt.Sprintf("Reconnecting to Slack in %s (attempt %d of %d)", delay.Round(time.Second/10), failures, reconnectMaxAttempts))
+		select {
+		case <-ctx.Done():
+			return slackerror.New(slackerror.ErrLocalAppRunCleanExit)
+		case <-time.After(delay):
 		}
 	}
 }
 
 // Listen waits for incoming events over a socket connection and invokes the deno-sdk-powered app with each payload. Responds to each event in a way that mimics the behaviour of a hosted app.
+// Events are handled concurrently by a bounded number of workers while responses are written to the connection one at a time.
 func (r *LocalServer) Listen(ctx context.Context, errChan chan<- error, done chan<- bool) {
 	r.log.Info("on_cloud_run_connection_connected")
+	dispatcher := newEventDispatcher(r.cliConfig.Config.EventConcurrency)
 
 	// Listen for socket messages
 	for {
@@ -148,12 +250,19 @@ func (r *LocalServer) Listen(ctx context.Context, errChan chan<- error, done cha
 		case <-ctx.Done():
 			// In case execution _happens_ to be here before calling ReadMessage() below, and the user ctrl+c,
 			// we can exit early and cleanly. Very unlikely, though, as ReadMessage() below blocks.
+			dispatcher.wait()
 			errChan <- slackerror.New(slackerror.ErrLocalAppRunCleanExit)
 			return
+		case err := <-dispatcher.failed:
+			// A response could not be written so the connection is no longer usable
+			dispatcher.wait()
+			errChan <- err
+			return
 		default:
 			// Unfortunately, the following call blocks the thread
 			_, messageBytes, err := r.Connection.ReadMessage()
 			if err != nil {
+				dispatcher.wait()
 				// If Slack backend signals that it is going down (CloseGoingAway), or the connection is being terminated by Slack - possibly as a result of user ctrl+c and us sending a Close Control Message and Slack echoing it back to us as part of a goodbye handshake (CloseNormalClosure),
 				// gorilla will by default send a close message back as per the websocket spec. In this case, we only need to close the TCP connection,
 				// which is done via `defer` in LocalServer.Start()
@@ -166,8 +275,11 @@ func (r *LocalServer) Listen(ctx context.Context, errChan chan<- error, done cha
 				}
 				return
 			}
+			r.status.heard()
+			dispatcher.logMu.Lock()
 			r.log.Data["cloud_run_connection_message"] = string(messageBytes)
 			r.log.Debug("on_cloud_run_connection_message")
+			dispatcher.logMu.Unlock()
 
 			var msg Message
 			err = json.Unmarshal(messageBytes, &msg)
@@ -177,72 +289,164 @@ func (r *LocalServer) Listen(ctx context.Context, errChan chan<- error, done cha
 				// Choosing this route because sometimes the server returns with errors like `UNAUTHENTICATED: cache_error`
 				// in that case, we do not want to exit the whole local run experience but merely warn the user and re-connect
 				r.clients.IO.PrintDebug(ctx, "Re-establishing socket connection as we received an unexpected response from server: %s", string(messageBytes))
+				dispatcher.wait()
 				done <- false
 				return
 			}
 
-			var linkResponse *LinkResponse
 			switch msg.Type {
 			case helloMessageType:
 				// ignore any hello messages from the server
 				continue
 			case disconnectMessageType:
 				// when we receive a disconnect event, we should reconnect
+				dispatcher.wait()
 				done <- false
 				return
 			default:
+				r.status.received()
 				var socketEvent = SocketEvent{
 					Body:    msg.Payload,
 					Context: r.localHostedContext,
 				}
+				if r.recordDir != "" {
+					r.recordEvent(ctx, msg)
+				}
 
 				body, err := json.Marshal(socketEvent)
 				if err != nil {
+					dispatcher.wait()
 					errChan <- slackerror.Wrap(err, slackerror.ErrSocketConnection)
 					return
 				}
 
 				_, err = r.clients.SDKConfig.Hooks.Start.Get()
 				if err != nil {
+					dispatcher.wait()
 					errChan <- err
 					return
 				}
-				// Mimic the hosted app by executing the SDKs run command with the message as a param
-				var startHookOpts = hooks.HookExecOpts{
-					Hook:   r.clients.SDKConfig.Hooks.Start,
-					Stdin:  bytes.NewBuffer(body),
-					Stdout: r.clients.IO.WriteSecondary(r.clients.IO.WriteOut()),
-					Stderr: r.clients.IO.WriteSecondary(r.clients.IO.WriteErr()),
-				}
-
-				out, err := r.clients.HookExecutor.Execute(ctx, startHookOpts)
-
-				if err != nil {
-					// Log the error but do not return because the user may be able to recover inside their app code
-					r.log.Data["cloud_run_connection_command_error"] = fmt.Sprintf("%s\n%s", err, out)
-					r.log.Warn("on_cloud_run_connection_command_error")
-					break
-				}
-
-				r.log.Info("on_cloud_run_connection_command_output")
 
-				linkResponse = &LinkResponse{
-					EnvelopeID: msg.EnvelopeID,
-					Payload:    json.RawMessage(out),
-				}
-			}
-
-			// Write response back to websocket
-			if linkResponse != nil {
-				if err := sendWebSocketMessage(r.Connection, linkResponse); err != nil {
+				// Wait for a free worker before reading the next message
+				select {
+				case dispatcher.slots <- struct{}{}:
+				case <-ctx.Done():
+					dispatcher.wait()
+					errChan <- slackerror.New(slackerror.ErrLocalAppRunCleanExit)
+					return
+				case err := <-dispatcher.failed:
+					dispatcher.wait()
 					errChan <- err
 					return
 				}
+				// Pongs are only handled while reading so the read deadline is
+				// extended in case every worker was busy past it
+				if err := extendReadDeadline(r.Connection); err != nil {
+					r.clients.IO.PrintDebug(ctx, "Failed to set the socket read deadline: %s", err)
+				}
+				dispatcher.wg.Add(1)
+				go func() {
+					defer dispatcher.wg.Done()
+					defer func() { <-dispatcher.slots }()
+					r.handleEvent(ctx, dispatcher, msg, body)
+				}()
 			}
 		}
 	}
 }
 
+// handleEvent mimics the hosted app by executing the start hook with a socket
+// event and writes the response back to the connection
+func (r *LocalServer) handleEvent(ctx context.Context, dispatcher *eventDispatcher, msg Message, body []byte) {
+	received := time.Now()
+	ackTimer := time.AfterFunc(slackAckTimeout, func() {
+		r.clients.IO.PrintWarning(ctx, "The %s envelope %s is still being handled after %s so Slack might retry it", msg.Type, msg.EnvelopeID, slackAckTimeout)
+	})
+	defer ackTimer.Stop()
+
+	var stdout bytes.Buffer
+	out, err := r.clients.HookExecutor.Execute(ctx, r.startHookOpts(body, &stdout))
+	if err != nil {
+		// Log the error but do not return because the user may be able to recover inside their app code
+		dispatcher.logMu.Lock()
+		r.log.Data["cloud_run_connection_command_error"] = fmt.Sprintf("%s\n%s", err, out)
+		r.log.Warn("on_cloud_run_connection_command_error")
+		dispatcher.logMu.Unlock()
+		r.recordEnvelope(ctx, msg, received, stdout.String(), nil, err)
+		return
+	}
+	dispatcher.logMu.Lock()
+	r.log.Info("on_cloud_run_connection_command_output")
+	dispatcher.logMu.Unlock()
+
+	// Write response back to websocket
+	linkResponse := &LinkResponse{
+		EnvelopeID: msg.EnvelopeID,
+		Payload:    json.RawMessage(out),
+	}
+	dispatcher.writeMu.Lock()
+	err = sendWebSocketMessage(r.Connection, linkResponse)
+	dispatcher.writeMu.Unlock()
+	if err != nil {
+		dispatcher.fail(err)
+		return
+	}
+	if elapsed := time.Since(received); elapsed > slackAckTimeout {
+		r.clients.IO.PrintWarning(ctx, "The %s envelope %s was acknowledged after %s which exceeds the %s that Slack waits", msg.Type, msg.EnvelopeID, elapsed.Round(time.Millisecond), slackAckTimeout)
+	}
+	r.recordEnvelope(ctx, msg, received, stdout.String(), linkResponse.Payload, nil)
+}
+
+// startHookOpts returns the options that pipe a socket event through the start
+// hook. Output of the hook is also written to stdout.
+func (r *LocalServer) startHookOpts(body []byte, stdout io.Writer) hooks.HookExecOpts {
+	return hooks.HookExecOpts{
+		Hook:   r.clients.SDKConfig.Hooks.Start,
+		Stdin:  bytes.NewBuffer(body),
+		Stdout: io.MultiWriter(stdout, r.clients.IO.WriteSecondary(r.clients.IO.WriteOut())),
+		Stderr: r.clients.IO.WriteSecondary(r.clients.IO.WriteErr()),
+	}
+}
+
+// recordEnvelope saves a socket message and the response of the app to the
+// session file if a session is recorded
+func (r *LocalServer) recordEnvelope(ctx context.Context, msg Message, received time.Time, stdout string, response json.RawMessage, hookErr error) {
+	if r.session == nil {
+		return
+	}
+	envelope := socketsession.Envelope{
+		Type:       msg.Type,
+		EnvelopeID: msg.EnvelopeID,
+		ReceivedAt: received,
+		Payload:    msg.Payload,
+		Stdout:     stdout,
+		Response:   response,
+		LatencyMs:  time.Since(received).Milliseconds(),
+	}
+	if hookErr != nil {
+		envelope.Error = hookErr.Error()
+	}
+	if err := r.session.Record(envelope); err != nil {
+		r.clients.IO.PrintWarning(ctx, "The envelope could not be recorded: %s", err)
+	}
+}
+
+// recordEvent saves the payload of a socket message as a fixture that can be
+// replayed with "function invoke"
+func (r *LocalServer) recordEvent(ctx context.Context, msg Message) {
+	path, err := function.WriteFixture(r.clients.Fs, r.recordDir, function.Fixture{
+		Type:       msg.Type,
+		EnvelopeID: msg.EnvelopeID,
+		ReceivedAt: time.Now(),
+		Payload:    msg.Payload,
+	})
+	if err != nil {
+		r.clients.IO.PrintWarning(ctx, "The event could not be recorded: %s", err)
+		return
+	}
+	r.clients.IO.PrintDebug(ctx, "Recorded the %s event to %s", msg.Type, path)
+}
+
 // StartDelegate passes along required opts to SDK, delegating
 // connection for running app locally to script hook start
 func (r *LocalServer) StartDelegate(ctx context.Context) error {
@@ -275,30 +479,51 @@ func (r *LocalServer) StartDelegate(ctx context.Context) error {
 	// before adding any new environment variables.
 	var cmdEnvVars = os.Environ()
 	cmdEnvVars = append(cmdEnvVars, goutils.MapToStringSlice(sdkManagedConnectionStartHookOpts.Env, "")...)
-	cmd := sdkManagedConnectionStartHookOpts.Exec.Command(cmdEnvVars, os.Stdout, os.Stderr, nil, cmdArgs[0], cmdArgVars...)
-	// The following command will block, as the expectation is that SDK-delegated local-run invokes a long-running (blocking) child process
-	err = cmd.Run()
-	if err != nil {
-		if status, ok := err.(*exec.ExitError); ok {
-			switch status.ExitCode() {
-			case -1:
-				return slackerror.New(slackerror.ErrProcessInterrupted)
-			default:
-				if status, ok := err.(*exec.ExitError); ok {
+	for {
+		cmd := sdkManagedConnectionStartHookOpts.Exec.Command(cmdEnvVars, os.Stdout, os.Stderr, nil, cmdArgs[0], cmdArgVars...)
+		// The expectation is that SDK-delegated local-run invokes a long-running (blocking) child process
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		exited := make(chan error, 1)
+		go func() {
+			exited <- cmd.Wait()
+		}()
+		select {
+		case err = <-exited:
+		case <-ctx.Done():
+			// Hooks run in a separate process group so the interrupt is passed along
+			_ = hooks.StopCommand(cmd, exited)
+			return slackerror.New(slackerror.ErrProcessInterrupted)
+		case <-r.restart:
+			// Stop the process then start it again with changes to the app code
+			r.clients.IO.PrintDebug(ctx, "Restarting the SDK managed process after a file change")
+			if process, ok := cmd.(interface{ Kill() error }); ok {
+				_ = process.Kill()
+			}
+			<-exited
+			continue
+		}
+		if err != nil {
+			if status, ok := err.(*exec.ExitError); ok {
+				switch status.ExitCode() {
+				case -1:
+					return slackerror.New(slackerror.ErrProcessInterrupted)
+				default:
 					code := iostreams.ExitCode(status.ExitCode())
 					r.clients.IO.SetExitCode(code)
+					return slackerror.New(slackerror.ErrSDKHookInvocationFailed).
+						WithMessage("The 'start' hook exited with an error").
+						WithDetails(slackerror.ErrorDetails{
+							{Code: slackerror.ErrLocalAppRun, Message: err.Error()},
+						}).
+						WithRemediation("")
 				}
-				return slackerror.New(slackerror.ErrSDKHookInvocationFailed).
-					WithMessage("The 'start' hook exited with an error").
-					WithDetails(slackerror.ErrorDetails{
-						{Code: slackerror.ErrLocalAppRun, Message: err.Error()},
-					}).
-					WithRemediation("")
 			}
+			return err
 		}
-		return err
+		return nil
 	}
-	return nil
 }
 
 // Watch for file changes. If configuration for watch is provided
@@ -313,7 +538,7 @@ func (r *LocalServer) Watch(ctx context.Context, auth types.SlackAuth, app types
 	// Init watcher
 	w := watcher.New()
 	w.SetMaxEvents(1)
-	w.FilterOps(watcher.Write)
+	w.FilterOps(watcher.Write, watcher.Create, watcher.Remove, watcher.Rename, watcher.Move)
 
 	// Use SDK-provided filter regex
 	if r.cliConfig.Config.Watch.FilterRegex != "" {
@@ -330,6 +555,12 @@ func (r *LocalServer) Watch(ctx context.Context, auth types.SlackAuth, app types
 		}
 	}
 
+	// Remember the installed manifest to skip reinstalls for code changes
+	manifestHash, err := r.localManifestHash(ctx)
+	if err != nil {
+		r.clients.IO.PrintDebug(ctx, "Failed to hash the app manifest: %s", err)
+	}
+
 	// Begin watching for file changes
 	go func() {
 		for {
@@ -340,14 +571,7 @@ func (r *LocalServer) Watch(ctx context.Context, auth types.SlackAuth, app types
 			case event := <-w.Event:
 				r.log.Data["cloud_run_watch_file_change"] = event.Path
 				r.log.Info("on_cloud_run_watch_file_change")
-
-				if _, _, _, err := apps.InstallLocalApp(ctx, r.clients, "", r.log, auth, app); err != nil {
-					// The install command will have handled printing the error
-					r.log.Data["cloud_run_watch_error"] = err.Error()
-					r.log.Warn("on_cloud_run_watch_error")
-				} else {
-					r.log.Info("on_cloud_run_watch_file_change_reinstalled")
-				}
+				manifestHash = r.reload(ctx, auth, app, manifestHash)
 			case err := <-w.Error:
 				r.log.Data["cloud_run_watch_error"] = err.Error()
 				r.log.Warn("on_cloud_run_watch_error")
@@ -360,6 +584,49 @@ func (r *LocalServer) Watch(ctx context.Context, auth types.SlackAuth, app types
 	return w.Start(time.Millisecond * 100)
 }
 
+// reload applies a file change to the running app and returns the hash of the
+// installed manifest. The app is reinstalled only if the manifest changed.
+// Otherwise an SDK managed process is restarted while the start hook that runs
+// for each event picks up changes once hook servers are stopped.
+func (r *LocalServer) reload(ctx context.Context, auth types.SlackAuth, app types.App, installed cache.Hash) cache.Hash {
+	// Stop hook servers once the events being handled are answered so that the
+	// next hook loads the changed app code
+	if closer, ok := r.clients.HookExecutor.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	hash, err := r.localManifestHash(ctx)
+	if err != nil || installed == "" || !hash.Equals(installed) {
+		r.log.Info("on_cloud_run_watch_manifest_change")
+		if _, _, _, err := installLocalApp(ctx, r.clients, "", r.log, auth, app); err != nil {
+			// The install command will have handled printing the error
+			r.log.Data["cloud_run_watch_error"] = err.Error()
+			r.log.Warn("on_cloud_run_watch_error")
+			return installed
+		}
+		r.log.Info("on_cloud_run_watch_file_change_reinstalled")
+		return hash
+	}
+	if r.cliConfig.Config.SDKManagedConnection {
+		select {
+		case r.restart <- struct{}{}:
+		default:
+		}
+		r.log.Info("on_cloud_run_watch_app_restart")
+		return hash
+	}
+	r.log.Info("on_cloud_run_watch_code_change")
+	return hash
+}
+
+// localManifestHash returns the hash of the project manifest
+func (r *LocalServer) localManifestHash(ctx context.Context) (cache.Hash, error) {
+	manifest, err := r.clients.AppClient().Manifest.GetManifestLocal(ctx, r.clients.SDKConfig, r.clients.HookExecutor)
+	if err != nil {
+		return "", err
+	}
+	return r.clients.Config.ProjectConfig.Cache().NewManifestHash(ctx, manifest.AppManifest)
+}
+
 func (r *LocalServer) WatchActivityLogs(ctx context.Context, minLevel string) error {
 	// Default minimum log level
 	if strings.TrimSpace(minLevel) == "" {
@@ -38,6 +38,8 @@ func (m *WebSocketConnMock) AddDefaultMocks() {
 	m.On("WriteMessage", mock.Anything, mock.Anything).Return(nil)
 	m.On("WriteControl", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	m.On("ReadMessage").Return(1 /* WebSocket TextMessage message type */, []byte("{}"), nil)
+	m.On("SetReadDeadline", mock.Anything).Return(nil)
+	m.On("SetPongHandler", mock.Anything)
 	m.On("Close").Return(nil)
 }
 
@@ -59,6 +61,17 @@ func (m *WebSocketConnMock) WriteControl(messageType int, data []byte, deadline
 	return args.Error(0)
 }
 
+// SetReadDeadline mock
+func (m *WebSocketConnMock) SetReadDeadline(t time.Time) error {
+	args := m.Called(t)
+	return args.Error(0)
+}
+
+// SetPongHandler mock
+func (m *WebSocketConnMock) SetPongHandler(h func(appData string) error) {
+	m.Called(h)
+}
+
 // Close mock
 func (m *WebSocketConnMock) Close() error {
 	args := m.Called()
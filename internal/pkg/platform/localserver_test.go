@@ -20,16 +20,25 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/app"
+	"github.com/toughtackle/slack-cli/internal/cache"
+	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/pkg/function"
 	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/socketsession"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -74,6 +83,47 @@ func Test_LocalServer_Start(t *testing.T) {
 				require.ErrorContains(t, server.Start(ctx), "oh no")
 			},
 		},
+		"should reconnect after a connection error": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				cm.APIInterface.On("ConnectionsOpen", mock.Anything, mock.Anything).Return(api.AppsConnectionsOpenResult{}, slackerror.New("the network went to sleep")).Once()
+				conn.On("ReadMessage").Return(websocket.CloseMessage, []byte{}, &websocket.CloseError{Code: websocket.CloseNormalClosure, Text: "byebye"}).Once()
+			},
+			fakeDialer: func(conn *WebSocketConnMock) func(d *websocket.Dialer, urlStr string, requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
+				return func(d *websocket.Dialer, urlStr string, requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
+					return conn, nil, nil
+				}
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				require.ErrorContains(t, server.Start(ctx), slackerror.ErrLocalAppRunCleanExit)
+				cm.APIInterface.AssertNumberOfCalls(t, "ConnectionsOpen", 2)
+				conn.AssertCalled(t, "SetReadDeadline", mock.Anything)
+				conn.AssertCalled(t, "SetPongHandler", mock.Anything)
+			},
+		},
+		"should reconnect when reading from the connection times out": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				conn.On("ReadMessage").Return(0, []byte{}, slackerror.New("i/o timeout")).Once()
+				conn.On("ReadMessage").Return(websocket.CloseMessage, []byte{}, &websocket.CloseError{Code: websocket.CloseNormalClosure, Text: "byebye"}).Once()
+			},
+			fakeDialer: func(conn *WebSocketConnMock) func(d *websocket.Dialer, urlStr string, requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
+				return func(d *websocket.Dialer, urlStr string, requestHeader http.Header) (WebSocketConnection, *http.Response, error) {
+					return conn, nil, nil
+				}
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				require.ErrorContains(t, server.Start(ctx), slackerror.ErrLocalAppRunCleanExit)
+				conn.AssertNumberOfCalls(t, "Close", 2)
+			},
+		},
+		"should stop reconnecting once the attempts run out": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				cm.APIInterface.On("ConnectionsOpen", mock.Anything, mock.Anything).Return(api.AppsConnectionsOpenResult{}, slackerror.New("the network went to sleep"))
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				require.ErrorContains(t, server.Start(ctx), "the network went to sleep")
+				cm.APIInterface.AssertNumberOfCalls(t, "ConnectionsOpen", reconnectMaxAttempts+1)
+			},
+		},
 		"should re-establish connection if disconnect message received": {
 			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
 				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
@@ -143,13 +193,18 @@ func Test_LocalServer_Start(t *testing.T) {
 				Data: map[string]interface{}{},
 			}
 			server := LocalServer{
-				clients,
-				&log,
-				"ABC123",
-				localContext,
-				clients.SDKConfig,
-				conn,
+				clients:            clients,
+				log:                &log,
+				token:              "ABC123",
+				localHostedContext: localContext,
+				cliConfig:          clients.SDKConfig,
+				Connection:         conn,
 			}
+			baseDelay, maxDelay := reconnectBaseDelay, reconnectMaxDelay
+			reconnectBaseDelay, reconnectMaxDelay = time.Millisecond, time.Millisecond
+			defer func() {
+				reconnectBaseDelay, reconnectMaxDelay = baseDelay, maxDelay
+			}()
 			if tt.fakeDialer != nil {
 				orig := *WebsocketDialerDial
 				websocketDialerDial = tt.fakeDialer(conn)
@@ -163,6 +218,28 @@ func Test_LocalServer_Start(t *testing.T) {
 	}
 }
 
+// blockingExecutor signals when an event starts and waits to be released
+type blockingExecutor struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (e *blockingExecutor) Execute(ctx context.Context, opts hooks.HookExecOpts) (string, error) {
+	e.started <- struct{}{}
+	<-e.release
+	return "{}", nil
+}
+
+// slowExecutor responds to each event after a delay
+type slowExecutor struct {
+	delay time.Duration
+}
+
+func (e *slowExecutor) Execute(ctx context.Context, opts hooks.HookExecOpts) (string, error) {
+	time.Sleep(e.delay)
+	return "{}", nil
+}
+
 func Test_LocalServer_Listen(t *testing.T) {
 	for name, tt := range map[string]struct {
 		Setup func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock)
@@ -308,6 +385,61 @@ func Test_LocalServer_Listen(t *testing.T) {
 				}
 			},
 		},
+		"should record events as fixtures when a record directory is set": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				clients.SDKConfig.Hooks.Start = hooks.HookScript{Command: "echo '{}'", Name: "start"}
+				payload := `{"type":"event_callback","event":{"type":"function_executed","function":{"callback_id":"greet"}}}`
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"12345","payload":`+payload+`}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
+				cm.HookExecutor.On("Execute", mock.Anything, mock.Anything).Return("{}", nil)
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				server.recordDir = "fixtures"
+				errChan := make(chan error)
+				done := make(chan bool)
+				go server.Listen(ctx, errChan, done)
+				select {
+				case err := <-errChan:
+					assert.Fail(t, "unexpected err channel signalled", err)
+				case <-done:
+					files, err := afero.ReadDir(server.clients.Fs, "fixtures")
+					require.NoError(t, err)
+					require.Len(t, files, 1)
+					assert.True(t, strings.HasPrefix(files[0].Name(), "greet-"))
+					fixture, err := function.ReadFixture(server.clients.Fs, filepath.Join("fixtures", files[0].Name()))
+					require.NoError(t, err)
+					assert.Equal(t, "events_api", fixture.Type)
+				}
+			},
+		},
+		"should record envelopes and responses to a session file when a session is set": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				clients.SDKConfig.Hooks.Start = hooks.HookScript{Command: "echo '{}'", Name: "start"}
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"12345","payload":{"event":{"type":"app_mention"}}}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
+				cm.HookExecutor.On("Execute", mock.Anything, mock.Anything).Return(`{"text":"hi"}`, nil)
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				session, err := socketsession.NewRecorder(server.clients.Fs, "session.jsonl")
+				require.NoError(t, err)
+				server.session = session
+				errChan := make(chan error)
+				done := make(chan bool)
+				go server.Listen(ctx, errChan, done)
+				select {
+				case err := <-errChan:
+					assert.Fail(t, "unexpected err channel signalled", err)
+				case <-done:
+					envelopes, err := socketsession.ReadSession(server.clients.Fs, "session.jsonl")
+					require.NoError(t, err)
+					require.Len(t, envelopes, 1)
+					assert.Equal(t, "events_api", envelopes[0].Type)
+					assert.Equal(t, "12345", envelopes[0].EnvelopeID)
+					assert.JSONEq(t, `{"event":{"type":"app_mention"}}`, string(envelopes[0].Payload))
+					assert.JSONEq(t, `{"text":"hi"}`, string(envelopes[0].Response))
+				}
+			},
+		},
 		"should return and send an error if there was a problem sending a websocket message": {
 			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
 				// TODO: should probably create a hookscript mock instead of doing this.
@@ -329,6 +461,90 @@ func Test_LocalServer_Listen(t *testing.T) {
 				}
 			},
 		},
+		"should handle events concurrently up to the configured event concurrency": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				clients.SDKConfig.Hooks.Start = hooks.HookScript{Command: "echo '{}'", Name: "start"}
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"E1","payload":{}}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"E2","payload":{}}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				executor := &blockingExecutor{started: make(chan struct{}), release: make(chan struct{})}
+				server.clients.HookExecutor = executor
+				server.cliConfig.Config.EventConcurrency = 2
+				errChan := make(chan error)
+				done := make(chan bool)
+				go server.Listen(ctx, errChan, done)
+				for range 2 {
+					select {
+					case <-executor.started:
+					case <-time.After(time.Second):
+						close(executor.release)
+						require.Fail(t, "events were not handled concurrently")
+					}
+				}
+				close(executor.release)
+				select {
+				case err := <-errChan:
+					assert.Fail(t, "unexpected err channel signalled", err)
+				case <-done:
+					conn.AssertNumberOfCalls(t, "WriteMessage", 2)
+				}
+			},
+		},
+		"should extend the read deadline after waiting for a free worker": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				clients.SDKConfig.Hooks.Start = hooks.HookScript{Command: "echo '{}'", Name: "start"}
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"E1","payload":{}}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"E2","payload":{}}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				executor := &blockingExecutor{started: make(chan struct{}), release: make(chan struct{})}
+				server.clients.HookExecutor = executor
+				server.cliConfig.Config.EventConcurrency = 1
+				errChan := make(chan error)
+				done := make(chan bool)
+				go server.Listen(ctx, errChan, done)
+				<-executor.started
+				close(executor.release)
+				<-executor.started
+				select {
+				case err := <-errChan:
+					assert.Fail(t, "unexpected err channel signalled", err)
+				case <-done:
+					conn.AssertNumberOfCalls(t, "SetReadDeadline", 2)
+					conn.AssertNumberOfCalls(t, "WriteMessage", 2)
+				}
+			},
+		},
+		"should warn when an envelope is not acknowledged within the ack window": {
+			Setup: func(t *testing.T, cm *shared.ClientsMock, clients *shared.ClientFactory, conn *WebSocketConnMock) {
+				clients.SDKConfig.Hooks.Start = hooks.HookScript{Command: "echo '{}'", Name: "start"}
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte(`{"type":"events_api","envelope_id":"E1","payload":{}}`), nil).Once()
+				conn.On("ReadMessage").Return(websocket.TextMessage, []byte("{\"type\":\"disconnect\"}"), nil).Once()
+			},
+			Test: func(t *testing.T, ctx context.Context, cm *shared.ClientsMock, server LocalServer, conn *WebSocketConnMock) {
+				server.clients.HookExecutor = &slowExecutor{delay: 50 * time.Millisecond}
+				ackTimeout := slackAckTimeout
+				slackAckTimeout = 10 * time.Millisecond
+				defer func() { slackAckTimeout = ackTimeout }()
+				errChan := make(chan error)
+				done := make(chan bool)
+				go server.Listen(ctx, errChan, done)
+				select {
+				case err := <-errChan:
+					assert.Fail(t, "unexpected err channel signalled", err)
+				case <-done:
+					for _, warning := range []string{"is still being handled", "exceeds the"} {
+						cm.IO.AssertCalled(t, "PrintWarning", mock.Anything, mock.MatchedBy(func(format string) bool {
+							return strings.Contains(format, warning)
+						}), mock.Anything)
+					}
+					conn.AssertNumberOfCalls(t, "WriteMessage", 1)
+				}
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			// Create mocks
@@ -354,12 +570,12 @@ func Test_LocalServer_Listen(t *testing.T) {
 				Data: map[string]interface{}{},
 			}
 			server := LocalServer{
-				clients,
-				&log,
-				"ABC123",
-				localContext,
-				clients.SDKConfig,
-				conn,
+				clients:            clients,
+				log:                &log,
+				token:              "ABC123",
+				localHostedContext: localContext,
+				cliConfig:          clients.SDKConfig,
+				Connection:         conn,
 			}
 			tt.Test(t, ctx, clientsMock, server, conn)
 		})
@@ -441,3 +657,132 @@ func Test_sendWebSocketMessage(t *testing.T) {
 		conn.AssertCalled(t, "WriteMessage", mock.Anything, mock.Anything)
 	})
 }
+
+func Test_LocalServer_reload(t *testing.T) {
+	tests := map[string]struct {
+		installed          cache.Hash
+		current            cache.Hash
+		sdkManaged         bool
+		installErr         error
+		expectedHash       cache.Hash
+		expectedEvents     []string
+		expectedInstalls   int
+		expectedRestarting bool
+	}{
+		"reinstalls the app when the manifest changes": {
+			installed:        "abc",
+			current:          "def",
+			expectedHash:     "def",
+			expectedEvents:   []string{"on_cloud_run_watch_manifest_change", "on_cloud_run_watch_file_change_reinstalled"},
+			expectedInstalls: 1,
+		},
+		"reinstalls the app when no manifest was hashed before": {
+			current:          "def",
+			expectedHash:     "def",
+			expectedEvents:   []string{"on_cloud_run_watch_manifest_change", "on_cloud_run_watch_file_change_reinstalled"},
+			expectedInstalls: 1,
+		},
+		"keeps the installed hash if reinstalling fails": {
+			installed:        "abc",
+			current:          "def",
+			installErr:       slackerror.New(slackerror.ErrAppInstall),
+			expectedHash:     "abc",
+			expectedEvents:   []string{"on_cloud_run_watch_manifest_change", "on_cloud_run_watch_error"},
+			expectedInstalls: 1,
+		},
+		"restarts the SDK managed process when only code changes": {
+			installed:          "abc",
+			current:            "abc",
+			sdkManaged:         true,
+			expectedHash:       "abc",
+			expectedEvents:     []string{"on_cloud_run_watch_app_restart"},
+			expectedRestarting: true,
+		},
+		"skips the reinstall when the start hook runs for each event": {
+			installed:      "abc",
+			current:        "abc",
+			expectedHash:   "abc",
+			expectedEvents: []string{"on_cloud_run_watch_code_change"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			projectCache := cache.NewCacheMock()
+			projectCache.On("NewManifestHash", mock.Anything, mock.Anything).Return(tt.current, nil)
+			projectConfig := config.NewProjectConfigMock()
+			projectConfig.On("Cache").Return(projectCache)
+			clientsMock.Config.ProjectConfig = projectConfig
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+			manifest := &app.ManifestMockObject{}
+			manifest.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{}, nil)
+			clients.AppClient().Manifest = manifest
+
+			installs := 0
+			originalInstall := installLocalApp
+			installLocalApp = func(ctx context.Context, clients *shared.ClientFactory, orgGrantWorkspaceID string, log *logger.Logger, auth types.SlackAuth, app types.App) (types.App, api.DeveloperAppInstallResult, types.InstallState, error) {
+				installs++
+				return app, api.DeveloperAppInstallResult{}, types.InstallSuccess, tt.installErr
+			}
+			defer func() {
+				installLocalApp = originalInstall
+			}()
+
+			events := []string{}
+			log := logger.New(func(event *logger.LogEvent) {
+				events = append(events, event.Name)
+			})
+			server := LocalServer{
+				clients: clients,
+				log:     log,
+				restart: make(chan struct{}, 1),
+			}
+			server.cliConfig.Config.SDKManagedConnection = tt.sdkManaged
+
+			hash := server.reload(ctx, types.SlackAuth{}, types.App{AppID: "A123"}, tt.installed)
+			assert.Equal(t, tt.expectedHash, hash)
+			assert.Equal(t, tt.expectedEvents, events)
+			assert.Equal(t, tt.expectedInstalls, installs)
+			assert.Equal(t, tt.expectedRestarting, len(server.restart) == 1)
+		})
+	}
+}
+
+// closingHookExecutor counts the times hook servers are stopped
+type closingHookExecutor struct {
+	hooks.MockHookExecutor
+	closed int
+}
+
+func (e *closingHookExecutor) Close() error {
+	e.closed++
+	return nil
+}
+
+func Test_LocalServer_reload_stopsHookServers(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	projectCache := cache.NewCacheMock()
+	projectCache.On("NewManifestHash", mock.Anything, mock.Anything).Return(cache.Hash("abc"), nil)
+	projectConfig := config.NewProjectConfigMock()
+	projectConfig.On("Cache").Return(projectCache)
+	clientsMock.Config.ProjectConfig = projectConfig
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	manifest := &app.ManifestMockObject{}
+	manifest.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(types.SlackYaml{}, nil)
+	clients.AppClient().Manifest = manifest
+	executor := &closingHookExecutor{}
+	clients.HookExecutor = executor
+	server := LocalServer{
+		clients: clients,
+		log:     logger.New(func(event *logger.LogEvent) {}),
+		restart: make(chan struct{}, 1),
+	}
+
+	hash := server.reload(ctx, types.SlackAuth{}, types.App{AppID: "A123"}, "abc")
+	assert.Equal(t, cache.Hash("abc"), hash)
+	assert.Equal(t, 1, executor.closed)
+}
@@ -0,0 +1,154 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/otlp"
+	"github.com/toughtackle/slack-cli/internal/shared"
+)
+
+// otlpScope names the instrumentation of exported spans
+const otlpScope = "slack-cli.activity"
+
+// TraceSpans returns a span for each node of the trace. Steps and functions
+// are children of the span that holds them and every span of the trace shares
+// a trace ID derived from the Slack trace ID. Span IDs are derived from the
+// position, name, and times of a node so spans are not duplicated when a trace
+// is exported again.
+func TraceSpans(trace Trace) []otlp.Span {
+	traceID := otlp.TraceID(trace.ID)
+	spans := []otlp.Span{}
+	var walk func(nodes []*TraceNode, parentPath string, parentID string)
+	walk = func(nodes []*TraceNode, parentPath string, parentID string) {
+		for i, node := range nodes {
+			path := fmt.Sprintf("%s/%d", parentPath, i)
+			span := newTraceSpan(trace.ID, node)
+			span.TraceID = traceID
+			span.SpanID = otlp.SpanID(trace.ID, path, string(node.Kind), node.Name, fmt.Sprint(node.Started, node.Ended))
+			span.ParentSpanID = parentID
+			spans = append(spans, span)
+			walk(node.Children, path, span.SpanID)
+		}
+	}
+	walk(trace.Nodes, "", "")
+	return spans
+}
+
+// newTraceSpan returns the timing, details, and outcome of a node as a span.
+// Nodes missing a start or result are given the one time that is known.
+func newTraceSpan(traceID string, node *TraceNode) otlp.Span {
+	started, ended := node.Started, node.Ended
+	if started == 0 {
+		started = ended
+	}
+	if ended == 0 {
+		ended = started
+	}
+	span := otlp.Span{
+		Name:              node.Name,
+		Kind:              otlp.SpanKindInternal,
+		StartTimeUnixNano: otlp.UnixNano(started * 1000),
+		EndTimeUnixNano:   otlp.UnixNano(ended * 1000),
+		Attributes: []otlp.KeyValue{
+			otlp.Attribute("slack.trace_id", traceID),
+			otlp.Attribute("slack.kind", string(node.Kind)),
+		},
+	}
+	switch node.Kind {
+	case TraceWorkflow:
+		span.Attributes = append(span.Attributes, otlp.Attribute("slack.workflow.name", node.Name))
+	case TraceStep:
+		if node.Function != "" {
+			span.Attributes = append(span.Attributes, otlp.Attribute("slack.function.name", node.Function))
+		}
+	case TraceFunction:
+		span.Attributes = append(span.Attributes, otlp.Attribute("slack.function.name", node.Name))
+		if node.FunctionType != "" {
+			span.Attributes = append(span.Attributes, otlp.Attribute("slack.function.type", node.FunctionType))
+		}
+	}
+	if span.Name == "" {
+		span.Name = string(node.Kind)
+	}
+	for _, log := range node.Logs {
+		span.Events = append(span.Events, otlp.Event{
+			TimeUnixNano: span.StartTimeUnixNano,
+			Name:         "log",
+			Attributes:   []otlp.KeyValue{otlp.Attribute("message", log)},
+		})
+	}
+	switch node.Status {
+	case TraceCompleted:
+		span.Status = otlp.Status{Code: otlp.StatusOK}
+	case TraceFailed:
+		span.Status = otlp.Status{Code: otlp.StatusError, Message: node.Error}
+	}
+	return span
+}
+
+// newTraceExportRequest returns the spans of traces from an app
+func newTraceExportRequest(appID string, traces []Trace) otlp.ExportRequest {
+	spans := []otlp.Span{}
+	for _, trace := range traces {
+		spans = append(spans, TraceSpans(trace)...)
+	}
+	return otlp.ExportRequest{
+		ResourceSpans: []otlp.ResourceSpans{
+			{
+				Resource: otlp.Resource{Attributes: []otlp.KeyValue{
+					otlp.Attribute("service.name", appID),
+					otlp.Attribute("slack.app_id", appID),
+				}},
+				ScopeSpans: []otlp.ScopeSpans{
+					{Scope: otlp.Scope{Name: otlpScope}, Spans: spans},
+				},
+			},
+		},
+	}
+}
+
+// traceExporter holds tailed activity logs until the trace of each is complete
+// and then exports the trace as spans
+type traceExporter struct {
+	appID    string
+	groups   *traceGroups
+	exporter *otlp.Exporter
+}
+
+// newTraceExporter returns an exporter of app traces to the OTLP target
+func newTraceExporter(clients *shared.ClientFactory, appID string, target string) *traceExporter {
+	return &traceExporter{
+		appID:    appID,
+		groups:   newTraceGroups(),
+		exporter: otlp.NewExporter(clients.Fs, target),
+	}
+}
+
+// add holds the activity and exports the traces that are complete
+func (e *traceExporter) add(ctx context.Context, activity api.Activity) error {
+	if activity.TraceID == "" || !isTraceEvent(activity.EventType) {
+		return nil
+	}
+	return e.exporter.Export(ctx, newTraceExportRequest(e.appID, e.groups.add(activity)))
+}
+
+// flush exports every trace that is held even if it is not complete
+func (e *traceExporter) flush(ctx context.Context) error {
+	return e.exporter.Export(ctx, newTraceExportRequest(e.appID, e.groups.flush()))
+}
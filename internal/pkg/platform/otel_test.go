@@ -0,0 +1,99 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/otlp"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TraceSpans(t *testing.T) {
+	spans := TraceSpans(BuildTrace("Tr001", mockTraceActivities("Tr001")))
+	require.Len(t, spans, 5)
+	byName := map[string]otlp.Span{}
+	for _, span := range spans {
+		assert.Equal(t, otlp.TraceID("Tr001"), span.TraceID)
+		assert.Contains(t, span.Attributes, otlp.Attribute("slack.trace_id", "Tr001"))
+		byName[span.Name] = span
+	}
+
+	workflow := byName["Greet"]
+	assert.Empty(t, workflow.ParentSpanID)
+	assert.Equal(t, otlp.UnixNano(1000*1000), workflow.StartTimeUnixNano)
+	assert.Equal(t, otlp.UnixNano(1900*1000), workflow.EndTimeUnixNano)
+	assert.Equal(t, otlp.Status{Code: otlp.StatusError, Message: "step failed"}, workflow.Status)
+
+	first := byName["Step 1 of 2"]
+	assert.Equal(t, workflow.SpanID, first.ParentSpanID)
+	assert.Equal(t, otlp.Status{Code: otlp.StatusOK}, first.Status)
+	assert.Equal(t, first.SpanID, byName["send_message"].ParentSpanID)
+	assert.Contains(t, byName["send_message"].Attributes, otlp.Attribute("slack.function.type", "builtin"))
+
+	function := byName["update_sheet"]
+	assert.Equal(t, byName["Step 2 of 2"].SpanID, function.ParentSpanID)
+	assert.Equal(t, otlp.Status{Code: otlp.StatusError, Message: "sheet not found"}, function.Status)
+	require.Len(t, function.Events, 1)
+	assert.Equal(t, []otlp.KeyValue{otlp.Attribute("message", "fetching rows")}, function.Events[0].Attributes)
+}
+
+func TestActivity_OTLP(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	ctx = config.SetContextToken(ctx, "xoxp-example")
+	requests := []otlp.ExportRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request otlp.ExportRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+	}))
+	defer server.Close()
+
+	// Logs are returned from newest to oldest
+	activities := append(mockTraceActivities("Tr001"),
+		api.Activity{TraceID: "Tr002", Level: types.INFO, EventType: types.TriggerExecuted, Created: 2000},
+	)
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Created > activities[j].Created
+	})
+	clientsMock := shared.NewClientsMock()
+	clientsMock.APIInterface.On("ValidateSession", mock.Anything, mock.Anything).Return(api.AuthSession{}, nil)
+	clientsMock.APIInterface.On("Activity", mock.Anything, mock.Anything, mock.Anything).Return(api.ActivityResult{
+		Activities: activities,
+	}, nil)
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+	err := Activity(ctx, clients, &logger.Logger{}, types.ActivityArgs{AppID: "A001", OTLP: server.URL})
+	require.NoError(t, err)
+	require.Len(t, requests, 2, "the complete trace is exported as logs arrive and the rest on return")
+	require.Len(t, requests[0].ResourceSpans, 1)
+	assert.Contains(t, requests[0].ResourceSpans[0].Resource.Attributes, otlp.Attribute("slack.app_id", "A001"))
+	assert.Len(t, requests[0].ResourceSpans[0].ScopeSpans[0].Spans, 5)
+	assert.Equal(t, "Other", requests[1].ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+	assert.Contains(t, clientsMock.GetStdoutOutput(), "Workflow 'Greet' failed", "logs are still printed")
+}
@@ -0,0 +1,124 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/socketsession"
+)
+
+// ReplayArgs are the arguments passed into the Replay function
+type ReplayArgs struct {
+	// Session is the path of a session file recorded during a local run
+	Session string
+}
+
+// ReplayResult compares the response to a replayed envelope with the recorded
+// response
+type ReplayResult struct {
+	Type              string          `json:"type"`
+	EnvelopeID        string          `json:"envelope_id,omitempty"`
+	Response          json.RawMessage `json:"response,omitempty"`
+	Error             string          `json:"error,omitempty"`
+	LatencyMs         int64           `json:"latency_ms"`
+	RecordedLatencyMs int64           `json:"recorded_latency_ms"`
+	// Diff has a line for each value of the response that changed
+	Diff []string `json:"diff"`
+}
+
+// Matched returns if the replayed response is the same as the recorded one
+func (r ReplayResult) Matched() bool {
+	return len(r.Diff) == 0
+}
+
+// Replay feeds the envelopes of a recorded session through the start hook in
+// order without connecting to Slack and compares each response with the
+// recorded response
+func Replay(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger, args ReplayArgs) ([]ReplayResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.platform.replay")
+	defer span.Finish()
+
+	envelopes, err := socketsession.ReadSession(clients.Fs, args.Session)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := clients.SDKConfig.Hooks.Start.Get(); err != nil {
+		return nil, err
+	}
+	variables, err := clients.Config.GetDotEnvFileVariables()
+	if err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrSocketSession).
+			WithMessage("Failed to read the local .env file")
+	}
+	server := LocalServer{
+		clients:            clients,
+		log:                log,
+		localHostedContext: LocalHostedContext{Variables: variables},
+		cliConfig:          clients.SDKConfig,
+	}
+	results := []ReplayResult{}
+	for _, envelope := range envelopes {
+		result, err := server.replayEnvelope(ctx, envelope)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// replayEnvelope pipes a recorded envelope through the start hook and diffs
+// the response
+func (r *LocalServer) replayEnvelope(ctx context.Context, envelope socketsession.Envelope) (ReplayResult, error) {
+	body, err := json.Marshal(SocketEvent{
+		Body:    envelope.Payload,
+		Context: r.localHostedContext,
+	})
+	if err != nil {
+		return ReplayResult{}, slackerror.Wrap(err, slackerror.ErrSocketSession)
+	}
+	result := ReplayResult{
+		Type:              envelope.Type,
+		EnvelopeID:        envelope.EnvelopeID,
+		RecordedLatencyMs: envelope.LatencyMs,
+		Diff:              []string{},
+	}
+	started := time.Now()
+	out, err := r.clients.HookExecutor.Execute(ctx, r.startHookOpts(body, io.Discard))
+	result.LatencyMs = time.Since(started).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Response = json.RawMessage(out)
+	}
+	switch {
+	case envelope.Error == "" && result.Error != "":
+		result.Diff = append(result.Diff, fmt.Sprintf("+ error: %s", result.Error))
+	case envelope.Error != "" && result.Error == "":
+		result.Diff = append(result.Diff, fmt.Sprintf("- error: %s", envelope.Error))
+	case envelope.Error == "":
+		result.Diff = socketsession.Diff(envelope.Response, result.Response)
+	}
+	return result, nil
+}
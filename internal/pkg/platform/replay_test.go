@@ -0,0 +1,103 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/socketsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// replayExecutor responds to each event with the next response
+type replayExecutor struct {
+	responses []string
+	errors    []error
+	events    []SocketEvent
+}
+
+func (e *replayExecutor) Execute(ctx context.Context, opts hooks.HookExecOpts) (string, error) {
+	input, err := io.ReadAll(opts.Stdin)
+	if err != nil {
+		return "", err
+	}
+	var event SocketEvent
+	if err := json.Unmarshal(input, &event); err != nil {
+		return "", err
+	}
+	index := len(e.events)
+	e.events = append(e.events, event)
+	return e.responses[index], e.errors[index]
+}
+
+func Test_Platform_Replay(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	clients.SDKConfig.Hooks.Start = hooks.HookScript{Name: "Start", Command: "deno run start.ts"}
+	executor := &replayExecutor{
+		responses: []string{`{"text":"hi"}`, `{"text":"bye"}`, ``},
+		errors:    []error{nil, nil, slackerror.New(slackerror.ErrSDKHookInvocationFailed)},
+	}
+	clients.HookExecutor = executor
+
+	recorder, err := socketsession.NewRecorder(clients.Fs, "session.jsonl")
+	require.NoError(t, err)
+	for _, envelope := range []socketsession.Envelope{
+		{Type: "events_api", EnvelopeID: "E1", Payload: json.RawMessage(`{"n":1}`), Response: json.RawMessage(`{"text":"hi"}`), LatencyMs: 5},
+		{Type: "events_api", EnvelopeID: "E2", Payload: json.RawMessage(`{"n":2}`), Response: json.RawMessage(`{"text":"hello"}`)},
+		{Type: "interactive", EnvelopeID: "E3", Payload: json.RawMessage(`{"n":3}`), Response: json.RawMessage(`{}`)},
+	} {
+		require.NoError(t, recorder.Record(envelope))
+	}
+
+	log := logger.Logger{Data: map[string]interface{}{}}
+	results, err := Replay(ctx, clients, &log, ReplayArgs{Session: "session.jsonl"})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Len(t, executor.events, 3)
+	assert.JSONEq(t, `{"n":1}`, string(executor.events[0].Body))
+
+	assert.True(t, results[0].Matched())
+	assert.Equal(t, int64(5), results[0].RecordedLatencyMs)
+	assert.False(t, results[1].Matched())
+	assert.Equal(t, []string{`- text: "hello"`, `+ text: "bye"`}, results[1].Diff)
+	assert.False(t, results[2].Matched())
+	assert.NotEmpty(t, results[2].Error)
+	assert.Contains(t, results[2].Diff[0], "+ error: ")
+}
+
+func Test_Platform_Replay_Errors(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	clients.SDKConfig.Hooks.Start = hooks.HookScript{Name: "Start", Command: "deno run start.ts"}
+
+	log := logger.Logger{Data: map[string]interface{}{}}
+	_, err := Replay(ctx, clients, &log, ReplayArgs{Session: "missing.jsonl"})
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrSocketSession, slackerror.ToSlackError(err).Code)
+}
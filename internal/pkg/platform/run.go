@@ -17,6 +17,7 @@ package platform
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
@@ -25,10 +26,14 @@ import (
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/logger"
 	"github.com/toughtackle/slack-cli/internal/pkg/apps"
+	"github.com/toughtackle/slack-cli/internal/pkg/datastore"
+	"github.com/toughtackle/slack-cli/internal/runtime/python"
 	"github.com/toughtackle/slack-cli/internal/shared"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
 	"github.com/toughtackle/slack-cli/internal/slacktrace"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/toughtackle/slack-cli/internal/socketsession"
 )
 
 // RunArgs are the arguments passed into the Run function
@@ -38,8 +43,11 @@ type RunArgs struct {
 	App                 types.App
 	Auth                types.SlackAuth
 	Cleanup             bool
+	DatastoreEmulator   bool
 	ShowTriggers        bool
 	OrgGrantWorkspaceID string
+	RecordDir           string
+	RecordSession       string
 }
 
 // Run locally runs your app.
@@ -76,6 +84,16 @@ func Run(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger,
 		return nil, "", err
 	}
 
+	// Install Python dependencies that changed since the virtual environment was
+	// last updated so the "start" hook runs with the latest packages
+	if _, ok := clients.Runtime.(*python.Python); ok && python.IsVirtualEnvStale(clients.Fs, cliConfig.WorkingDirectory) {
+		output, err := clients.Runtime.InstallProjectDependencies(ctx, cliConfig.WorkingDirectory, clients.HookExecutor, clients.IO, clients.Fs, clients.Os)
+		if err != nil {
+			return nil, "", slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+		}
+		clients.IO.PrintInfo(ctx, false, "%s", style.Secondary(output))
+	}
+
 	// Update local install
 	installedApp, localInstallResult, installState, err := apps.InstallLocalApp(ctx, clients, runArgs.OrgGrantWorkspaceID, log, runArgs.Auth, runArgs.App)
 	if err != nil {
@@ -114,6 +132,22 @@ func Run(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger,
 		variables["SLACK_API_URL"] = fmt.Sprintf("%s/api/", clients.Config.APIHostResolved)
 	}
 
+	// Answer datastore requests from the app with a local emulator
+	if runArgs.DatastoreEmulator {
+		emulator, err := datastore.NewEmulator(ctx, clients)
+		if err != nil {
+			return nil, "", slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+		}
+		emulatorURL, err := datastore.ServeEmulator(ctx, clients, emulator)
+		if err != nil {
+			return nil, "", slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+		}
+		variables["SLACK_API_URL"] = emulatorURL
+		log.Data["datastoreEmulatorURL"] = emulatorURL
+		log.Data["datastoreEmulatorDir"] = emulator.Dir()
+		log.Info("on_datastore_emulator_started")
+	}
+
 	var localHostedContext = LocalHostedContext{
 		BotAccessToken: localInstallResult.APIAccessTokens.Bot,
 		AppID:          installedApp.AppID,
@@ -121,6 +155,25 @@ func Run(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger,
 		Variables:      variables,
 	}
 
+	// Record events relative to the project unless the path is absolute
+	recordDir := runArgs.RecordDir
+	if recordDir != "" && !filepath.IsAbs(recordDir) {
+		recordDir = filepath.Join(clients.SDKConfig.WorkingDirectory, recordDir)
+	}
+
+	var session *socketsession.Recorder
+	if runArgs.RecordSession != "" {
+		sessionPath := runArgs.RecordSession
+		if !filepath.IsAbs(sessionPath) {
+			sessionPath = filepath.Join(clients.SDKConfig.WorkingDirectory, sessionPath)
+		}
+		session, err = socketsession.NewRecorder(clients.Fs, sessionPath)
+		if err != nil {
+			return nil, "", slackerror.Wrap(err, slackerror.ErrLocalAppRun)
+		}
+		clients.IO.PrintDebug(ctx, "Recording socket envelopes to %s", session.Path())
+	}
+
 	var server = LocalServer{
 		clients:            clients,
 		log:                log,
@@ -128,6 +181,9 @@ func Run(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger,
 		localHostedContext: localHostedContext,
 		cliConfig:          cliConfig,
 		Connection:         nil,
+		recordDir:          recordDir,
+		session:            session,
+		restart:            make(chan struct{}, 1),
 	}
 
 	// Once the "run" command completes, delete the app if the --cleanup flag is
@@ -181,6 +237,9 @@ func Run(ctx context.Context, clients *shared.ClientFactory, log *logger.Logger,
 	// If so Delegate the connection to the SDK otherwise Start connection
 	if cliConfig.Config.SDKManagedConnection {
 		clients.IO.PrintDebug(ctx, "Delegating connection to SDK managed script hook")
+		if runArgs.RecordDir != "" || runArgs.RecordSession != "" {
+			clients.IO.PrintWarning(ctx, "Events are not recorded when the SDK manages the connection")
+		}
 		// Delegate connection to hook; this should be a blocking call, as the delegate should be a server, too.
 		go func() {
 			errChan <- server.StartDelegate(ctx)
@@ -0,0 +1,71 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/activitylog"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/style"
+)
+
+// newActivityStore returns the store of activity logs saved in the project
+func newActivityStore(clients *shared.ClientFactory) *activitylog.Store {
+	dir := filepath.Join(clients.SDKConfig.WorkingDirectory, config.ProjectConfigDirName, activitylog.DirName)
+	return activitylog.NewStore(clients.Fs, dir)
+}
+
+// ActivitySearch outputs activity logs saved in the project that match the
+// filters of args without calling the API
+func ActivitySearch(ctx context.Context, clients *shared.ClientFactory, args types.ActivityArgs) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "cmd.activity.search")
+	defer span.Finish()
+
+	store := newActivityStore(clients)
+	records, err := store.Search(activitylog.Filter{
+		AppID:         args.AppID,
+		MinLevel:      types.ActivityLevel(args.MinLevel),
+		EventType:     args.EventType,
+		ComponentType: args.ComponentType,
+		ComponentID:   args.ComponentID,
+		Source:        args.Source,
+		TraceID:       args.TraceID,
+		MinCreated:    args.MinDateCreated,
+		MaxCreated:    args.MaxDateCreated,
+		Limit:         args.Limit,
+	})
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 && !clients.IO.IsStructuredOutput() {
+		clients.IO.PrintInfo(ctx, false, "%s", style.Secondary("No saved activity logs match the search"))
+		return nil
+	}
+	for _, record := range records {
+		if clients.IO.IsStructuredOutput() {
+			if err := clients.IO.PrintStructuredLine(ctx, newActivityOutput(record.Activity)); err != nil {
+				return err
+			}
+			continue
+		}
+		clients.IO.PrintInfo(ctx, false, "%s", prettifyActivity(record.Activity))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/logger"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivitySearch(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	ctx = config.SetContextToken(ctx, "xoxp-example")
+	clientsMock := shared.NewClientsMock()
+	clientsMock.APIInterface.On("ValidateSession", mock.Anything, mock.Anything).Return(api.AuthSession{}, nil)
+	clientsMock.APIInterface.On("Activity", mock.Anything, mock.Anything, mock.Anything).Return(api.ActivityResult{
+		Activities: []api.Activity{
+			{TraceID: "Tr001", Level: types.INFO, EventType: types.FunctionExecutionStarted, ComponentID: "Fn001", Created: 1686939542000000, Payload: map[string]interface{}{"function_name": "greet", "function_type": "app"}},
+			{TraceID: "Tr002", Level: types.ERROR, EventType: types.FunctionExecutionResult, ComponentID: "Fn002", Created: 1686939543000000, Payload: map[string]interface{}{"function_name": "update", "function_type": "app"}},
+		},
+	}, nil)
+	clientsMock.AddDefaultMocks()
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	clients.SDKConfig.WorkingDirectory = "/project"
+
+	err := Activity(ctx, clients, &logger.Logger{}, types.ActivityArgs{AppID: "A001", Save: true})
+	require.NoError(t, err)
+	clientsMock.APIInterface.AssertNumberOfCalls(t, "Activity", 1)
+
+	err = ActivitySearch(ctx, clients, types.ActivityArgs{AppID: "A001", MinLevel: "error"})
+	require.NoError(t, err)
+	clientsMock.APIInterface.AssertNumberOfCalls(t, "Activity", 1)
+	output := clientsMock.GetStdoutOutput()
+	assert.Contains(t, output, "Function 'update' (app function) failed")
+
+	err = ActivitySearch(ctx, clients, types.ActivityArgs{AppID: "A002"})
+	require.NoError(t, err)
+	assert.Contains(t, clientsMock.GetStdoutOutput(), "No saved activity logs match the search")
+}
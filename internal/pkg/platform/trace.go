@@ -0,0 +1,366 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+)
+
+// TraceNodeKind is the part of an execution that a trace node represents
+type TraceNodeKind string
+
+const (
+	TraceWorkflow TraceNodeKind = "workflow"
+	TraceStep     TraceNodeKind = "step"
+	TraceFunction TraceNodeKind = "function"
+)
+
+// TraceStatus is the outcome of a part of an execution
+type TraceStatus string
+
+const (
+	TraceRunning   TraceStatus = "running"
+	TraceCompleted TraceStatus = "completed"
+	TraceFailed    TraceStatus = "failed"
+)
+
+// TraceNode is a workflow, a step of a workflow, or a function that a step
+// calls. Times are unix timestamps in microseconds and Ended is zero until a
+// result is logged.
+type TraceNode struct {
+	Kind   TraceNodeKind `json:"kind"`
+	Name   string        `json:"name"`
+	Status TraceStatus   `json:"status"`
+	// Function is the name of the function a step calls
+	Function string `json:"function,omitempty"`
+	// FunctionType is the kind of function that was called
+	FunctionType string       `json:"function_type,omitempty"`
+	Started      int64        `json:"started,omitempty"`
+	Ended        int64        `json:"ended,omitempty"`
+	Error        string       `json:"error,omitempty"`
+	Logs         []string     `json:"logs,omitempty"`
+	Children     []*TraceNode `json:"children"`
+}
+
+// Duration returns the time between the start and result of the node
+func (n *TraceNode) Duration() (time.Duration, bool) {
+	if n.Started == 0 || n.Ended == 0 || n.Ended < n.Started {
+		return 0, false
+	}
+	return time.Duration(n.Ended-n.Started) * time.Microsecond, true
+}
+
+// Trace is the execution tree assembled from the activity logs of a trace
+type Trace struct {
+	ID    string       `json:"trace_id"`
+	Nodes []*TraceNode `json:"nodes"`
+}
+
+// Complete returns true if every workflow and function of the trace has a
+// result
+func (t Trace) Complete() bool {
+	if len(t.Nodes) == 0 {
+		return false
+	}
+	for _, node := range t.Nodes {
+		if node.Status == TraceRunning {
+			return false
+		}
+	}
+	return true
+}
+
+// BuildTrace assembles activity logs of a trace into a tree of workflows, the
+// steps of each workflow, and the functions each step calls. Activities are
+// ordered by time before being assembled and activities of other traces are
+// ignored.
+func BuildTrace(traceID string, activities []api.Activity) Trace {
+	ordered := []api.Activity{}
+	for _, activity := range activities {
+		if activity.TraceID == traceID {
+			ordered = append(ordered, activity)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Created < ordered[j].Created
+	})
+
+	trace := Trace{ID: traceID, Nodes: []*TraceNode{}}
+	var workflow, step, function *TraceNode
+	add := func(node *TraceNode) {
+		switch {
+		case node.Kind == TraceFunction && step != nil:
+			step.Children = append(step.Children, node)
+		case node.Kind != TraceWorkflow && workflow != nil:
+			workflow.Children = append(workflow.Children, node)
+		default:
+			trace.Nodes = append(trace.Nodes, node)
+		}
+	}
+	for _, activity := range ordered {
+		switch activity.EventType {
+		case types.WorkflowExecutionStarted:
+			workflow = newTraceNode(TraceWorkflow, payloadString(activity, "workflow_name"), activity)
+			step, function = nil, nil
+			add(workflow)
+		case types.WorkflowExecutionResult:
+			if workflow == nil {
+				workflow = newTraceNode(TraceWorkflow, payloadString(activity, "workflow_name"), activity)
+				workflow.Started = 0
+				add(workflow)
+			}
+			closeTraceNode(workflow, activity)
+			workflow, step, function = nil, nil, nil
+		case types.WorkflowStepStarted:
+			step = newTraceNode(TraceStep, "", activity)
+			current, _ := activity.Payload["current_step"].(float64)
+			total, _ := activity.Payload["total_steps"].(float64)
+			step.Name = fmt.Sprintf("Step %.0f of %.0f", current, total)
+			function = nil
+			add(step)
+		case types.WorkflowStepExecutionResult:
+			if step == nil {
+				step = newTraceNode(TraceStep, "Step", activity)
+				step.Started = 0
+				add(step)
+			}
+			step.Function = payloadString(activity, "function_name")
+			closeTraceNode(step, activity)
+			step, function = nil, nil
+		case types.FunctionExecutionStarted:
+			function = newTraceNode(TraceFunction, payloadString(activity, "function_name"), activity)
+			function.FunctionType = payloadString(activity, "function_type")
+			add(function)
+		case types.FunctionExecutionResult:
+			if function == nil || function.Name != payloadString(activity, "function_name") {
+				function = newTraceNode(TraceFunction, payloadString(activity, "function_name"), activity)
+				function.Started = 0
+				add(function)
+			}
+			closeTraceNode(function, activity)
+			function = nil
+		case types.FunctionExecutionOutput:
+			if function != nil {
+				function.Logs = append(function.Logs, fmt.Sprint(activity.Payload["log"]))
+			}
+		}
+	}
+	return trace
+}
+
+// newTraceNode returns a running node that started with the activity
+func newTraceNode(kind TraceNodeKind, name string, activity api.Activity) *TraceNode {
+	return &TraceNode{
+		Kind:     kind,
+		Name:     name,
+		Status:   TraceRunning,
+		Started:  activity.Created,
+		Children: []*TraceNode{},
+	}
+}
+
+// closeTraceNode sets the result of a node from the activity
+func closeTraceNode(node *TraceNode, activity api.Activity) {
+	node.Ended = activity.Created
+	node.Status = TraceCompleted
+	switch activity.Level {
+	case types.ERROR, types.FATAL:
+		node.Status = TraceFailed
+	}
+	if err := payloadString(activity, "error"); err != "" {
+		node.Error = err
+	}
+}
+
+// isTraceEvent returns true if the event is part of an execution tree
+func isTraceEvent(eventType types.EventType) bool {
+	switch eventType {
+	case types.WorkflowExecutionStarted, types.WorkflowExecutionResult,
+		types.WorkflowStepStarted, types.WorkflowStepExecutionResult,
+		types.FunctionExecutionStarted, types.FunctionExecutionResult,
+		types.FunctionExecutionOutput:
+		return true
+	}
+	return false
+}
+
+// payloadString returns a text value of the activity payload
+func payloadString(activity api.Activity, key string) string {
+	value, ok := activity.Payload[key].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+// FormatTrace returns the trace as an indented tree with the durations and
+// status of each part. Failures are highlighted.
+func FormatTrace(trace Trace) string {
+	lines := []string{style.Bold(fmt.Sprintf("Trace %s", trace.ID))}
+	if len(trace.Nodes) == 0 {
+		lines = append(lines, style.Indent(style.Secondary("No workflow or function executions were found for this trace")))
+	}
+	var format func(nodes []*TraceNode, depth int)
+	format = func(nodes []*TraceNode, depth int) {
+		indent := strings.Repeat("  ", depth)
+		for _, node := range nodes {
+			lines = append(lines, indent+formatTraceNode(node))
+			if node.Error != "" {
+				for _, line := range strings.Split(node.Error, "\n") {
+					lines = append(lines, indent+"    "+style.Styler().Red(line).String())
+				}
+			}
+			for _, log := range node.Logs {
+				for _, line := range strings.Split(log, "\n") {
+					lines = append(lines, indent+"    "+style.Secondary(line))
+				}
+			}
+			format(node.Children, depth+1)
+		}
+	}
+	format(trace.Nodes, 1)
+	return strings.Join(lines, "\n")
+}
+
+// formatTraceNode returns a line that describes a node
+func formatTraceNode(node *TraceNode) string {
+	var text string
+	switch node.Kind {
+	case TraceWorkflow:
+		text = fmt.Sprintf("Workflow '%s'", node.Name)
+	case TraceStep:
+		text = node.Name
+		if node.Function != "" {
+			text = fmt.Sprintf("%s '%s'", text, node.Function)
+		}
+	default:
+		text = fmt.Sprintf("Function '%s'", node.Name)
+		if node.FunctionType != "" {
+			text = fmt.Sprintf("%s (%s function)", text, node.FunctionType)
+		}
+	}
+	text = fmt.Sprintf("%s %s", text, node.Status)
+	if duration, ok := node.Duration(); ok {
+		text = fmt.Sprintf("%s %s", text, style.Secondary(fmt.Sprintf("(%s)", duration)))
+	}
+	switch node.Status {
+	case TraceFailed:
+		if node.Kind == TraceStep {
+			return style.Styler().Red(style.Bold("✗ " + text)).String()
+		}
+		return style.Styler().Red(text).String()
+	case TraceRunning:
+		return style.Styler().Yellow(text).String()
+	}
+	return text
+}
+
+// ActivityTrace prints the execution tree of a single trace
+func ActivityTrace(ctx context.Context, clients *shared.ClientFactory, args types.ActivityArgs) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "cmd.activity.trace")
+	defer span.Finish()
+
+	var token = config.GetContextToken(ctx)
+	if strings.TrimSpace(token) == "" {
+		return slackerror.New(slackerror.ErrAuthToken)
+	}
+	if strings.TrimSpace(args.TraceID) == "" {
+		return slackerror.New(slackerror.ErrMissingFlag).
+			WithMessage("A trace ID is required to show a trace")
+	}
+
+	request := types.ActivityRequest{
+		AppID:           args.AppID,
+		Limit:           args.Limit,
+		MinimumLogLevel: args.MinLevel,
+		TraceID:         args.TraceID,
+	}
+	activities := []api.Activity{}
+	for {
+		result, err := clients.APIInterface().Activity(ctx, token, request)
+		if err != nil {
+			return err
+		}
+		activities = append(activities, result.Activities...)
+		if result.NextCursor == "" || len(result.Activities) == 0 {
+			break
+		}
+		request.NextCursor = result.NextCursor
+	}
+	trace := BuildTrace(args.TraceID, activities)
+	if clients.IO.IsStructuredOutput() {
+		return clients.IO.PrintStructured(ctx, trace)
+	}
+	clients.IO.PrintInfo(ctx, false, "%s", FormatTrace(trace))
+	return nil
+}
+
+// traceGroups holds tailed activity logs until the trace of each is complete
+type traceGroups struct {
+	order   []string
+	pending map[string][]api.Activity
+}
+
+// newTraceGroups returns empty trace groups
+func newTraceGroups() *traceGroups {
+	return &traceGroups{pending: map[string][]api.Activity{}}
+}
+
+// add holds the activity with others of the trace and returns the traces that
+// are complete
+func (g *traceGroups) add(activities ...api.Activity) []Trace {
+	for _, activity := range activities {
+		if _, ok := g.pending[activity.TraceID]; !ok {
+			g.order = append(g.order, activity.TraceID)
+		}
+		g.pending[activity.TraceID] = append(g.pending[activity.TraceID], activity)
+	}
+	complete := []Trace{}
+	remaining := []string{}
+	for _, traceID := range g.order {
+		trace := BuildTrace(traceID, g.pending[traceID])
+		if trace.Complete() {
+			complete = append(complete, trace)
+			delete(g.pending, traceID)
+			continue
+		}
+		remaining = append(remaining, traceID)
+	}
+	g.order = remaining
+	return complete
+}
+
+// flush returns every trace that is held even if it is not complete
+func (g *traceGroups) flush() []Trace {
+	traces := []Trace{}
+	for _, traceID := range g.order {
+		traces = append(traces, BuildTrace(traceID, g.pending[traceID]))
+	}
+	g.order = []string{}
+	g.pending = map[string][]api.Activity{}
+	return traces
+}
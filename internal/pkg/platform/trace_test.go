@@ -0,0 +1,122 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTraceActivities returns the logs of a two step workflow where the
+// second step fails
+func mockTraceActivities(traceID string) []api.Activity {
+	return []api.Activity{
+		{TraceID: traceID, Level: types.ERROR, EventType: types.WorkflowExecutionResult, Created: 1900, Payload: map[string]interface{}{"workflow_name": "Greet", "error": "step failed"}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.WorkflowExecutionStarted, Created: 1000, Payload: map[string]interface{}{"workflow_name": "Greet"}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.WorkflowStepStarted, Created: 1100, Payload: map[string]interface{}{"current_step": float64(1), "total_steps": float64(2)}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.FunctionExecutionStarted, Created: 1150, Payload: map[string]interface{}{"function_name": "send_message", "function_type": "builtin"}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.FunctionExecutionResult, Created: 1250, Payload: map[string]interface{}{"function_name": "send_message", "function_type": "builtin"}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.WorkflowStepExecutionResult, Created: 1300, Payload: map[string]interface{}{"function_name": "send_message"}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.WorkflowStepStarted, Created: 1400, Payload: map[string]interface{}{"current_step": float64(2), "total_steps": float64(2)}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.FunctionExecutionStarted, Created: 1450, Payload: map[string]interface{}{"function_name": "update_sheet", "function_type": "app"}},
+		{TraceID: traceID, Level: types.INFO, EventType: types.FunctionExecutionOutput, Created: 1500, Payload: map[string]interface{}{"log": "fetching rows"}},
+		{TraceID: traceID, Level: types.ERROR, EventType: types.FunctionExecutionResult, Created: 1700, Payload: map[string]interface{}{"function_name": "update_sheet", "function_type": "app", "error": "sheet not found"}},
+		{TraceID: traceID, Level: types.ERROR, EventType: types.WorkflowStepExecutionResult, Created: 1800, Payload: map[string]interface{}{"function_name": "update_sheet"}},
+		{TraceID: "Tr_other", Level: types.INFO, EventType: types.WorkflowExecutionStarted, Created: 1000, Payload: map[string]interface{}{"workflow_name": "Other"}},
+	}
+}
+
+func Test_BuildTrace(t *testing.T) {
+	trace := BuildTrace("Tr001", mockTraceActivities("Tr001"))
+	assert.Equal(t, "Tr001", trace.ID)
+	assert.True(t, trace.Complete())
+	require.Len(t, trace.Nodes, 1)
+
+	workflow := trace.Nodes[0]
+	assert.Equal(t, TraceWorkflow, workflow.Kind)
+	assert.Equal(t, "Greet", workflow.Name)
+	assert.Equal(t, TraceFailed, workflow.Status)
+	assert.Equal(t, "step failed", workflow.Error)
+	duration, ok := workflow.Duration()
+	assert.True(t, ok)
+	assert.Equal(t, 900*time.Microsecond, duration)
+	require.Len(t, workflow.Children, 2)
+
+	first := workflow.Children[0]
+	assert.Equal(t, TraceStep, first.Kind)
+	assert.Equal(t, "Step 1 of 2", first.Name)
+	assert.Equal(t, "send_message", first.Function)
+	assert.Equal(t, TraceCompleted, first.Status)
+	require.Len(t, first.Children, 1)
+	assert.Equal(t, "builtin", first.Children[0].FunctionType)
+	assert.Equal(t, TraceCompleted, first.Children[0].Status)
+
+	second := workflow.Children[1]
+	assert.Equal(t, "Step 2 of 2", second.Name)
+	assert.Equal(t, TraceFailed, second.Status)
+	require.Len(t, second.Children, 1)
+	function := second.Children[0]
+	assert.Equal(t, "update_sheet", function.Name)
+	assert.Equal(t, TraceFailed, function.Status)
+	assert.Equal(t, "sheet not found", function.Error)
+	assert.Equal(t, []string{"fetching rows"}, function.Logs)
+}
+
+func Test_BuildTrace_Running(t *testing.T) {
+	activities := mockTraceActivities("Tr002")[1:4]
+	trace := BuildTrace("Tr002", activities)
+	assert.False(t, trace.Complete())
+	require.Len(t, trace.Nodes, 1)
+	assert.Equal(t, TraceRunning, trace.Nodes[0].Status)
+	_, ok := trace.Nodes[0].Duration()
+	assert.False(t, ok)
+}
+
+func Test_FormatTrace(t *testing.T) {
+	output := FormatTrace(BuildTrace("Tr003", mockTraceActivities("Tr003")))
+	assert.Contains(t, output, "Trace Tr003")
+	assert.Contains(t, output, "Workflow 'Greet' failed")
+	assert.Contains(t, output, "Step 1 of 2 'send_message' completed")
+	assert.Contains(t, output, "Function 'send_message' (builtin function) completed")
+	assert.Contains(t, output, "✗ Step 2 of 2 'update_sheet' failed")
+	assert.Contains(t, output, "sheet not found")
+	assert.Contains(t, output, "fetching rows")
+	assert.Contains(t, output, "(100µs)")
+
+	empty := FormatTrace(BuildTrace("Tr004", []api.Activity{}))
+	assert.Contains(t, empty, "No workflow or function executions were found for this trace")
+}
+
+func Test_traceGroups(t *testing.T) {
+	groups := newTraceGroups()
+	activities := mockTraceActivities("Tr005")
+	complete := groups.add(activities[1:5]...)
+	assert.Empty(t, complete)
+	complete = groups.add(activities[5:]...)
+	assert.Empty(t, complete, "the workflow result was not yet received")
+	complete = groups.add(activities[0])
+	require.Len(t, complete, 1)
+	assert.Equal(t, "Tr005", complete[0].ID)
+
+	remaining := groups.flush()
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "Tr_other", remaining[0].ID)
+	assert.Empty(t, groups.flush())
+}
@@ -0,0 +1,127 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// referencePattern matches references to workflow inputs and step outputs such
+// as {{inputs.channel}} or {{steps.0.message_ts}}
+var referencePattern = regexp.MustCompile(`\{\{\s*(inputs|steps)\.([^}]*?)\s*\}\}`)
+
+// scope holds the values that step inputs can reference
+type scope struct {
+	inputs map[string]interface{}
+	steps  map[string]map[string]interface{}
+}
+
+// resolve replaces references in the value with workflow inputs and the
+// outputs of earlier steps. A string that is only a reference is replaced with
+// the referenced value as is and references within text are written as text.
+func (s scope) resolve(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case string:
+		if match := referencePattern.FindStringSubmatch(value); match != nil && match[0] == strings.TrimSpace(value) {
+			return s.lookup(match[1], match[2])
+		}
+		var err error
+		resolved := referencePattern.ReplaceAllStringFunc(value, func(reference string) string {
+			match := referencePattern.FindStringSubmatch(reference)
+			found, lookupErr := s.lookup(match[1], match[2])
+			if lookupErr != nil {
+				err = lookupErr
+				return reference
+			}
+			return referenceText(found)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resolved, nil
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			found, err := s.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = found
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(value))
+		for i, item := range value {
+			found, err := s.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = found
+		}
+		return resolved, nil
+	default:
+		return value, nil
+	}
+}
+
+// lookup returns the value at a path of workflow inputs or step outputs. Paths
+// of step outputs start with the step ID and paths can continue into objects.
+func (s scope) lookup(root string, path string) (interface{}, error) {
+	parts := strings.Split(path, ".")
+	var current interface{}
+	switch root {
+	case "inputs":
+		if _, ok := s.inputs[parts[0]]; !ok {
+			// Optional inputs that are not provided have no value
+			return nil, nil
+		}
+		current = s.inputs
+	case "steps":
+		outputs, ok := s.steps[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("step \"%s\" has not run before this step", parts[0])
+		}
+		current = outputs
+		parts = parts[1:]
+	}
+	for _, part := range parts {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("\"%s.%s\" is not a value", root, path)
+		}
+		if current, ok = object[part]; !ok {
+			return nil, fmt.Errorf("\"%s.%s\" is not a value", root, path)
+		}
+	}
+	return current, nil
+}
+
+// referenceText returns a value written within text
+func referenceText(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case nil:
+		return ""
+	}
+	text, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(text)
+}
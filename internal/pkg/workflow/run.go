@@ -0,0 +1,227 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package workflow runs the workflows of an app manifest on this machine by
+// calling the start hook for each custom function step.
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/toughtackle/slack-cli/internal/pkg/function"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+)
+
+const (
+	// customFunctionPrefix starts the function ID of functions in the app
+	customFunctionPrefix = "#/functions/"
+	// localWorkflowExecutionID identifies the execution of a local run
+	localWorkflowExecutionID = "Wx_LOCAL"
+)
+
+// RunArgs are the options of a local workflow run
+type RunArgs struct {
+	// CallbackID is the workflow to run
+	CallbackID string
+	// Inputs are the input parameters of the workflow
+	Inputs map[string]interface{}
+	// Mocks are the outputs of steps that are not run, keyed by step ID or
+	// function ID
+	Mocks map[string]map[string]interface{}
+}
+
+// StepResult is the outcome of a single step
+type StepResult struct {
+	ID         string                 `json:"id"`
+	FunctionID string                 `json:"function_id"`
+	Inputs     map[string]interface{} `json:"inputs"`
+	Outputs    map[string]interface{} `json:"outputs"`
+	// Mocked is true if outputs came from mocks rather than running the step
+	Mocked bool `json:"mocked"`
+	// Methods are the API methods called by the function
+	Methods []string `json:"methods,omitempty"`
+}
+
+// RunResult is the outcome of a workflow run
+type RunResult struct {
+	Workflow string       `json:"workflow"`
+	Steps    []StepResult `json:"steps"`
+	// Outputs are the outputs of the final step
+	Outputs map[string]interface{} `json:"outputs"`
+}
+
+// Run executes the steps of a workflow in order. Custom function steps are
+// invoked through the start hook with a function_executed event and the
+// outputs of each step can be referenced by the inputs of later steps. Steps
+// of other functions use mocked outputs.
+func Run(ctx context.Context, clients *shared.ClientFactory, args RunArgs) (RunResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pkg.workflow.run")
+	defer span.Finish()
+
+	manifest, err := clients.AppClient().Manifest.GetManifestLocal(ctx, clients.SDKConfig, clients.HookExecutor)
+	if err != nil {
+		return RunResult{}, err
+	}
+	workflow, ok := manifest.Workflows[args.CallbackID]
+	if !ok {
+		return RunResult{}, slackerror.New(slackerror.ErrWorkflowNotFound).
+			WithMessage("No workflow with the callback ID \"%s\" is in the app manifest", args.CallbackID)
+	}
+	if args.Inputs == nil {
+		args.Inputs = map[string]interface{}{}
+	}
+	if err := checkRequiredInputs(workflow, args.Inputs); err != nil {
+		return RunResult{}, err
+	}
+
+	variables, err := clients.Config.GetDotEnvFileVariables()
+	if err != nil {
+		return RunResult{}, slackerror.Wrap(err, slackerror.ErrWorkflowRun).
+			WithMessage("Failed to read the local .env file")
+	}
+
+	result := RunResult{Workflow: args.CallbackID, Steps: []StepResult{}, Outputs: map[string]interface{}{}}
+	values := scope{inputs: args.Inputs, steps: map[string]map[string]interface{}{}}
+	for _, step := range workflow.Steps {
+		inputs, err := stepInputs(step, values)
+		if err != nil {
+			return result, slackerror.New(slackerror.ErrWorkflowRun).
+				WithMessage("The inputs of step \"%s\" could not be resolved", step.ID).
+				WithRootCause(err)
+		}
+		stepResult := StepResult{ID: step.ID, FunctionID: step.FunctionID, Inputs: inputs}
+		if mock, ok := findMock(args.Mocks, step); ok {
+			stepResult.Outputs = mock
+			stepResult.Mocked = true
+		} else if callbackID, ok := strings.CutPrefix(step.FunctionID, customFunctionPrefix); ok {
+			execution, err := runFunction(ctx, clients, variables, step, callbackID, manifest.Functions[callbackID], inputs)
+			if err != nil {
+				return result, err
+			}
+			stepResult.Outputs, stepResult.Methods = execution.Outputs, execution.Methods
+		} else {
+			// Functions of Slack and other apps are not run without mocks
+			stepResult.Outputs = map[string]interface{}{}
+			stepResult.Mocked = true
+		}
+		values.steps[step.ID] = stepResult.Outputs
+		result.Steps = append(result.Steps, stepResult)
+		result.Outputs = stepResult.Outputs
+	}
+	return result, nil
+}
+
+// checkRequiredInputs errors if a required input parameter of the workflow is
+// missing
+func checkRequiredInputs(workflow types.Workflow, inputs map[string]interface{}) error {
+	parameters, err := rawObject(workflow.InputParameters)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrWorkflowRun)
+	}
+	required, _ := parameters["required"].([]interface{})
+	missing := []string{}
+	for _, name := range required {
+		if _, ok := inputs[fmt.Sprint(name)]; !ok {
+			missing = append(missing, fmt.Sprint(name))
+		}
+	}
+	if len(missing) > 0 {
+		return slackerror.New(slackerror.ErrWorkflowRun).
+			WithMessage("The workflow requires the inputs %s", strings.Join(missing, ", ")).
+			WithRemediation("Provide each required input in the file passed to --inputs")
+	}
+	return nil
+}
+
+// stepInputs returns the inputs of a step with references resolved
+func stepInputs(step types.Step, values scope) (map[string]interface{}, error) {
+	inputs, err := rawObject(step.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := values.resolve(inputs)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(map[string]interface{}), nil
+}
+
+// findMock returns the mocked outputs of a step by step ID or function ID
+func findMock(mocks map[string]map[string]interface{}, step types.Step) (map[string]interface{}, bool) {
+	if outputs, ok := mocks[step.ID]; ok {
+		return outputs, true
+	}
+	outputs, ok := mocks[step.FunctionID]
+	return outputs, ok
+}
+
+// executionID returns the function execution ID of a step
+func executionID(step types.Step) string {
+	return fmt.Sprintf("Fx_LOCAL_%s", step.ID)
+}
+
+// runFunction calls the start hook with a function_executed event for the step
+// and returns how the function completed
+func runFunction(
+	ctx context.Context,
+	clients *shared.ClientFactory,
+	variables map[string]string,
+	step types.Step,
+	callbackID string,
+	manifestFunction types.ManifestFunction,
+	inputs map[string]interface{},
+) (function.Execution, error) {
+	body, err := function.NewExecutedEvent(function.ExecutedEventArgs{
+		CallbackID:          callbackID,
+		Function:            manifestFunction,
+		Inputs:              inputs,
+		ExecutionID:         executionID(step),
+		WorkflowExecutionID: localWorkflowExecutionID,
+	})
+	if err != nil {
+		return function.Execution{}, err
+	}
+	execution, err := function.Execute(ctx, clients, body, variables)
+	if err != nil {
+		return function.Execution{}, slackerror.Wrap(err, slackerror.ErrWorkflowRun).
+			WithMessage("The function of step \"%s\" failed to run", step.ID)
+	}
+	if execution.Error != "" {
+		return function.Execution{}, slackerror.New(slackerror.ErrWorkflowRun).
+			WithMessage("The function of step \"%s\" completed with an error: %s", step.ID, execution.Error)
+	}
+	return execution, nil
+}
+
+// rawObject decodes the manifest value as an object
+func rawObject(raw *types.RawJSON) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	if raw == nil || (raw.JSONData == nil && raw.Data == nil) {
+		return object, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/app"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// startHookInput is the event the start hook receives
+type startHookInput struct {
+	Body struct {
+		Event map[string]interface{} `json:"event"`
+	} `json:"body"`
+	Context struct {
+		Variables map[string]string `json:"variables"`
+	} `json:"context"`
+}
+
+// appExecutor acts as an app that completes each function with outputs
+type appExecutor struct {
+	// outputs returns the outputs of a function from its inputs
+	outputs func(callbackID string, inputs map[string]interface{}) map[string]interface{}
+	// respond writes outputs to the hook response instead of the API
+	respond bool
+	// fail completes functions with an error
+	fail   string
+	events []startHookInput
+}
+
+func (e *appExecutor) Execute(ctx context.Context, opts hooks.HookExecOpts) (string, error) {
+	var event startHookInput
+	input, err := io.ReadAll(opts.Stdin)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(input, &event); err != nil {
+		return "", err
+	}
+	e.events = append(e.events, event)
+	function := event.Body.Event["function"].(map[string]interface{})
+	inputs := event.Body.Event["inputs"].(map[string]interface{})
+	outputs := e.outputs(function["callback_id"].(string), inputs)
+	if e.respond {
+		response, err := json.Marshal(map[string]interface{}{"outputs": outputs, "error": e.fail})
+		return string(response), err
+	}
+	apiURL := opts.Env["SLACK_API_URL"]
+	if apiURL != event.Context.Variables["SLACK_API_URL"] {
+		return "", fmt.Errorf("the API URL of the context and environment differ")
+	}
+	if _, err := http.PostForm(apiURL+"chat.postMessage", url.Values{"channel": {"C001"}}); err != nil {
+		return "", err
+	}
+	method, params := "functions.completeSuccess", url.Values{}
+	if e.fail != "" {
+		method = "functions.completeError"
+		params.Set("error", e.fail)
+	}
+	encoded, _ := json.Marshal(outputs)
+	params.Set("outputs", string(encoded))
+	params.Set("function_execution_id", event.Body.Event["function_execution_id"].(string))
+	response, err := http.PostForm(apiURL+method, params)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	return "{}", nil
+}
+
+func mockManifest() types.SlackYaml {
+	manifest := types.SlackYaml{}
+	manifest.Functions = map[string]types.ManifestFunction{
+		"greet":   {Title: "Greet"},
+		"shorten": {Title: "Shorten"},
+	}
+	manifest.Workflows = map[string]types.Workflow{
+		"welcome": {
+			Title:           "Welcome",
+			InputParameters: types.ToRawJSON(`{"properties": {"name": {"type": "string"}, "channel": {"type": "slack#/types/channel_id"}}, "required": ["name"]}`),
+			Steps: []types.Step{
+				{ID: "0", FunctionID: "#/functions/greet", Inputs: types.ToRawJSON(`{"name": "{{inputs.name}}", "channel": "{{inputs.channel}}"}`)},
+				{ID: "1", FunctionID: "slack#/functions/send_message", Inputs: types.ToRawJSON(`{"channel_id": "{{inputs.channel}}", "message": "{{steps.0.greeting}}"}`)},
+				{ID: "2", FunctionID: "#/functions/shorten", Inputs: types.ToRawJSON(`{"text": "Sent {{steps.0.greeting}} at {{steps.1.message_context.message_ts}}", "details": {"length": "{{steps.0.length}}"}}`)},
+			},
+		},
+	}
+	return manifest
+}
+
+func setupRun(t *testing.T, executor hooks.HookExecutor) (context.Context, *shared.ClientFactory) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	manifestMock := &app.ManifestMockObject{}
+	manifestMock.On("GetManifestLocal", mock.Anything, mock.Anything, mock.Anything).Return(mockManifest(), nil)
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	clients.AppClient().Manifest = manifestMock
+	clients.HookExecutor = executor
+	clients.SDKConfig.Hooks.Start = hooks.HookScript{Name: "Start", Command: "deno run start.ts"}
+	return ctx, clients
+}
+
+func greetOutputs(callbackID string, inputs map[string]interface{}) map[string]interface{} {
+	switch callbackID {
+	case "greet":
+		greeting := fmt.Sprintf("Hello %s", inputs["name"])
+		return map[string]interface{}{"greeting": greeting, "length": len(greeting)}
+	default:
+		return map[string]interface{}{"short": strings.ToLower(inputs["text"].(string))}
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := map[string]struct {
+		respond bool
+	}{
+		"threads outputs completed through the API":    {respond: false},
+		"threads outputs written to the hook response": {respond: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			executor := &appExecutor{outputs: greetOutputs, respond: tt.respond}
+			ctx, clients := setupRun(t, executor)
+
+			result, err := Run(ctx, clients, RunArgs{
+				CallbackID: "welcome",
+				Inputs:     map[string]interface{}{"name": "Ada", "channel": "C001"},
+				Mocks: map[string]map[string]interface{}{
+					"slack#/functions/send_message": {"message_context": map[string]interface{}{"message_ts": "1.0001"}},
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Steps, 3)
+			require.Len(t, executor.events, 2, "only custom function steps call the start hook")
+			assert.Equal(t, "function_executed", executor.events[0].Body.Event["type"])
+			assert.Equal(t, "Fx_LOCAL_0", executor.events[0].Body.Event["function_execution_id"])
+
+			assert.Equal(t, map[string]interface{}{"name": "Ada", "channel": "C001"}, result.Steps[0].Inputs)
+			assert.False(t, result.Steps[0].Mocked)
+			assert.Equal(t, map[string]interface{}{"channel_id": "C001", "message": "Hello Ada"}, result.Steps[1].Inputs)
+			assert.True(t, result.Steps[1].Mocked)
+			assert.Equal(t, map[string]interface{}{
+				"text":    "Sent Hello Ada at 1.0001",
+				"details": map[string]interface{}{"length": float64(9)},
+			}, result.Steps[2].Inputs)
+			assert.Equal(t, map[string]interface{}{"short": "sent hello ada at 1.0001"}, result.Outputs)
+			if !tt.respond {
+				assert.Equal(t, []string{"chat.postMessage", "functions.completeSuccess"}, result.Steps[0].Methods)
+			}
+		})
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := map[string]struct {
+		args            RunArgs
+		fail            string
+		expectedCode    string
+		expectedMessage string
+	}{
+		"errors for an unknown workflow": {
+			args:         RunArgs{CallbackID: "goodbye"},
+			expectedCode: slackerror.ErrWorkflowNotFound,
+		},
+		"errors for missing required inputs": {
+			args:            RunArgs{CallbackID: "welcome"},
+			expectedCode:    slackerror.ErrWorkflowRun,
+			expectedMessage: "The workflow requires the inputs name",
+		},
+		"errors when a function completes with an error": {
+			args:            RunArgs{CallbackID: "welcome", Inputs: map[string]interface{}{"name": "Ada"}},
+			fail:            "name is too short",
+			expectedCode:    slackerror.ErrWorkflowRun,
+			expectedMessage: "The function of step \"0\" completed with an error: name is too short",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, clients := setupRun(t, &appExecutor{outputs: greetOutputs, fail: tt.fail})
+			_, err := Run(ctx, clients, tt.args)
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, slackerror.ToSlackError(err).Code)
+			assert.Contains(t, slackerror.ToSlackError(err).Message, tt.expectedMessage)
+		})
+	}
+}
+
+func TestScope_Resolve(t *testing.T) {
+	values := scope{
+		inputs: map[string]interface{}{"name": "Ada", "count": float64(2)},
+		steps: map[string]map[string]interface{}{
+			"0": {"user": map[string]interface{}{"id": "U001"}, "tags": []interface{}{"a", "b"}},
+		},
+	}
+	tests := map[string]struct {
+		value         interface{}
+		expected      interface{}
+		expectedError string
+	}{
+		"keeps the type of a whole reference": {value: "{{inputs.count}}", expected: float64(2)},
+		"writes references within text":       {value: "Hi {{ inputs.name }} ({{steps.0.user.id}})", expected: "Hi Ada (U001)"},
+		"writes objects within text as JSON":  {value: "tags: {{steps.0.tags}}", expected: `tags: ["a","b"]`},
+		"resolves nested values":              {value: map[string]interface{}{"to": []interface{}{"{{steps.0.user.id}}"}}, expected: map[string]interface{}{"to": []interface{}{"U001"}}},
+		"leaves missing inputs empty":         {value: "{{inputs.missing}}", expected: nil},
+		"errors for steps that have not run":  {value: "{{steps.3.ts}}", expectedError: "step \"3\" has not run before this step"},
+		"errors for missing outputs":          {value: "{{steps.0.user.name}}", expectedError: "\"steps.0.user.name\" is not a value"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resolved, err := values.resolve(tt.value)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved)
+		})
+	}
+}
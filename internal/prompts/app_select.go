@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/toughtackle/slack-cli/internal/api"
+	"github.com/toughtackle/slack-cli/internal/auth"
 	"github.com/toughtackle/slack-cli/internal/cmdutil"
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/experiment"
@@ -318,6 +319,7 @@ func getAuths(ctx context.Context, clients *shared.ClientFactory) ([]types.Slack
 	if err != nil {
 		return nil, err
 	}
+	allAuths = auth.SelectTeamAuths(allAuths, clients.Config.ProfileResolved)
 	if clients.Config.TokenFlag != "" {
 		tokenAuth, err := clients.AuthInterface().AuthWithToken(ctx, clients.Config.TokenFlag)
 		if err != nil {
@@ -343,6 +345,75 @@ func getAuths(ctx context.Context, clients *shared.ClientFactory) ([]types.Slack
 	return allAuths, nil
 }
 
+// resolveProfileTeam selects the team of the auth profile chosen with the
+// --profile flag or pinned to the app environment in the project config file
+func resolveProfileTeam(ctx context.Context, clients *shared.ClientFactory, env AppEnvironmentType) error {
+	if clients.Config.TokenFlag != "" {
+		return nil
+	}
+	profile := clients.Config.ProfileFlag
+	pinned := false
+	if profile == "" {
+		profile = getProjectProfile(ctx, clients, env)
+		pinned = true
+	}
+	if profile == "" {
+		return nil
+	}
+	profileAuth, err := clients.AuthInterface().AuthWithProfile(ctx, profile)
+	if err != nil {
+		return err
+	}
+	switch clients.Config.TeamFlag {
+	case "":
+		clients.Config.TeamFlag = profileAuth.TeamID
+	case profileAuth.TeamID, profileAuth.TeamDomain:
+	default:
+		if pinned {
+			clients.IO.PrintDebug(ctx, "ignoring the project profile '%s' of another team than '%s'", profile, clients.Config.TeamFlag)
+			return nil
+		}
+		return slackerror.New(slackerror.ErrMismatchedFlags).
+			WithMessage("The profile \"%s\" belongs to the team \"%s\" and not \"%s\"", profile, profileAuth.TeamDomain, clients.Config.TeamFlag)
+	}
+	clients.IO.PrintDebug(ctx, "selecting team '%s' with the auth profile '%s'", profileAuth.TeamDomain, profile)
+	clients.Config.ProfileResolved = profile
+	return nil
+}
+
+// getProjectProfile returns the profile pinned to the app environment in the
+// project config file. Commands for either environment use a profile only if
+// both environments have the same profile.
+func getProjectProfile(ctx context.Context, clients *shared.ClientFactory, env AppEnvironmentType) string {
+	if env == ShowAllEnvironments {
+		switch {
+		case types.IsAppFlagLocal(clients.Config.AppFlag):
+			env = ShowLocalOnly
+		case types.IsAppFlagDeploy(clients.Config.AppFlag):
+			env = ShowHostedOnly
+		}
+	}
+	profile := func(environment string) string {
+		profile, err := clients.Config.ProjectConfig.GetProfile(ctx, environment)
+		if err != nil {
+			clients.IO.PrintDebug(ctx, "failed to read the project profile of the %s app: %s", environment, err)
+			return ""
+		}
+		return profile
+	}
+	switch env {
+	case ShowHostedOnly:
+		return profile("deployed")
+	case ShowLocalOnly:
+		return profile("local")
+	default:
+		if deployed := profile("deployed"); deployed == profile("local") {
+			return deployed
+		}
+		return ""
+	}
+}
+
 // getTeamApps creates a map from team ID to applications and authentications
 //
 // Details are collected from both the credentials.json and apps.*.json files.
@@ -1289,6 +1360,10 @@ func flatAppSelectPrompt(
 // AppSelectPrompt prompts the user to select a workspace then environment for the current command,
 // returning the selected app. This app might require installation before use if `status == ShowAllApps`.
 func AppSelectPrompt(ctx context.Context, clients *shared.ClientFactory, status AppInstallStatus) (SelectedApp, error) {
+	if err := resolveProfileTeam(ctx, clients, ShowAllEnvironments); err != nil {
+		return SelectedApp{}, err
+	}
+
 	var selectedApp SelectedApp
 	var selectedTeam TeamApps
 	var tokenAuth types.SlackAuth
@@ -1495,6 +1570,10 @@ func flatTeamSelectPrompt(
 // TeamAppSelectPrompt prompts the user to select an app from a specified team environment,
 // returning the selected app. This app might require installation before use if `status == ShowAllApps`.
 func TeamAppSelectPrompt(ctx context.Context, clients *shared.ClientFactory, env AppEnvironmentType, status AppInstallStatus) (SelectedApp, error) {
+	if err := resolveProfileTeam(ctx, clients, env); err != nil {
+		return SelectedApp{}, err
+	}
+
 	var teamFlag = clients.Config.TeamFlag
 	var appFlag = clients.Config.AppFlag
 	var tokenFlag = clients.Config.TokenFlag
@@ -5205,3 +5205,74 @@ func mockReauthentication(clientsMock *shared.ClientsMock) {
 	clientsMock.AuthInterface.On("SetAuth", mock.Anything, mock.Anything).Return(types.SlackAuth{}, "", nil)
 	clientsMock.AuthInterface.On("SetSelectedAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 }
+
+func Test_resolveProfileTeam(t *testing.T) {
+	profileAuth := types.SlackAuth{TeamDomain: team1TeamDomain, TeamID: team1TeamID, Token: team1Token, Profile: "service"}
+
+	tests := map[string]struct {
+		flagProfile      string
+		flagTeam         string
+		projectProfiles  map[string]string
+		expectedTeam     string
+		expectedProfile  string
+		expectedErrorStr string
+	}{
+		"selects the team of the profile flag": {
+			flagProfile:     "service",
+			expectedTeam:    team1TeamID,
+			expectedProfile: "service",
+		},
+		"uses the profile pinned to the environment of the project": {
+			projectProfiles: map[string]string{"deployed": "service", "local": "service"},
+			expectedTeam:    team1TeamID,
+			expectedProfile: "service",
+		},
+		"ignores different profiles pinned to each environment": {
+			projectProfiles: map[string]string{"deployed": "service", "local": "personal"},
+		},
+		"keeps a matching team flag": {
+			flagProfile:     "service",
+			flagTeam:        team1TeamDomain,
+			expectedTeam:    team1TeamDomain,
+			expectedProfile: "service",
+		},
+		"errors if the profile flag belongs to another team": {
+			flagProfile:      "service",
+			flagTeam:         team2TeamDomain,
+			expectedTeam:     team2TeamDomain,
+			expectedErrorStr: slackerror.ErrMismatchedFlags,
+		},
+		"ignores a pinned profile of another team": {
+			flagTeam:        team2TeamDomain,
+			projectProfiles: map[string]string{"deployed": "service", "local": "service"},
+			expectedTeam:    team2TeamDomain,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := shared.NewClientsMock()
+			clientsMock.AuthInterface.On("AuthWithProfile", mock.Anything, "service").Return(profileAuth, nil)
+			clientsMock.AddDefaultMocks()
+			projectConfigMock := config.NewProjectConfigMock()
+			for environment, profile := range tt.projectProfiles {
+				projectConfigMock.On("GetProfile", mock.Anything, environment).Return(profile, nil)
+			}
+			projectConfigMock.On("GetProfile", mock.Anything, mock.Anything).Return("", nil)
+			clientsMock.Config.ProjectConfig = projectConfigMock
+			clientsMock.Config.ProfileFlag = tt.flagProfile
+			clientsMock.Config.TeamFlag = tt.flagTeam
+			clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+
+			err := resolveProfileTeam(ctx, clients, ShowAllEnvironments)
+			if tt.expectedErrorStr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedErrorStr, slackerror.ToSlackError(err).Code)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTeam, clients.Config.TeamFlag)
+			assert.Equal(t, tt.expectedProfile, clients.Config.ProfileResolved)
+		})
+	}
+}
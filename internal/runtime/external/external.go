@@ -0,0 +1,195 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+// defaultName is used when neither the runtime nor the adapter are named
+const defaultName = "external"
+
+// External runtime type drives a runtime through the runtime adapter hooks
+// declared in the project hooks.json
+type External struct {
+	name              string
+	version           string
+	ignoreDirectories []string
+	adapter           hooks.RuntimeAdapter
+
+	// ctx and hookExecutor run the adapter hooks that are gathered lazily
+	ctx            context.Context
+	hookExecutor   hooks.HookExecutor
+	versionOnce    sync.Once
+	ignoreDirsOnce sync.Once
+}
+
+// New creates a new External runtime from the runtime adapter hooks. The
+// version and ignored directories are gathered from the adapter hooks on the
+// first call to Version and IgnoreDirectories.
+func New(ctx context.Context, runtimeName string, adapter hooks.RuntimeAdapter, hookExecutor hooks.HookExecutor) (*External, error) {
+	e := &External{
+		name:              strings.TrimSpace(adapter.Name),
+		version:           strings.TrimSpace(runtimeName),
+		ignoreDirectories: []string{},
+		adapter:           adapter,
+		ctx:               ctx,
+		hookExecutor:      hookExecutor,
+	}
+	if e.name == "" {
+		e.name = e.version
+	}
+	if e.name == "" {
+		e.name = defaultName
+	}
+	if e.version == "" {
+		e.version = strings.ToLower(e.name)
+	}
+	return e, nil
+}
+
+// loadVersion replaces the default version with the output of the "version"
+// adapter hook. The default is kept if the hook fails.
+func (e *External) loadVersion() {
+	if !e.adapter.Hooks.Version.IsAvailable() {
+		return
+	}
+	hookScript := e.adapter.Hooks.Version
+	hookScript.Name = "RuntimeVersion"
+	version, err := e.hookExecutor.Execute(e.ctx, hooks.HookExecOpts{Hook: hookScript})
+	if err != nil {
+		return
+	}
+	if version = strings.TrimSpace(version); version != "" {
+		e.version = version
+	}
+}
+
+// loadIgnoreDirectories gathers the directories from the "ignore-directories"
+// adapter hook. No directories are ignored if the hook fails.
+func (e *External) loadIgnoreDirectories() {
+	if !e.adapter.Hooks.IgnoreDirectories.IsAvailable() {
+		return
+	}
+	hookScript := e.adapter.Hooks.IgnoreDirectories
+	hookScript.Name = "RuntimeIgnoreDirectories"
+	response, err := e.hookExecutor.Execute(e.ctx, hooks.HookExecOpts{Hook: hookScript})
+	if err != nil {
+		return
+	}
+	var ignoreDirectories []string
+	if err := json.Unmarshal([]byte(response), &ignoreDirectories); err != nil {
+		return
+	}
+	e.ignoreDirectories = ignoreDirectories
+}
+
+// IgnoreDirectories is a list of directories to ignore when packaging the runtime for deployment.
+func (e *External) IgnoreDirectories() []string {
+	e.ignoreDirsOnce.Do(e.loadIgnoreDirectories)
+	return e.ignoreDirectories
+}
+
+// InstallProjectDependencies runs the "install-dependencies" adapter hook in the dirPath
+func (e *External) InstallProjectDependencies(
+	ctx context.Context,
+	dirPath string,
+	hookExecutor hooks.HookExecutor,
+	ios iostreams.IOStreamer,
+	fs afero.Fs,
+	os types.Os,
+) (string, error) {
+	if !e.adapter.Hooks.InstallDependencies.IsAvailable() {
+		return "", nil
+	}
+
+	// Stream install outputs to debug logs
+	stdout := bytes.Buffer{}
+	hookScript := e.adapter.Hooks.InstallDependencies
+	hookScript.Name = "InstallProjectDependencies"
+	hookExecOpts := hooks.HookExecOpts{
+		Directory: dirPath,
+		Args: map[string]string{
+			"source": dirPath,
+		},
+		Hook:   hookScript,
+		Stdin:  ios.ReadIn(),
+		Stdout: &stdout,
+	}
+	if _, err := hookExecutor.Execute(ctx, hookExecOpts); err != nil {
+		ios.PrintDebug(ctx, "failed to install project dependencies: %s", err)
+		return fmt.Sprintf("Error installing dependencies using %s", style.Highlight(hookScript.Command)), err
+	}
+	return fmt.Sprintf("Installed dependencies using %s", style.Highlight(hookScript.Command)), nil
+}
+
+// Name prints the name of the runtime
+func (e *External) Name() string {
+	return e.name
+}
+
+// Version is the runtime version used by the hosted app deployment
+func (e *External) Version() string {
+	e.versionOnce.Do(e.loadVersion)
+	return e.version
+}
+
+// SetVersion sets the Version value
+func (e *External) SetVersion(version string) {
+	e.versionOnce.Do(func() {})
+	e.version = version
+}
+
+// HooksJSONTemplate returns an empty template because projects declare the
+// adapter in an existing hooks.json
+func (e *External) HooksJSONTemplate() []byte {
+	return []byte("{}")
+}
+
+// PreparePackage will prepare and copy the app in srcDirPath to dstDirPath as a release-ready bundle
+// with the "prepare-package" adapter hook or the "build" hook of the project.
+func (e *External) PreparePackage(ctx context.Context, sdkConfig hooks.SDKCLIConfig, hookExecutor hooks.HookExecutor, opts types.PreparePackageOpts) error {
+	hookScript := e.adapter.Hooks.PreparePackage
+	hookScript.Name = "PreparePackage"
+	if !hookScript.IsAvailable() {
+		hookScript = sdkConfig.Hooks.BuildProject
+	}
+	if !hookScript.IsAvailable() {
+		return nil // Nothing to prepare
+	}
+	var packageHookOpts = hooks.HookExecOpts{
+		Directory: opts.SrcDirPath,
+		Args: map[string]string{
+			"source": opts.SrcDirPath,
+			"output": opts.DstDirPath,
+		},
+		Hook: hookScript,
+	}
+	if _, err := hookExecutor.Execute(ctx, packageHookOpts); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newAdapter returns a runtime adapter with the hook commands
+func newAdapter(name string, commands map[string]string) hooks.RuntimeAdapter {
+	adapter := hooks.RuntimeAdapter{Name: name}
+	adapter.Hooks.IgnoreDirectories.Command = commands["ignore-directories"]
+	adapter.Hooks.InstallDependencies.Command = commands["install-dependencies"]
+	adapter.Hooks.PreparePackage.Command = commands["prepare-package"]
+	adapter.Hooks.Version.Command = commands["version"]
+	return adapter
+}
+
+// hookCalledWith returns a matcher for the hook command that was executed
+func hookCalledWith(command string) any {
+	return mock.MatchedBy(func(opts hooks.HookExecOpts) bool {
+		return opts.Hook.Command == command
+	})
+}
+
+func Test_External_New(t *testing.T) {
+	tests := map[string]struct {
+		runtimeName               string
+		adapter                   hooks.RuntimeAdapter
+		hookResponses             map[string]string
+		hookError                 error
+		expectedName              string
+		expectedVersion           string
+		expectedIgnoreDirectories []string
+	}{
+		"uses the runtime name without adapter hooks": {
+			runtimeName:               "bun",
+			adapter:                   newAdapter("", nil),
+			expectedName:              "bun",
+			expectedVersion:           "bun",
+			expectedIgnoreDirectories: []string{},
+		},
+		"uses the adapter name as the default version": {
+			adapter:                   newAdapter("Ruby", nil),
+			expectedName:              "Ruby",
+			expectedVersion:           "ruby",
+			expectedIgnoreDirectories: []string{},
+		},
+		"uses a default name without names": {
+			adapter:                   newAdapter("", map[string]string{"install-dependencies": "bundle install"}),
+			expectedName:              "external",
+			expectedVersion:           "external",
+			expectedIgnoreDirectories: []string{},
+		},
+		"gathers the version and ignored directories from hooks": {
+			runtimeName: "bun",
+			adapter: newAdapter("Bun", map[string]string{
+				"ignore-directories": "./ignore.sh",
+				"version":            "bun --version",
+			}),
+			hookResponses: map[string]string{
+				"./ignore.sh":   `["node_modules", "dist"]`,
+				"bun --version": "1.2.3\n",
+			},
+			expectedName:              "Bun",
+			expectedVersion:           "1.2.3",
+			expectedIgnoreDirectories: []string{"node_modules", "dist"},
+		},
+		"ignores no directories when the ignored directories are not a JSON array": {
+			adapter: newAdapter("Bun", map[string]string{"ignore-directories": "./ignore.sh"}),
+			hookResponses: map[string]string{
+				"./ignore.sh": "node_modules",
+			},
+			expectedName:              "Bun",
+			expectedVersion:           "bun",
+			expectedIgnoreDirectories: []string{},
+		},
+		"keeps the default version when a hook fails": {
+			adapter:                   newAdapter("Bun", map[string]string{"version": "bun --version"}),
+			hookError:                 slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			expectedName:              "Bun",
+			expectedVersion:           "bun",
+			expectedIgnoreDirectories: []string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			mockHookExecutor := &hooks.MockHookExecutor{}
+			for command, response := range tt.hookResponses {
+				mockHookExecutor.On("Execute", mock.Anything, hookCalledWith(command)).Return(response, nil)
+			}
+			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("", tt.hookError)
+
+			e, err := New(ctx, tt.runtimeName, tt.adapter, mockHookExecutor)
+			require.NoError(t, err)
+			mockHookExecutor.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			require.Equal(t, tt.expectedName, e.Name())
+			require.Equal(t, tt.expectedVersion, e.Version())
+			require.Equal(t, tt.expectedIgnoreDirectories, e.IgnoreDirectories())
+		})
+	}
+}
+
+func Test_External_lazyHooks(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	mockHookExecutor := &hooks.MockHookExecutor{}
+	mockHookExecutor.On("Execute", mock.Anything, hookCalledWith("bun --version")).Return("1.2.3", nil)
+	mockHookExecutor.On("Execute", mock.Anything, hookCalledWith("./ignore.sh")).Return(`["dist"]`, nil)
+	e, err := New(ctx, "bun", newAdapter("Bun", map[string]string{
+		"ignore-directories": "./ignore.sh",
+		"version":            "bun --version",
+	}), mockHookExecutor)
+	require.NoError(t, err)
+
+	for range 2 {
+		require.Equal(t, "1.2.3", e.Version())
+	}
+	mockHookExecutor.AssertNumberOfCalls(t, "Execute", 1)
+	for range 2 {
+		require.Equal(t, []string{"dist"}, e.IgnoreDirectories())
+	}
+	mockHookExecutor.AssertNumberOfCalls(t, "Execute", 2)
+}
+
+func Test_External_SetVersion(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	e, err := New(ctx, "bun", newAdapter("Bun", nil), &hooks.MockHookExecutor{})
+	require.NoError(t, err)
+	e.SetVersion("bun1.x")
+	require.Equal(t, "bun1.x", e.Version())
+}
+
+func Test_External_HooksJSONTemplate(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	e, err := New(ctx, "bun", newAdapter("Bun", nil), &hooks.MockHookExecutor{})
+	require.NoError(t, err)
+	require.Equal(t, []byte("{}"), e.HooksJSONTemplate())
+}
+
+func Test_External_InstallProjectDependencies(t *testing.T) {
+	tests := map[string]struct {
+		adapter           hooks.RuntimeAdapter
+		hookError         error
+		expectedResponse  string
+		expectedError     error
+		expectedHookCalls int
+	}{
+		"skips without an install hook": {
+			adapter:           newAdapter("Bun", nil),
+			expectedResponse:  "",
+			expectedHookCalls: 0,
+		},
+		"runs the install hook in the project": {
+			adapter:           newAdapter("Bun", map[string]string{"install-dependencies": "bun install"}),
+			expectedResponse:  "Installed dependencies using bun install",
+			expectedHookCalls: 1,
+		},
+		"errors when the install hook fails": {
+			adapter:           newAdapter("Bun", map[string]string{"install-dependencies": "bun install"}),
+			hookError:         slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			expectedResponse:  "Error installing dependencies using bun install",
+			expectedError:     slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			expectedHookCalls: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			projectDirPath := "/path/to/project-name"
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.AddDefaultMocks()
+			cfg := config.NewConfig(fs, os)
+			ios := iostreams.NewIOStreamsMock(cfg, fs, os)
+			ios.AddDefaultMocks()
+			mockHookExecutor := &hooks.MockHookExecutor{}
+			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("", tt.hookError)
+
+			e, err := New(ctx, "bun", tt.adapter, &hooks.MockHookExecutor{})
+			require.NoError(t, err)
+			response, err := e.InstallProjectDependencies(ctx, projectDirPath, mockHookExecutor, ios, fs, os)
+
+			require.Contains(t, response, tt.expectedResponse)
+			require.Equal(t, tt.expectedError, err)
+			mockHookExecutor.AssertNumberOfCalls(t, "Execute", tt.expectedHookCalls)
+			if tt.expectedHookCalls > 0 {
+				opts := mockHookExecutor.Calls[0].Arguments.Get(1).(hooks.HookExecOpts)
+				require.Equal(t, "InstallProjectDependencies", opts.Hook.Name)
+				require.Equal(t, projectDirPath, opts.Args["source"])
+			}
+		})
+	}
+}
+
+func Test_External_PreparePackage(t *testing.T) {
+	tests := map[string]struct {
+		adapter           hooks.RuntimeAdapter
+		buildProject      string
+		expectedCommand   string
+		expectedHookCalls int
+	}{
+		"skips without a package hook": {
+			adapter:           newAdapter("Bun", nil),
+			expectedHookCalls: 0,
+		},
+		"runs the prepare package hook": {
+			adapter:           newAdapter("Bun", map[string]string{"prepare-package": "bun build"}),
+			buildProject:      "./build.sh",
+			expectedCommand:   "bun build",
+			expectedHookCalls: 1,
+		},
+		"falls back to the build hook": {
+			adapter:           newAdapter("Bun", nil),
+			buildProject:      "./build.sh",
+			expectedCommand:   "./build.sh",
+			expectedHookCalls: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			sdkConfig := hooks.NewSDKConfigMock()
+			sdkConfig.Hooks.BuildProject = hooks.HookScript{Name: "BuildProject", Command: tt.buildProject}
+			mockHookExecutor := &hooks.MockHookExecutor{}
+			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("", nil)
+			opts := types.PreparePackageOpts{
+				SrcDirPath: "src/dir/path",
+				DstDirPath: "dst/dir/path",
+			}
+
+			e, err := New(ctx, "bun", tt.adapter, &hooks.MockHookExecutor{})
+			require.NoError(t, err)
+			err = e.PreparePackage(ctx, sdkConfig, mockHookExecutor, opts)
+
+			require.NoError(t, err)
+			mockHookExecutor.AssertNumberOfCalls(t, "Execute", tt.expectedHookCalls)
+			if tt.expectedHookCalls > 0 {
+				execOpts := mockHookExecutor.Calls[0].Arguments.Get(1).(hooks.HookExecOpts)
+				require.Equal(t, tt.expectedCommand, execOpts.Hook.Command)
+				require.Equal(t, "src/dir/path", execOpts.Args["source"])
+				require.Equal(t, "dst/dir/path", execOpts.Args["output"])
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/toughtackle/slack-cli/internal/style"
+	"github.com/spf13/afero"
+)
+
+//go:embed hooks.json
+var hooksJSON []byte
+
+// Constants
+const (
+	// defaultVersion is the latest version of Go
+	defaultVersion = "go"
+
+	// goModFileName declares the module and dependencies of a Go project
+	goModFileName = "go.mod"
+
+	// binaryFileName is the name of the executable built for a release-ready bundle
+	binaryFileName = "app"
+)
+
+// Go runtime type
+type Go struct {
+	version string // Defines the hosted deployment runtime and version: "go" is the latest, "go1.24", etc
+}
+
+// New creates a new Go runtime
+func New() *Go {
+	return &Go{
+		version: defaultVersion,
+	}
+}
+
+// IgnoreDirectories is a list of directories to ignore when packaging the runtime for deployment.
+func (g *Go) IgnoreDirectories() []string {
+	return []string{"vendor", "bin", "dist"}
+}
+
+// InstallProjectDependencies runs `go mod download` in the dirPath
+func (g *Go) InstallProjectDependencies(
+	ctx context.Context,
+	dirPath string,
+	hookExecutor hooks.HookExecutor,
+	ios iostreams.IOStreamer,
+	fs afero.Fs,
+	os types.Os,
+) (string, error) {
+	// Check that the go toolchain is installed on the system
+	if _, err := os.LookPath("go"); err != nil {
+		return "", slackerror.Wrap(err, slackerror.ErrGoNotFound)
+	}
+
+	// Skip directories without a module, such as a prepared package
+	if _, err := fs.Stat(filepath.Join(dirPath, goModFileName)); err != nil {
+		return fmt.Sprintf("Skipped installing dependencies without a %s", style.Highlight(goModFileName)), nil
+	}
+
+	// Internal hook implementation with a preferred download command
+	//
+	// An internal hook is used for streaming install outputs to debug logs
+	stdout := bytes.Buffer{}
+	hookExecOpts := hooks.HookExecOpts{
+		Hook: hooks.HookScript{
+			Name:    "InstallProjectDependencies",
+			Command: fmt.Sprintf(`go -C "%s" mod download`, dirPath),
+		},
+		Stdin:     ios.ReadIn(),
+		Stdout:    &stdout,
+		Directory: dirPath,
+	}
+	if _, err := hookExecutor.Execute(ctx, hookExecOpts); err != nil {
+		ios.PrintDebug(ctx, "failed to download project dependencies: %s", err)
+		return fmt.Sprintf("Error installing dependencies using %s", style.Highlight("go mod download")), err
+	}
+	return fmt.Sprintf("Installed dependencies using %s", style.Highlight("go mod download")), nil
+}
+
+// Name prints the name of the runtime
+func (g *Go) Name() string {
+	return "Go"
+}
+
+// Version is the runtime version used by the hosted app deployment
+func (g *Go) Version() string {
+	if g.version == "" {
+		g.version = defaultVersion
+	}
+	return g.version
+}
+
+// SetVersion sets the Version value
+func (g *Go) SetVersion(version string) {
+	g.version = version
+}
+
+// HooksJSONTemplate returns the default hooks.json template
+func (g *Go) HooksJSONTemplate() []byte {
+	return hooksJSON
+}
+
+// PreparePackage builds the app in srcDirPath to a binary in dstDirPath as a
+// release-ready bundle. A "build" hook from the project is used instead when
+// one is provided.
+func (g *Go) PreparePackage(ctx context.Context, sdkConfig hooks.SDKCLIConfig, hookExecutor hooks.HookExecutor, opts types.PreparePackageOpts) error {
+	var packageHookOpts = hooks.HookExecOpts{
+		Directory: opts.SrcDirPath,
+		Args: map[string]string{
+			"source": opts.SrcDirPath,
+			"output": opts.DstDirPath,
+		},
+		Hook: sdkConfig.Hooks.BuildProject,
+	}
+	if !sdkConfig.Hooks.BuildProject.IsAvailable() {
+		packageHookOpts.Args = map[string]string{}
+		packageHookOpts.Env = map[string]string{
+			"CGO_ENABLED": "0",
+		}
+		packageHookOpts.Hook = hooks.HookScript{
+			Name:    "BuildProject",
+			Command: fmt.Sprintf(`go -C "%s" build -o "%s" .`, opts.SrcDirPath, filepath.Join(opts.DstDirPath, binaryFileName)),
+		}
+	}
+
+	// Execute the build and ignore the output because the binary is written to the dstDirPath
+	_, err := hookExecutor.Execute(ctx, packageHookOpts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsRuntimeForProject returns true if dirPath is a Go project
+func IsRuntimeForProject(ctx context.Context, fs afero.Fs, dirPath string, sdkConfig hooks.SDKCLIConfig) bool {
+	// Is Go project when app manifest says so
+	if strings.HasPrefix(sdkConfig.Runtime, "go") {
+		return true
+	}
+
+	// Go projects must have a go.mod in the root dirPath
+	if _, err := fs.Stat(filepath.Join(dirPath, goModFileName)); err == nil {
+		return true
+	}
+
+	return false
+}
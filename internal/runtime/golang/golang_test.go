@@ -0,0 +1,299 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/config"
+	"github.com/toughtackle/slack-cli/internal/hooks"
+	"github.com/toughtackle/slack-cli/internal/iostreams"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Go_New(t *testing.T) {
+	g := New()
+	require.Equal(t, &Go{version: defaultVersion}, g)
+}
+
+func Test_Go_IgnoreDirectories(t *testing.T) {
+	g := New()
+	require.Equal(t, []string{"vendor", "bin", "dist"}, g.IgnoreDirectories())
+}
+
+func Test_Go_InstallProjectDependencies(t *testing.T) {
+	tests := []struct {
+		name                      string
+		lookPathError             error
+		hookExecutorError         error
+		existingFilePaths         []string
+		expectedError             error
+		expectedResponse          string
+		expectedCommand           string
+		expectedHookExecutorCalls int
+	}{
+		{
+			name:                      "Go executable not found",
+			lookPathError:             exec.ErrNotFound,
+			existingFilePaths:         []string{"go.mod"},
+			expectedError:             slackerror.Wrap(exec.ErrNotFound, slackerror.ErrGoNotFound),
+			expectedResponse:          "",
+			expectedHookExecutorCalls: 0,
+		},
+		{
+			name:                      "Skip without a go.mod file",
+			existingFilePaths:         []string{"app"},
+			expectedError:             nil,
+			expectedResponse:          "Skipped installing dependencies",
+			expectedHookExecutorCalls: 0,
+		},
+		{
+			name:                      "Download modules when a go.mod file exists",
+			existingFilePaths:         []string{"go.mod", "go.sum"},
+			expectedError:             nil,
+			expectedResponse:          "Installed dependencies using go mod download",
+			expectedCommand:           `go -C "/path/to/project-name" mod download`,
+			expectedHookExecutorCalls: 1,
+		},
+		{
+			name:                      "Error when downloading modules fails",
+			hookExecutorError:         slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			existingFilePaths:         []string{"go.mod"},
+			expectedError:             slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			expectedResponse:          "Error installing dependencies using go mod download",
+			expectedHookExecutorCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			ctx := slackcontext.MockContext(t.Context())
+			projectDirPath := "/path/to/project-name"
+
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.On("LookPath", mock.Anything).Return("", tt.lookPathError)
+			os.AddDefaultMocks()
+
+			cfg := config.NewConfig(fs, os)
+
+			ios := iostreams.NewIOStreamsMock(cfg, fs, os)
+			ios.AddDefaultMocks()
+
+			mockHookExecutor := &hooks.MockHookExecutor{}
+			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("text output", tt.hookExecutorError)
+
+			// Create files
+			for _, filePath := range tt.existingFilePaths {
+				filePathAbs := filepath.Join(projectDirPath, filePath)
+				// Create the directory
+				if err := fs.MkdirAll(filepath.Dir(filePathAbs), 0755); err != nil {
+					require.FailNow(t, fmt.Sprintf("Failed to create the directory %s in the memory-based file system", filePath))
+				}
+				// Create the file
+				if err := afero.WriteFile(fs, filePathAbs, []byte("mock file data"), 0644); err != nil {
+					require.FailNow(t, fmt.Sprintf("Failed to create the file %s in the memory-based file system", filePath))
+				}
+			}
+
+			// Test
+			g := New()
+			response, err := g.InstallProjectDependencies(ctx, projectDirPath, mockHookExecutor, ios, fs, os)
+
+			// Assertions
+			require.Contains(t, response, tt.expectedResponse)
+			require.Equal(t, tt.expectedError, err)
+			mockHookExecutor.AssertNumberOfCalls(t, "Execute", tt.expectedHookExecutorCalls)
+			if tt.expectedCommand != "" {
+				opts := mockHookExecutor.Calls[0].Arguments.Get(1).(hooks.HookExecOpts)
+				require.Equal(t, tt.expectedCommand, opts.Hook.Command)
+			}
+		})
+	}
+}
+
+func Test_Go_Name(t *testing.T) {
+	g := New()
+	require.Equal(t, "Go", g.Name())
+}
+
+func Test_Go_Version(t *testing.T) {
+	tests := []struct {
+		name            string
+		golang          *Go
+		expectedVersion string
+	}{
+		{
+			name:            "Default version",
+			golang:          New(),
+			expectedVersion: defaultVersion,
+		},
+		{
+			name:            "Custom version",
+			golang:          &Go{version: "go1.24"},
+			expectedVersion: "go1.24",
+		},
+		{
+			name:            "Undefined version",
+			golang:          &Go{version: ""},
+			expectedVersion: defaultVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedVersion, tt.golang.Version())
+		})
+	}
+}
+
+func Test_Go_SetVersion(t *testing.T) {
+	g := New()
+	g.SetVersion("go1.24")
+	require.Equal(t, "go1.24", g.Version())
+}
+
+func Test_Go_HooksJSONTemplate(t *testing.T) {
+	var sdkConfig hooks.SDKCLIConfig
+	err := json.Unmarshal(New().HooksJSONTemplate(), &sdkConfig)
+	require.NoError(t, err)
+	require.True(t, sdkConfig.Hooks.Start.IsAvailable())
+	require.True(t, sdkConfig.Hooks.GetManifest.IsAvailable())
+	require.True(t, sdkConfig.Config.SDKManagedConnection)
+}
+
+func Test_Go_PreparePackage(t *testing.T) {
+	tests := []struct {
+		name                        string
+		buildProject                hooks.HookScript
+		hookExecutorError           error
+		expectedCommand             string
+		expectedEnv                 map[string]string
+		expectedPreparePackageError error
+	}{
+		{
+			name:            "Build a binary without a build hook",
+			expectedCommand: fmt.Sprintf(`go -C "src/dir/path" build -o "%s" .`, filepath.Join("dst/dir/path", "app")),
+			expectedEnv:     map[string]string{"CGO_ENABLED": "0"},
+		},
+		{
+			name: "Use the build hook of the project",
+			buildProject: hooks.HookScript{
+				Name:    "BuildProject",
+				Command: "./hook-script/build-project",
+			},
+			expectedCommand: "./hook-script/build-project",
+		},
+		{
+			name:                        "Hook error",
+			hookExecutorError:           slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			expectedCommand:             fmt.Sprintf(`go -C "src/dir/path" build -o "%s" .`, filepath.Join("dst/dir/path", "app")),
+			expectedEnv:                 map[string]string{"CGO_ENABLED": "0"},
+			expectedPreparePackageError: slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+
+			// Setup SDKConfig
+			mockSDKConfig := hooks.NewSDKConfigMock()
+			mockSDKConfig.Hooks.BuildProject = tt.buildProject
+
+			// Setup HookExecutor
+			mockHookExecutor := &hooks.MockHookExecutor{}
+			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("text output", tt.hookExecutorError)
+
+			// Setup
+			mockOpts := types.PreparePackageOpts{}
+			mockOpts.SrcDirPath = "src/dir/path"
+			mockOpts.DstDirPath = "dst/dir/path"
+
+			// Run tests
+			g := New()
+			err := g.PreparePackage(ctx, mockSDKConfig, mockHookExecutor, mockOpts)
+
+			// Assertions
+			require.Equal(t, tt.expectedPreparePackageError, err)
+			opts := mockHookExecutor.Calls[0].Arguments.Get(1).(hooks.HookExecOpts)
+			require.Equal(t, tt.expectedCommand, opts.Hook.Command)
+			require.Equal(t, tt.expectedEnv, opts.Env)
+		})
+	}
+}
+
+func Test_Go_IsRuntimeForProject(t *testing.T) {
+	tests := []struct {
+		name              string
+		sdkConfigRuntime  string
+		existingFilePaths []string
+		expectedBool      bool
+	}{
+		{
+			name:              "Not a Go project",
+			sdkConfigRuntime:  "", // Unset to check for file
+			existingFilePaths: []string{},
+			expectedBool:      false,
+		},
+		{
+			name:              "SDKConfig Runtime is Go",
+			sdkConfigRuntime:  "go",
+			existingFilePaths: []string{}, // Unset to check SDKConfig
+			expectedBool:      true,
+		},
+		{
+			name:              "go.mod file exists",
+			sdkConfigRuntime:  "", // Unset to check for file
+			existingFilePaths: []string{"go.mod"},
+			expectedBool:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			ctx := slackcontext.MockContext(t.Context())
+			fs := slackdeps.NewFsMock()
+			projectDirPath := "/path/to/project-name"
+
+			// Create files
+			for _, filePath := range tt.existingFilePaths {
+				filePathAbs := filepath.Join(projectDirPath, filePath)
+				// Create the directory
+				if err := fs.MkdirAll(filepath.Dir(filePathAbs), 0755); err != nil {
+					require.FailNow(t, fmt.Sprintf("Failed to create the directory %s in the memory-based file system", filePath))
+				}
+				// Create the file
+				if err := afero.WriteFile(fs, filePathAbs, []byte("mock file data"), 0644); err != nil {
+					require.FailNow(t, fmt.Sprintf("Failed to create the file %s in the memory-based file system", filePath))
+				}
+			}
+
+			// Test
+			b := IsRuntimeForProject(ctx, fs, projectDirPath, hooks.SDKCLIConfig{Runtime: tt.sdkConfigRuntime})
+
+			// Assertions
+			require.Equal(t, tt.expectedBool, b)
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package python
 
 import (
+	"bytes"
 	"context"
 	_ "embed"
 	"fmt"
@@ -61,7 +62,9 @@ func (p *Python) IgnoreDirectories() []string {
 	return []string{}
 }
 
-// InstallProjectDependencies is unsupported by Python because a virtual environment is required before installing the project dependencies.
+// InstallProjectDependencies creates a virtual environment for the project if one does not exist then installs
+// the dependencies of requirements.txt or pyproject.toml into it. Dependencies are only installed again after
+// these files change.
 // TODO(@mbrooks) - should we confirm that the project is using Bolt Python?
 func (p *Python) InstallProjectDependencies(ctx context.Context, projectDirPath string, hookExecutor hooks.HookExecutor, ios iostreams.IOStreamer, fs afero.Fs, os types.Os) (output string, err error) {
 	var outputs []string
@@ -70,22 +73,24 @@ func (p *Python) InstallProjectDependencies(ctx context.Context, projectDirPath
 	// Defer a function to transform the return values
 	defer func() {
 		// Manual steps to setup virtual environment and install dependencies
-		var activateVirtualEnv = "source .venv/bin/activate"
-		if runtime.GOOS == "windows" {
-			activateVirtualEnv = `.venv\Scripts\activate`
-		}
-
-		// Get the relative path to the project directory
-		var projectDirPathRel, _ = getProjectDirRelPath(os, os.GetExecutionDir(), projectDirPath)
-
-		outputs = append(outputs, fmt.Sprintf("Manually setup a %s", style.Highlight("Python virtual environment")))
-		if projectDirPathRel != "." {
-			outputs = append(outputs, fmt.Sprintf("  Change into the project: %s", style.CommandText(fmt.Sprintf("cd %s%s", filepath.Base(projectDirPathRel), string(filepath.Separator)))))
+		if len(errs) > 0 {
+			var activateVirtualEnv = "source .venv/bin/activate"
+			if runtime.GOOS == "windows" {
+				activateVirtualEnv = `.venv\Scripts\activate`
+			}
+
+			// Get the relative path to the project directory
+			var projectDirPathRel, _ = getProjectDirRelPath(os, os.GetExecutionDir(), projectDirPath)
+
+			outputs = append(outputs, fmt.Sprintf("Manually setup a %s", style.Highlight("Python virtual environment")))
+			if projectDirPathRel != "." {
+				outputs = append(outputs, fmt.Sprintf("  Change into the project: %s", style.CommandText(fmt.Sprintf("cd %s%s", filepath.Base(projectDirPathRel), string(filepath.Separator)))))
+			}
+			outputs = append(outputs, fmt.Sprintf("  Create virtual environment: %s", style.CommandText("python3 -m venv .venv")))
+			outputs = append(outputs, fmt.Sprintf("  Activate virtual environment: %s", style.CommandText(activateVirtualEnv)))
+			outputs = append(outputs, fmt.Sprintf("  Install project dependencies: %s", style.CommandText("pip install -r requirements.txt")))
+			outputs = append(outputs, fmt.Sprintf("  Learn more: %s", style.Underline("https://docs.python.org/3/tutorial/venv.html")))
 		}
-		outputs = append(outputs, fmt.Sprintf("  Create virtual environment: %s", style.CommandText("python3 -m venv .venv")))
-		outputs = append(outputs, fmt.Sprintf("  Activate virtual environment: %s", style.CommandText(activateVirtualEnv)))
-		outputs = append(outputs, fmt.Sprintf("  Install project dependencies: %s", style.CommandText("pip install -r requirements.txt")))
-		outputs = append(outputs, fmt.Sprintf("  Learn more: %s", style.Underline("https://docs.python.org/3/tutorial/venv.html")))
 
 		// Get first error or nil
 		var firstErr error
@@ -98,22 +103,84 @@ func (p *Python) InstallProjectDependencies(ctx context.Context, projectDirPath
 		err = firstErr
 	}()
 
-	// Read requirements.txt
-	var requirementsFilePath = filepath.Join(projectDirPath, "requirements.txt")
+	// Add slack-cli-hooks to requirements.txt unless only pyproject.toml is used
+	var requirementsFilePath = filepath.Join(projectDirPath, requirementsFileName)
+	var pyprojectFilePath = filepath.Join(projectDirPath, pyprojectFileName)
+	_, requirementsErr := fs.Stat(requirementsFilePath)
+	_, pyprojectErr := fs.Stat(pyprojectFilePath)
+	usesPyproject := requirementsErr != nil && pyprojectErr == nil
+	if !usesPyproject {
+		if updateErr := addSlackCLIHooksRequirement(fs, requirementsFilePath, &outputs); updateErr != nil {
+			errs = append(errs, updateErr)
+			return
+		}
+	}
+
+	// Create the virtual environment
+	venvPath := virtualEnvPath(projectDirPath)
+	if virtualEnvExists(fs, venvPath) {
+		outputs = append(outputs, fmt.Sprintf("Found virtual environment %s", style.Highlight(virtualEnvDirName)))
+	} else {
+		pythonCommand := "python3"
+		if runtime.GOOS == "windows" {
+			pythonCommand = "python"
+		}
+		command := fmt.Sprintf(`%s -m venv "%s"`, pythonCommand, venvPath)
+		if venvErr := executeInstallCommand(ctx, command, projectDirPath, hookExecutor, ios); venvErr != nil {
+			errs = append(errs, venvErr)
+			outputs = append(outputs, fmt.Sprintf("Error creating virtual environment using %s", style.Highlight(pythonCommand+" -m venv .venv")))
+			return
+		}
+		outputs = append(outputs, fmt.Sprintf("Created virtual environment %s", style.Highlight(virtualEnvDirName)))
+	}
 
+	// Install dependencies when requirements changed since the last install
+	hash, hashErr := requirementsHash(fs, projectDirPath)
+	if hashErr != nil {
+		errs = append(errs, hashErr)
+		return
+	}
+	if !IsVirtualEnvStale(fs, projectDirPath) {
+		outputs = append(outputs, fmt.Sprintf("Found dependencies installed in %s", style.Highlight(virtualEnvDirName)))
+		ActivateVirtualEnv(fs, os, projectDirPath)
+		return
+	}
+	pythonPath := filepath.Join(virtualEnvBinPath(venvPath), "python")
+	installArgs := fmt.Sprintf(`-m pip install -r "%s"`, requirementsFilePath)
+	installCommand := "pip install -r requirements.txt"
+	if usesPyproject {
+		installArgs = fmt.Sprintf(`-m pip install "%s" "%s"`, projectDirPath, slackCLIHooksPackageSpecifier)
+		installCommand = "pip install ."
+	}
+	if installErr := executeInstallCommand(ctx, shellCommand(pythonPath, installArgs), projectDirPath, hookExecutor, ios); installErr != nil {
+		errs = append(errs, installErr)
+		outputs = append(outputs, fmt.Sprintf("Error installing dependencies using %s", style.Highlight(installCommand)))
+		return
+	}
+	if hashErr := afero.WriteFile(fs, filepath.Join(venvPath, requirementsHashFileName), []byte(hash), 0644); hashErr != nil {
+		ios.PrintDebug(ctx, "Error saving the hash of installed dependencies: %s", hashErr)
+	}
+	outputs = append(outputs, fmt.Sprintf("Installed dependencies using %s", style.Highlight(installCommand)))
+
+	// Run later hooks inside the virtual environment
+	ActivateVirtualEnv(fs, os, projectDirPath)
+	return
+}
+
+// addSlackCLIHooksRequirement adds slack-cli-hooks to requirements.txt if it is not declared
+func addSlackCLIHooksRequirement(fs afero.Fs, requirementsFilePath string, outputs *[]string) error {
 	file, err := afero.ReadFile(fs, requirementsFilePath)
 	if err != nil {
-		errs = append(errs, err)
-		outputs = append(outputs, fmt.Sprintf("Error reading requirements.txt: %s", err))
-		return
+		*outputs = append(*outputs, fmt.Sprintf("Error reading requirements.txt: %s", err))
+		return err
 	}
 
 	fileData := string(file)
 
 	// Skip when slack-cli-hooks is already declared in requirements.txt
 	if strings.Contains(fileData, slackCLIHooksPackageName) {
-		outputs = append(outputs, fmt.Sprintf("Found requirements.txt with %s", style.Highlight(slackCLIHooksPackageName)))
-		return
+		*outputs = append(*outputs, fmt.Sprintf("Found requirements.txt with %s", style.Highlight(slackCLIHooksPackageName)))
+		return nil
 	}
 
 	// Add slack-cli-hooks to requirements.txt
@@ -133,13 +200,41 @@ func (p *Python) InstallProjectDependencies(ctx context.Context, projectDirPath
 	// Save requirements.txt
 	err = afero.WriteFile(fs, requirementsFilePath, []byte(fileData), 0644)
 	if err != nil {
-		errs = append(errs, err)
-		outputs = append(outputs, fmt.Sprintf("Error updating requirements.txt: %s", err))
-	} else {
-		outputs = append(outputs, fmt.Sprintf("Updated requirements.txt with %s", style.Highlight(slackCLIHooksPackageSpecifier)))
+		*outputs = append(*outputs, fmt.Sprintf("Error updating requirements.txt: %s", err))
+		return err
 	}
+	*outputs = append(*outputs, fmt.Sprintf("Updated requirements.txt with %s", style.Highlight(slackCLIHooksPackageSpecifier)))
+	return nil
+}
 
-	return
+// installExecutor returns an executor that runs install commands as written.
+// These commands are not hooks of the SDK so the arguments of other protocols
+// must not be added.
+func installExecutor(hookExecutor hooks.HookExecutor, ios iostreams.IOStreamer) hooks.HookExecutor {
+	switch hookExecutor.(type) {
+	case *hooks.HookExecutorMessageBoundaryProtocol, *hooks.HookExecutorServerProtocol:
+		return &hooks.HookExecutorDefaultProtocol{IO: ios}
+	}
+	return hookExecutor
+}
+
+// executeInstallCommand runs a command that sets up the project with outputs streamed to debug logs
+func executeInstallCommand(ctx context.Context, command string, projectDirPath string, hookExecutor hooks.HookExecutor, ios iostreams.IOStreamer) error {
+	stdout := bytes.Buffer{}
+	hookExecOpts := hooks.HookExecOpts{
+		Hook: hooks.HookScript{
+			Name:    "InstallProjectDependencies",
+			Command: command,
+		},
+		Stdin:     ios.ReadIn(),
+		Stdout:    &stdout,
+		Directory: projectDirPath,
+	}
+	if _, err := installExecutor(hookExecutor, ios).Execute(ctx, hookExecOpts); err != nil {
+		ios.PrintDebug(ctx, "Error executing '%s': %s", command, err)
+		return err
+	}
+	return nil
 }
 
 // Name prints the name of the runtime
@@ -20,12 +20,14 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/toughtackle/slack-cli/internal/cache"
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -69,11 +71,13 @@ func Test_Python_IgnoreDirectories(t *testing.T) {
 
 func Test_Python_InstallProjectDependencies(t *testing.T) {
 	tests := []struct {
-		name            string
-		existingFiles   map[string]string
-		expectedFiles   map[string]string
-		expectedOutputs string
-		expectedError   bool
+		name             string
+		existingFiles    map[string]string
+		expectedFiles    map[string]string
+		executeErr       error
+		expectedCommands []string
+		expectedOutputs  string
+		expectedError    bool
 	}{
 		{
 			name:            "Error when requirements.txt is missing",
@@ -159,13 +163,47 @@ func Test_Python_InstallProjectDependencies(t *testing.T) {
 			expectedError:   false,
 		},
 		{
-			name: "Should output help text because installing project dependencies is unsupported",
+			name: "Should output help text when the virtual environment cannot be created",
 			existingFiles: map[string]string{
 				"requirements.txt": "slack-cli-hooks\npytest==8.3.2\nruff==0.7.2",
 			},
-			expectedError:   false,
+			executeErr:      slackerror.New(slackerror.ErrSDKHookInvocationFailed),
+			expectedError:   true,
 			expectedOutputs: "Manually setup a Python virtual environment",
 		},
+		{
+			name: "Create a virtual environment and install requirements.txt",
+			existingFiles: map[string]string{
+				"requirements.txt": "slack-cli-hooks\nslack-bolt==2.31.2",
+			},
+			expectedCommands: []string{"-m venv", "-m pip install -r"},
+			expectedOutputs:  "Created virtual environment .venv\nInstalled dependencies using pip install -r requirements.txt",
+			expectedError:    false,
+		},
+		{
+			name: "Install pyproject.toml when requirements.txt is missing",
+			existingFiles: map[string]string{
+				"pyproject.toml":   "[project]\ndependencies = [\"slack-bolt\"]",
+				".venv/pyvenv.cfg": "home = /usr/bin",
+			},
+			expectedFiles: map[string]string{
+				"pyproject.toml": "[project]\ndependencies = [\"slack-bolt\"]",
+			},
+			expectedCommands: []string{"-m pip install \"/path/to/project-name\" \"slack-cli-hooks<1.0.0\""},
+			expectedOutputs:  "Found virtual environment .venv\nInstalled dependencies using pip install .",
+			expectedError:    false,
+		},
+		{
+			name: "Skip installing when the requirements have not changed",
+			existingFiles: map[string]string{
+				"requirements.txt":               "slack-cli-hooks",
+				".venv/pyvenv.cfg":               "home = /usr/bin",
+				".venv/.slack-requirements-hash": string(cache.NewHash([]byte("requirements.txt\nslack-cli-hooks"))),
+			},
+			expectedCommands: []string{},
+			expectedOutputs:  "Found dependencies installed in .venv",
+			expectedError:    false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -176,9 +214,10 @@ func Test_Python_InstallProjectDependencies(t *testing.T) {
 			os.AddDefaultMocks()
 			cfg := config.NewConfig(fs, os)
 			ios := iostreams.NewIOStreamsMock(cfg, fs, os)
+			ios.AddDefaultMocks()
 
 			mockHookExecutor := &hooks.MockHookExecutor{}
-			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("text output", nil)
+			mockHookExecutor.On("Execute", mock.Anything, mock.Anything).Return("text output", tt.executeErr)
 
 			projectDirPath := "/path/to/project-name"
 
@@ -209,6 +248,17 @@ func Test_Python_InstallProjectDependencies(t *testing.T) {
 
 			require.Contains(t, outputs, tt.expectedOutputs)
 
+			if tt.expectedCommands != nil {
+				commands := []string{}
+				for _, call := range mockHookExecutor.Calls {
+					commands = append(commands, call.Arguments.Get(1).(hooks.HookExecOpts).Hook.Command)
+				}
+				require.Len(t, commands, len(tt.expectedCommands))
+				for i, command := range tt.expectedCommands {
+					require.Contains(t, commands[i], command)
+				}
+			}
+
 			if tt.expectedError {
 				require.Error(t, err)
 			} else {
@@ -218,6 +268,38 @@ func Test_Python_InstallProjectDependencies(t *testing.T) {
 	}
 }
 
+func Test_Python_executeInstallCommand(t *testing.T) {
+	tests := map[string]struct {
+		hookExecutor func(ios iostreams.IOStreamer) hooks.HookExecutor
+	}{
+		"runs the command without protocol arguments for the default protocol": {
+			hookExecutor: func(ios iostreams.IOStreamer) hooks.HookExecutor {
+				return &hooks.HookExecutorDefaultProtocol{IO: ios}
+			},
+		},
+		"runs the command without protocol arguments for the message boundary protocol": {
+			hookExecutor: func(ios iostreams.IOStreamer) hooks.HookExecutor {
+				return &hooks.HookExecutorMessageBoundaryProtocol{IO: ios}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			fs := slackdeps.NewFsMock()
+			os := slackdeps.NewOsMock()
+			os.AddDefaultMocks()
+			cfg := config.NewConfig(fs, os)
+			ios := iostreams.NewIOStreamsMock(cfg, fs, os)
+			ios.AddDefaultMocks()
+
+			// The test command errors if any arguments are appended to it
+			err := executeInstallCommand(ctx, "test 1 -eq 1", t.TempDir(), tt.hookExecutor(ios), ios)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func Test_Python_Name(t *testing.T) {
 	p := New()
 	require.Equal(t, "Python", p.Name())
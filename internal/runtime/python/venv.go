@@ -0,0 +1,123 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/toughtackle/slack-cli/internal/cache"
+	"github.com/toughtackle/slack-cli/internal/shared/types"
+	"github.com/spf13/afero"
+)
+
+const (
+	// virtualEnvDirName is the directory of the project virtual environment
+	virtualEnvDirName = ".venv"
+
+	// requirementsHashFileName saves the hash of the installed dependencies in the virtual environment
+	requirementsHashFileName = ".slack-requirements-hash"
+
+	// requirementsFileName lists the project dependencies
+	requirementsFileName = "requirements.txt"
+
+	// pyprojectFileName declares the project dependencies when requirements.txt is missing
+	pyprojectFileName = "pyproject.toml"
+)
+
+// virtualEnvPath returns the path of the virtual environment for a project
+func virtualEnvPath(projectDirPath string) string {
+	return filepath.Join(projectDirPath, virtualEnvDirName)
+}
+
+// virtualEnvBinPath returns the directory with executables of a virtual environment
+func virtualEnvBinPath(venvPath string) string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(venvPath, "Scripts")
+	}
+	return filepath.Join(venvPath, "bin")
+}
+
+// virtualEnvExists returns true if a virtual environment was created at venvPath
+func virtualEnvExists(fs afero.Fs, venvPath string) bool {
+	_, err := fs.Stat(filepath.Join(venvPath, "pyvenv.cfg"))
+	return err == nil
+}
+
+// requirementsHash returns a hash of the files that declare project dependencies
+func requirementsHash(fs afero.Fs, projectDirPath string) (cache.Hash, error) {
+	contents := []byte{}
+	found := false
+	for _, name := range []string{requirementsFileName, pyprojectFileName} {
+		data, err := afero.ReadFile(fs, filepath.Join(projectDirPath, name))
+		if err != nil {
+			continue
+		}
+		found = true
+		contents = append(contents, []byte(name+"\n")...)
+		contents = append(contents, data...)
+	}
+	if !found {
+		return "", fmt.Errorf("neither %s nor %s were found", requirementsFileName, pyprojectFileName)
+	}
+	return cache.NewHash(contents), nil
+}
+
+// IsVirtualEnvStale returns true if the project virtual environment is missing
+// or the project dependencies changed since these were last installed
+func IsVirtualEnvStale(fs afero.Fs, projectDirPath string) bool {
+	hash, err := requirementsHash(fs, projectDirPath)
+	if err != nil {
+		return false
+	}
+	venvPath := virtualEnvPath(projectDirPath)
+	if !virtualEnvExists(fs, venvPath) {
+		return true
+	}
+	saved, err := afero.ReadFile(fs, filepath.Join(venvPath, requirementsHashFileName))
+	if err != nil {
+		return true
+	}
+	return !hash.Equals(cache.Hash(saved))
+}
+
+// ActivateVirtualEnv runs commands started by this process, including hooks,
+// inside the project virtual environment if one exists
+func ActivateVirtualEnv(fs afero.Fs, os types.Os, projectDirPath string) bool {
+	venvPath := virtualEnvPath(projectDirPath)
+	if !virtualEnvExists(fs, venvPath) {
+		return false
+	}
+	if os.Getenv("VIRTUAL_ENV") == venvPath {
+		return true
+	}
+	path := virtualEnvBinPath(venvPath)
+	if current := os.Getenv("PATH"); current != "" {
+		path = path + string(filepath.ListSeparator) + current
+	}
+	_ = os.Setenv("VIRTUAL_ENV", venvPath)
+	_ = os.Setenv("PATH", path)
+	return true
+}
+
+// shellCommand returns a command that runs the executable at path with the
+// current shell
+func shellCommand(path string, args string) string {
+	if runtime.GOOS == "windows" {
+		return fmt.Sprintf(`& "%s" %s`, path, args)
+	}
+	return fmt.Sprintf(`"%s" %s`, path, args)
+}
@@ -0,0 +1,112 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/toughtackle/slack-cli/internal/slackdeps"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsVirtualEnvStale(t *testing.T) {
+	projectDirPath := "/path/to/project-name"
+	tests := map[string]struct {
+		files    map[string]string
+		expected bool
+	}{
+		"not stale without files that declare dependencies": {
+			files:    map[string]string{},
+			expected: false,
+		},
+		"stale without a virtual environment": {
+			files:    map[string]string{"requirements.txt": "slack-bolt"},
+			expected: true,
+		},
+		"stale without a hash of installed dependencies": {
+			files: map[string]string{
+				"requirements.txt": "slack-bolt",
+				".venv/pyvenv.cfg": "",
+			},
+			expected: true,
+		},
+		"stale when the requirements changed": {
+			files: map[string]string{
+				"requirements.txt":               "slack-bolt\nslack-sdk",
+				".venv/pyvenv.cfg":               "",
+				".venv/.slack-requirements-hash": "outdated",
+			},
+			expected: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := slackdeps.NewFsMock()
+			for path, data := range tt.files {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(projectDirPath, path), []byte(data), 0644))
+			}
+			assert.Equal(t, tt.expected, IsVirtualEnvStale(fs, projectDirPath))
+		})
+	}
+
+	t.Run("not stale after the requirements are installed", func(t *testing.T) {
+		fs := slackdeps.NewFsMock()
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(projectDirPath, "pyproject.toml"), []byte("[project]"), 0644))
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(projectDirPath, ".venv", "pyvenv.cfg"), []byte(""), 0644))
+		hash, err := requirementsHash(fs, projectDirPath)
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(projectDirPath, ".venv", ".slack-requirements-hash"), []byte(hash), 0644))
+		assert.False(t, IsVirtualEnvStale(fs, projectDirPath))
+	})
+}
+
+func Test_ActivateVirtualEnv(t *testing.T) {
+	projectDirPath := "/path/to/project-name"
+	venvPath := filepath.Join(projectDirPath, ".venv")
+
+	t.Run("skips projects without a virtual environment", func(t *testing.T) {
+		fs := slackdeps.NewFsMock()
+		os := slackdeps.NewOsMock()
+		os.AddDefaultMocks()
+		assert.False(t, ActivateVirtualEnv(fs, os, projectDirPath))
+		os.AssertNotCalled(t, "Setenv", mock.Anything, mock.Anything)
+	})
+
+	t.Run("prepends the virtual environment to the path", func(t *testing.T) {
+		fs := slackdeps.NewFsMock()
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(venvPath, "pyvenv.cfg"), []byte(""), 0644))
+		os := slackdeps.NewOsMock()
+		os.On("Getenv", "VIRTUAL_ENV").Return("")
+		os.On("Getenv", "PATH").Return("/usr/bin")
+		os.AddDefaultMocks()
+		assert.True(t, ActivateVirtualEnv(fs, os, projectDirPath))
+		os.AssertCalled(t, "Setenv", "VIRTUAL_ENV", venvPath)
+		os.AssertCalled(t, "Setenv", "PATH", virtualEnvBinPath(venvPath)+string(filepath.ListSeparator)+"/usr/bin")
+	})
+
+	t.Run("skips a virtual environment that is active", func(t *testing.T) {
+		fs := slackdeps.NewFsMock()
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(venvPath, "pyvenv.cfg"), []byte(""), 0644))
+		os := slackdeps.NewOsMock()
+		os.On("Getenv", "VIRTUAL_ENV").Return(venvPath)
+		os.AddDefaultMocks()
+		assert.True(t, ActivateVirtualEnv(fs, os, projectDirPath))
+		os.AssertNotCalled(t, "Setenv", mock.Anything, mock.Anything)
+	})
+}
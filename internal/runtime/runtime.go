@@ -22,6 +22,8 @@ import (
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/runtime/deno"
+	"github.com/toughtackle/slack-cli/internal/runtime/external"
+	"github.com/toughtackle/slack-cli/internal/runtime/golang"
 	"github.com/toughtackle/slack-cli/internal/runtime/node"
 	"github.com/toughtackle/slack-cli/internal/runtime/python"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
@@ -33,7 +35,7 @@ import (
 const DefaultRuntimeName = "deno"
 
 // Runtime interface generalizes the required functionality for any runtime language.
-// Example runtimes: Node.js, Deno, Python, Java, etc.
+// Example runtimes: Node.js, Deno, Python, Go, Java, etc.
 type Runtime interface {
 	IgnoreDirectories() []string
 	InstallProjectDependencies(context.Context, string, hooks.HookExecutor, iostreams.IOStreamer, afero.Fs, types.Os) (string, error)
@@ -59,6 +61,9 @@ func New(runtimeName string) (Runtime, error) {
 	case strings.HasPrefix(strings.ToLower(runtimeName), "python"): // python
 		rt = python.New()
 		rt.SetVersion(runtimeName) // Default to runtimeName for now
+	case strings.HasPrefix(strings.ToLower(runtimeName), "go"): // go, golang
+		rt = golang.New()
+		rt.SetVersion(runtimeName) // Default to runtimeName for now
 	default:
 		return nil, slackerror.New(slackerror.ErrRuntimeNotSupported).
 			WithMessage("This CLI does not support the '%s' runtime", runtimeName)
@@ -66,6 +71,16 @@ func New(runtimeName string) (Runtime, error) {
 	return rt, nil
 }
 
+// NewWithAdapter creates the built-in runtime for runtimeName and otherwise
+// falls back to the runtime adapter hooks declared in the project hooks.json
+func NewWithAdapter(ctx context.Context, runtimeName string, sdkConfig hooks.SDKCLIConfig, hookExecutor hooks.HookExecutor) (Runtime, error) {
+	rt, err := New(runtimeName)
+	if err == nil || !sdkConfig.RuntimeAdapter.IsAvailable() {
+		return rt, err
+	}
+	return external.New(ctx, runtimeName, sdkConfig.RuntimeAdapter, hookExecutor)
+}
+
 // NewDetectProject returns a new Runtime based on the project type of dirPath
 func NewDetectProject(ctx context.Context, fs afero.Fs, dirPath string, sdkConfig hooks.SDKCLIConfig) (Runtime, error) {
 	var rt Runtime
@@ -77,6 +92,8 @@ func NewDetectProject(ctx context.Context, fs afero.Fs, dirPath string, sdkConfi
 		rt, err = New("node")
 	case python.IsRuntimeForProject(ctx, fs, dirPath, sdkConfig):
 		rt, err = New("python")
+	case golang.IsRuntimeForProject(ctx, fs, dirPath, sdkConfig):
+		rt, err = New("go")
 	default:
 		return nil, slackerror.New(slackerror.ErrRuntimeNotSupported).
 			WithMessage("Failed to detect project runtime from directory structure")
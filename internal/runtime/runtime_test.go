@@ -19,6 +19,8 @@ import (
 
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/runtime/deno"
+	"github.com/toughtackle/slack-cli/internal/runtime/external"
+	"github.com/toughtackle/slack-cli/internal/runtime/golang"
 	"github.com/toughtackle/slack-cli/internal/runtime/node"
 	"github.com/toughtackle/slack-cli/internal/runtime/python"
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
@@ -47,6 +49,11 @@ func Test_Runtime_New(t *testing.T) {
 			runtime:             "python",
 			expectedRuntimeType: python.New(),
 		},
+		{
+			name:                "Go",
+			runtime:             "go",
+			expectedRuntimeType: golang.New(),
+		},
 		{
 			name:                "Unsupported Runtime",
 			runtime:             "biggly-boo",
@@ -83,6 +90,11 @@ func Test_Runtime_NewDetectProject(t *testing.T) {
 			sdkConfig:           hooks.SDKCLIConfig{Runtime: "python"},
 			expectedRuntimeType: python.New(),
 		},
+		{
+			name:                "Go",
+			sdkConfig:           hooks.SDKCLIConfig{Runtime: "go"},
+			expectedRuntimeType: golang.New(),
+		},
 		{
 			name:                "Unsupported Runtime",
 			sdkConfig:           hooks.SDKCLIConfig{Runtime: ""},
@@ -102,3 +114,49 @@ func Test_Runtime_NewDetectProject(t *testing.T) {
 		})
 	}
 }
+
+func Test_Runtime_NewWithAdapter(t *testing.T) {
+	adapter := hooks.RuntimeAdapter{Name: "Bun"}
+	tests := map[string]struct {
+		runtime             string
+		adapter             hooks.RuntimeAdapter
+		expectedRuntimeType Runtime
+		expectedError       bool
+	}{
+		"Built-in runtime ignores the adapter": {
+			runtime:             "node",
+			adapter:             adapter,
+			expectedRuntimeType: node.New(),
+		},
+		"Unsupported runtime uses the adapter": {
+			runtime:             "bun",
+			adapter:             adapter,
+			expectedRuntimeType: &external.External{},
+		},
+		"Unnamed runtime uses the adapter": {
+			runtime:             "",
+			adapter:             adapter,
+			expectedRuntimeType: &external.External{},
+		},
+		"Unsupported runtime without an adapter": {
+			runtime:             "bun",
+			expectedRuntimeType: nil,
+			expectedError:       true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			sdkConfig := hooks.SDKCLIConfig{RuntimeAdapter: tt.adapter}
+
+			rt, err := NewWithAdapter(ctx, tt.runtime, sdkConfig, &hooks.MockHookExecutor{})
+
+			require.IsType(t, tt.expectedRuntimeType, rt)
+			if tt.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/api"
 	"github.com/toughtackle/slack-cli/internal/app"
@@ -33,6 +34,7 @@ import (
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/iostreams"
 	"github.com/toughtackle/slack-cli/internal/runtime"
+	"github.com/toughtackle/slack-cli/internal/runtime/python"
 	"github.com/toughtackle/slack-cli/internal/shared/types"
 	"github.com/toughtackle/slack-cli/internal/slackdeps"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
@@ -165,10 +167,13 @@ func (c *ClientFactory) InitRuntime(ctx context.Context, dirPath string) error {
 	switch {
 	case len(strings.TrimSpace(c.Config.RuntimeFlag)) > 0:
 		method = "flag"
-		c.Runtime, err = runtime.New(c.Config.RuntimeFlag)
+		c.Runtime, err = runtime.NewWithAdapter(ctx, c.Config.RuntimeFlag, c.SDKConfig, c.HookExecutor)
 	case len(strings.TrimSpace(c.SDKConfig.Runtime)) > 0:
 		method = "hooks.json"
-		c.Runtime, err = runtime.New(c.SDKConfig.Runtime)
+		c.Runtime, err = runtime.NewWithAdapter(ctx, c.SDKConfig.Runtime, c.SDKConfig, c.HookExecutor)
+	case c.SDKConfig.RuntimeAdapter.IsAvailable():
+		method = "runtime adapter"
+		c.Runtime, err = runtime.NewWithAdapter(ctx, "", c.SDKConfig, c.HookExecutor)
 	default:
 		method = "auto-detect"
 		c.Runtime, err = runtime.NewDetectProject(ctx, c.Fs, dirPath, c.SDKConfig)
@@ -179,6 +184,17 @@ func (c *ClientFactory) InitRuntime(ctx context.Context, dirPath string) error {
 	}
 	c.IO.PrintDebug(ctx, "initialize runtime from %s: %s (%s)",
 		method, c.Runtime.Name(), c.Runtime.Version())
+
+	// Run hooks of Python projects with the project virtual environment
+	if _, ok := c.Runtime.(*python.Python); ok {
+		projectDirPath := c.SDKConfig.WorkingDirectory
+		if projectDirPath == "" {
+			projectDirPath = dirPath
+		}
+		if python.ActivateVirtualEnv(c.Fs, c.Os, projectDirPath) {
+			c.IO.PrintDebug(ctx, "activated the virtual environment of the project at %s", projectDirPath)
+		}
+	}
 	return nil
 }
 
@@ -305,7 +321,7 @@ func (c *ClientFactory) InitSDKConfigFromJSON(ctx context.Context, configFileByt
 
 	c.SDKConfig = config
 
-	// Reflect on the hooks struct to set the Name field for each hook
+	// Reflect on the hooks struct to set the Name and Timeout fields for each hook
 	hooks := reflect.ValueOf(&c.SDKConfig.Hooks).Elem()
 	fields := reflect.VisibleFields(reflect.TypeOf(c.SDKConfig.Hooks))
 	for _, field := range fields {
@@ -315,6 +331,11 @@ func (c *ClientFactory) InitSDKConfigFromJSON(ctx context.Context, configFileByt
 			if hookNamePointer.IsValid() && hookNamePointer.CanSet() {
 				hookNamePointer.SetString(field.Name)
 			}
+			hookKey, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			hookTimeoutPointer := hookPointer.FieldByName("Timeout")
+			if seconds, ok := c.SDKConfig.Config.HookTimeouts[hookKey]; ok && seconds > 0 && hookTimeoutPointer.CanSet() {
+				hookTimeoutPointer.SetInt(int64(time.Duration(seconds) * time.Second))
+			}
 		}
 	}
 
@@ -19,12 +19,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/internal/slackdeps"
 	"github.com/toughtackle/slack-cli/internal/slackerror"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -129,6 +131,41 @@ func Test_ClientFactory_InitSDKConfig(t *testing.T) {
 	}
 }
 
+func Test_ClientFactory_InitRuntime(t *testing.T) {
+	projectDirPath := filepath.Join(slackdeps.MockHomeDirectory, "project")
+	tests := map[string]struct {
+		runtime           string
+		expectedActivated bool
+	}{
+		"activates the virtual environment of python projects": {
+			runtime:           "python",
+			expectedActivated: true,
+		},
+		"skips the virtual environment of other projects": {
+			runtime:           "node",
+			expectedActivated: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := slackcontext.MockContext(t.Context())
+			clientsMock := NewClientsMock()
+			clientsMock.AddDefaultMocks()
+			clients := NewClientFactory(clientsMock.MockClientFactory())
+			err := afero.WriteFile(clients.Fs, filepath.Join(projectDirPath, ".venv", "pyvenv.cfg"), []byte(""), 0o600)
+			require.NoError(t, err)
+			clients.Config.RuntimeFlag = tt.runtime
+			err = clients.InitRuntime(ctx, projectDirPath)
+			require.NoError(t, err)
+			if tt.expectedActivated {
+				clientsMock.Os.AssertCalled(t, "Setenv", "VIRTUAL_ENV", filepath.Join(projectDirPath, ".venv"))
+			} else {
+				clientsMock.Os.AssertNotCalled(t, "Setenv", "VIRTUAL_ENV", mock.Anything)
+			}
+		})
+	}
+}
+
 func Test_ClientFactory_InitSDKConfigFromJSON(t *testing.T) {
 	ctx := slackcontext.MockContext(t.Context())
 	path := setupGetHooksScript(t)
@@ -213,6 +250,17 @@ func Test_ClientFactory_InitSDKConfigFromJSON_mergesExistingFile(t *testing.T) {
 	require.Equal(t, "foobar", string(clients.SDKConfig.Hooks.Start.Command))
 }
 
+func Test_ClientFactory_InitSDKConfigFromJSON_setsHookTimeouts(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clients := NewClientFactory()
+	getHooksJSON := `{"hooks":{"get-manifest": "echo {}", "start": "echo {}"},"config":{"hook-timeouts":{"get-manifest": 30}}}`
+	if err := clients.InitSDKConfigFromJSON(ctx, []byte(getHooksJSON)); err != nil {
+		t.Errorf("error init'ing SDK from JSON %s", err)
+	}
+	require.Equal(t, 30*time.Second, clients.SDKConfig.Hooks.GetManifest.Timeout)
+	require.Equal(t, time.Duration(0), clients.SDKConfig.Hooks.Start.Timeout)
+}
+
 func Test_ClientFactory_InitSDKConfigFromJSON_noGetHooks(t *testing.T) {
 	ctx := slackcontext.MockContext(t.Context())
 	clients := NewClientFactory()
@@ -30,6 +30,10 @@ type ActivityArgs struct {
 	ComponentID       string
 	Source            string
 	TraceID           string
+	GroupByTrace      bool
+	Save              bool
+	Alerts            string
+	OTLP              string
 }
 
 type ActivityLevel string
@@ -43,6 +47,28 @@ const (
 	FATAL ActivityLevel = "fatal"
 )
 
+// activityLevelOrder ranks log levels from least to most severe
+var activityLevelOrder = map[ActivityLevel]int{
+	TRACE: 0,
+	DEBUG: 1,
+	INFO:  2,
+	WARN:  3,
+	ERROR: 4,
+	FATAL: 5,
+}
+
+// IsValid returns true if the level is a known log level
+func (level ActivityLevel) IsValid() bool {
+	_, ok := activityLevelOrder[level]
+	return ok
+}
+
+// AtLeast returns true if the level is as severe or more severe than the
+// minimum level
+func (level ActivityLevel) AtLeast(minimum ActivityLevel) bool {
+	return activityLevelOrder[level] >= activityLevelOrder[minimum]
+}
+
 type EventType string
 
 const (
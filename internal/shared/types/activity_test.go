@@ -0,0 +1,62 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ActivityLevel_IsValid(t *testing.T) {
+	assert.True(t, TRACE.IsValid())
+	assert.True(t, FATAL.IsValid())
+	assert.False(t, ActivityLevel("loud").IsValid())
+	assert.False(t, ActivityLevel("").IsValid())
+}
+
+func Test_ActivityLevel_AtLeast(t *testing.T) {
+	tests := map[string]struct {
+		level    ActivityLevel
+		minimum  ActivityLevel
+		expected bool
+	}{
+		"a more severe level is at least the minimum": {
+			level:    ERROR,
+			minimum:  WARN,
+			expected: true,
+		},
+		"the same level is at least the minimum": {
+			level:    INFO,
+			minimum:  INFO,
+			expected: true,
+		},
+		"a less severe level is below the minimum": {
+			level:    DEBUG,
+			minimum:  INFO,
+			expected: false,
+		},
+		"an unknown level is ranked as the least severe": {
+			level:    ActivityLevel("loud"),
+			minimum:  DEBUG,
+			expected: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.level.AtLeast(tt.minimum))
+		})
+	}
+}
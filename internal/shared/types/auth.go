@@ -27,7 +27,8 @@ const AuthLevelWorkspace = "workspace"
 // particular Slack team (workspace or organization) on behalf of the authorizing
 // user.
 //
-// A SlackAuth is created by logging in with the CLI via the `login` command
+// A SlackAuth is created by logging in with the CLI via the `login` command and
+// can be given a profile name to save more than one user for the same team
 type SlackAuth struct {
 	Token               string    `json:"token,omitempty"`
 	TeamDomain          string    `json:"team_domain"`
@@ -39,6 +40,7 @@ type SlackAuth struct {
 	RefreshToken        string    `json:"refresh_token,omitempty"`
 	ExpiresAt           int       `json:"exp,omitempty"`
 	IsEnterpriseInstall bool      `json:"is_enterprise_install,omitempty"`
+	Profile             string    `json:"profile,omitempty"`
 }
 
 // AuthLevel returns the authorization level of a specific SlackAuth, e.g. organization or workspace level
@@ -82,18 +84,48 @@ const (
 
 // ShouldRotateToken returns true if an auth credential can be rotated and also expires in <= 5min
 func (a *SlackAuth) ShouldRotateToken() bool {
+	return a.TokenExpiresWithin(5 * time.Minute)
+}
+
+// CanRotateToken returns true if an auth credential has a refresh token that
+// can rotate an expiring token
+func (a *SlackAuth) CanRotateToken() bool {
 
 	// if ExpiresAt is 0, then the auth token is not one we can rotate
 	// if RefreshToken is empty, then we cannot rotate the token either
-	if a == nil || a.ExpiresAt == 0 || a.RefreshToken == "" {
+	return a != nil && a.ExpiresAt != 0 && a.RefreshToken != ""
+}
+
+// TokenExpiresWithin returns true if an auth credential can be rotated and
+// expires before the duration passes
+func (a *SlackAuth) TokenExpiresWithin(d time.Duration) bool {
+	if !a.CanRotateToken() {
 		return false
 	}
+	return time.Until(a.TokenExpiry()) <= d
+}
 
-	// refresh the token if it expires in the next 5 mins
-	timeToExpiration := a.ExpiresAt - int(time.Now().Unix())
-	fiveMinutes := 60 * 5 // in seconds
+// TokenExpiry returns the time an expiring auth credential expires or the zero
+// time if the token does not expire
+func (a *SlackAuth) TokenExpiry() time.Time {
+	if a == nil || a.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(a.ExpiresAt), 0)
+}
 
-	return timeToExpiration <= fiveMinutes
+// RefreshAhead returns how long before expiry a token should be refreshed,
+// which is the duration or half the lifetime of a token that lives for less.
+// The lifetime is the time between the last update and the expiry.
+func (a *SlackAuth) RefreshAhead(d time.Duration) time.Duration {
+	if a == nil || a.ExpiresAt == 0 || a.LastUpdated.IsZero() {
+		return d
+	}
+	lifetime := a.TokenExpiry().Sub(a.LastUpdated)
+	if lifetime <= 0 {
+		return d
+	}
+	return min(d, lifetime/2)
 }
 
 // TokenIsExpired returns true if an auth credential is expired or not
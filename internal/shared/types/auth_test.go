@@ -93,6 +93,36 @@ func Test_SlackAuth_ShouldRotateToken(t *testing.T) {
 	}
 }
 
+func Test_SlackAuth_RefreshAhead(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	tests := map[string]struct {
+		input    *SlackAuth
+		expected time.Duration
+	}{
+		"nil case": {
+			input:    nil,
+			expected: 10 * time.Minute,
+		},
+		"token without an update time": {
+			input:    &SlackAuth{ExpiresAt: int(now.Add(time.Minute).Unix())},
+			expected: 10 * time.Minute,
+		},
+		"token that lives longer than the duration": {
+			input:    &SlackAuth{ExpiresAt: int(now.Add(12 * time.Hour).Unix()), LastUpdated: now},
+			expected: 10 * time.Minute,
+		},
+		"token that lives shorter than the duration": {
+			input:    &SlackAuth{ExpiresAt: int(now.Add(4 * time.Minute).Unix()), LastUpdated: now},
+			expected: 2 * time.Minute,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.input.RefreshAhead(10*time.Minute))
+		})
+	}
+}
+
 func Test_SlackAuth_TokenIsExpired(t *testing.T) {
 	var token = "fakeToken"
 	var timeNow = int(time.Now().Unix())
@@ -24,6 +24,8 @@ import (
 
 const (
 	ErrAccessDenied                                  = "access_denied"
+	ErrActivityAlertRules                            = "activity_alert_rules_error"
+	ErrActivityLogStore                              = "activity_log_store_error"
 	ErrAddAppToProject                               = "add_app_to_project_error"
 	ErrAlreadyLoggedOut                              = "already_logged_out"
 	ErrAlreadyResolved                               = "already_resolved"
@@ -82,12 +84,14 @@ const (
 	ErrConnectedOrgDenied                            = "connected_org_denied"
 	ErrConnectedTeamDenied                           = "connected_team_denied"
 	ErrContextValueNotFound                          = "context_value_not_found"
+	ErrCredentialStore                               = "credential_store_error"
 	ErrCredentialsNotFound                           = "credentials_not_found"
 	ErrCustomizableInputMissingMatchingWorkflowInput = "customizable_input_missing_matching_workflow_input"
 	ErrCustomizableInputsNotAllowedOnOptionalInputs  = "customizable_inputs_not_allowed_on_optional_inputs"
 	ErrCustomizableInputsOnlyAllowedOnLinkTriggers   = "customizable_inputs_only_allowed_on_link_triggers"
 	ErrCustomizableInputUnsupportedType              = "customizable_input_unsupported_type"
 	ErrDatastore                                     = "datastore_error"
+	ErrDatastoreImportCheckpoint                     = "datastore_import_checkpoint_error"
 	ErrDatastoreMissingPrimaryKey                    = "datastore_missing_primary_key"
 	ErrDatastoreNotFound                             = "datastore_not_found"
 	ErrDefaultAppAccess                              = "default_app_access_error"
@@ -109,10 +113,12 @@ const (
 	ErrForbiddenTeam                                 = "forbidden_team"
 	ErrFreeTeamNotAllowed                            = "free_team_not_allowed"
 	ErrFunctionBelongsToAnotherApp                   = "function_belongs_to_another_app"
+	ErrFunctionInvoke                                = "function_invoke_error"
 	ErrFunctionNotFound                              = "function_not_found"
 	ErrGitNotFound                                   = "git_not_found"
 	ErrGitClone                                      = "git_clone_error"
 	ErrGitZipDownload                                = "git_zip_download_error"
+	ErrGoNotFound                                    = "go_not_found"
 	ErrHomeDirectoryAccessFailed                     = "home_directory_access_failed"
 	ErrHooksJSONLocation                             = "hooks_json_location_error"
 	ErrHostAppsDisallowUserScopes                    = "hosted_apps_disallow_user_scopes"
@@ -133,9 +139,11 @@ const (
 	ErrInvalidAuth                                   = "invalid_auth"
 	ErrInvalidChallenge                              = "invalid_challenge"
 	ErrInvalidChannelID                              = "invalid_channel_id"
+	ErrInvalidCredentialStore                        = "invalid_credential_store"
 	ErrInvalidCursor                                 = "invalid_cursor"
 	ErrInvalidDistributionType                       = "invalid_distribution_type"
 	ErrInvalidFlag                                   = "invalid_flag"
+	ErrInvalidFunctionParameters                     = "invalid_function_parameters"
 	ErrInvalidInteractiveTriggerInputs               = "invalid_interactive_trigger_inputs"
 	ErrInvalidManifest                               = "invalid_manifest"
 	ErrInvalidManifestSource                         = "invalid_manifest_source"
@@ -196,9 +204,11 @@ const (
 	ErrOrgNotFound                                   = "org_not_found"
 	ErrOrgGrantExists                                = "org_grant_exists"
 	ErrOSNotSupported                                = "os_not_supported"
+	ErrOTLPExport                                    = "otlp_export_error"
 	ErrOverResourceLimit                             = "over_resource_limit"
 	ErrParameterValidationFailed                     = "parameter_validation_failed"
 	ErrProcessInterrupted                            = "process_interrupted"
+	ErrProfileNotFound                               = "profile_not_found"
 	ErrProjectCompilation                            = "project_compilation_error"
 	ErrProjectConfigIDNotFound                       = "project_config_id_not_found"
 	ErrProjectConfigManifestSource                   = "project_config_manifest_source_error"
@@ -214,6 +224,7 @@ const (
 	ErrSDKHookInvocationFailed                       = "sdk_hook_invocation_failed"
 	ErrSDKHookNotFound                               = "sdk_hook_not_found"
 	ErrSDKHookGetTriggerNotFound                     = "sdk_hook_get_trigger_not_found"
+	ErrSDKHookTimeout                                = "sdk_hook_timeout"
 	ErrSampleCreate                                  = "sample_create_error"
 	ErrServiceLimitsExceeded                         = "service_limits_exceeded"
 	ErrSharedChannelDenied                           = "shared_channel_denied"
@@ -221,6 +232,7 @@ const (
 	ErrSlackJSONLocation                             = "slack_json_location_error"
 	ErrSlackSlackJSONLocation                        = "slack_slack_json_location_error"
 	ErrSocketConnection                              = "socket_connection_error"
+	ErrSocketSession                                 = "socket_session_error"
 	ErrScopesExceedAppConfig                         = "scopes_exceed_app_config"
 	ErrStreamingActivityLogs                         = "streaming_activity_logs_error"
 	ErrSurveyConfigNotFound                          = "survey_config_not_found"
@@ -263,6 +275,7 @@ const (
 	ErrUserNotFound                                  = "user_not_found"
 	ErrUserRemovedFromTeam                           = "user_removed_from_team"
 	ErrWorkflowNotFound                              = "workflow_not_found"
+	ErrWorkflowRun                                   = "workflow_run_error"
 	ErrYaml                                          = "yaml_error"
 )
 
@@ -274,6 +287,16 @@ var ErrorCodeMap = map[string]Error{
 		Remediation: "Check with your Slack admin to make sure that you have permission to access the resource.",
 	},
 
+	ErrActivityAlertRules: {
+		Code:    ErrActivityAlertRules,
+		Message: "The activity alert rules are not valid",
+	},
+
+	ErrActivityLogStore: {
+		Code:    ErrActivityLogStore,
+		Message: "Couldn't read or save activity logs in the project",
+	},
+
 	ErrAddAppToProject: {
 		Code:    ErrAddAppToProject,
 		Message: "Couldn't save your app's info to this project",
@@ -605,6 +628,11 @@ Otherwise start your app for local development with: %s`,
 		Message: "The context value could not be found",
 	},
 
+	ErrCredentialStore: {
+		Code:    ErrCredentialStore,
+		Message: "Saved authorizations could not be accessed",
+	},
+
 	ErrCredentialsNotFound: {
 		Code:        ErrCredentialsNotFound,
 		Message:     "No authentication found for this team",
@@ -636,6 +664,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "An error occurred while accessing your datastore",
 	},
 
+	ErrDatastoreImportCheckpoint: {
+		Code:        ErrDatastoreImportCheckpoint,
+		Message:     "The checkpoint of a datastore import could not be used",
+		Remediation: "Start the import again without the --resume flag",
+	},
+
 	ErrDatastoreMissingPrimaryKey: {
 		Code:    ErrDatastoreMissingPrimaryKey,
 		Message: "The primary key for the datastore is missing",
@@ -749,6 +783,11 @@ Otherwise start your app for local development with: %s`,
 		Message: "The provided function_id does not belong to this app_id",
 	},
 
+	ErrFunctionInvoke: {
+		Code:    ErrFunctionInvoke,
+		Message: "The function could not be invoked locally",
+	},
+
 	ErrFunctionNotFound: {
 		Code:    ErrFunctionNotFound,
 		Message: "The specified function couldn't be found",
@@ -770,6 +809,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "Cannot download Git repository as a .zip archive",
 	},
 
+	ErrGoNotFound: {
+		Code:        ErrGoNotFound,
+		Message:     "Couldn't find the 'go' language runtime installed on this system",
+		Remediation: "To install Go, visit https://go.dev/doc/install.",
+	},
+
 	ErrHomeDirectoryAccessFailed: {
 		Code:        ErrHomeDirectoryAccessFailed,
 		Message:     "Failed to read/create .slack/ directory in your home directory",
@@ -888,6 +933,12 @@ Otherwise start your app for local development with: %s`,
 		Remediation: "Channel ID appears to be formatted correctly. Check if this channel exists on the current team and that you have permissions to access it.",
 	},
 
+	ErrInvalidCredentialStore: {
+		Code:        ErrInvalidCredentialStore,
+		Message:     "The credential store is not supported",
+		Remediation: "Set SLACK_CREDENTIAL_STORE to one of \"file\", \"encrypted\", or \"keyring\"",
+	},
+
 	ErrInvalidCursor: {
 		Code:    ErrInvalidCursor,
 		Message: "Value passed for `cursor` was not valid or is valid no longer",
@@ -898,6 +949,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "The provided flag value is invalid",
 	},
 
+	ErrInvalidFunctionParameters: {
+		Code:        ErrInvalidFunctionParameters,
+		Message:     "The parameters of the function do not match the app manifest",
+		Remediation: "Check the input and output parameters of the function in the app manifest",
+	},
+
 	ErrInvalidDistributionType: {
 		Code:    ErrInvalidDistributionType,
 		Message: "This function requires distribution_type to be set as named_entities before adding users",
@@ -1216,6 +1273,11 @@ Otherwise start your app for local development with: %s`,
 		Message: "This operating system is not supported",
 	},
 
+	ErrOTLPExport: {
+		Code:    ErrOTLPExport,
+		Message: "Couldn't export activity logs as OpenTelemetry spans",
+	},
+
 	ErrOverResourceLimit: {
 		Code:    ErrOverResourceLimit,
 		Message: "Workspace exceeded the maximum number of Run On Slack functions and/or app datastores.",
@@ -1231,6 +1293,12 @@ Otherwise start your app for local development with: %s`,
 		Message: "The process received an interrupt signal",
 	},
 
+	ErrProfileNotFound: {
+		Code:        ErrProfileNotFound,
+		Message:     "No authorization was found for the profile",
+		Remediation: fmt.Sprintf("Save a login for the profile with %s", style.Commandf("login --profile <name>", false)),
+	},
+
 	ErrProjectCompilation: {
 		Code:    ErrProjectCompilation,
 		Message: "An error occurred while compiling your code",
@@ -1340,6 +1408,12 @@ Otherwise start your app for local development with: %s`,
 		Remediation: `Try defining your trigger by specifying a json file instead.`,
 	},
 
+	ErrSDKHookTimeout: {
+		Code:        ErrSDKHookTimeout,
+		Message:     fmt.Sprintf("A script hook defined in the Slack Configuration file (`%s`) didn't finish in time", filepath.Join(".slack", "hooks.json")),
+		Remediation: "Check that the hook doesn't wait for input or increase the seconds allowed for the hook with \"hook-timeouts\" in the \"config\" of the hooks file.",
+	},
+
 	ErrSlackAuth: {
 		Code:        ErrSlackAuth,
 		Message:     "You are not logged into a team or have not installed an app",
@@ -1369,6 +1443,11 @@ Otherwise start your app for local development with: %s`,
 		Message: "Couldn't connect to Slack over WebSocket",
 	},
 
+	ErrSocketSession: {
+		Code:    ErrSocketSession,
+		Message: "Couldn't record or replay the socket session",
+	},
+
 	ErrScopesExceedAppConfig: {
 		Code:    ErrScopesExceedAppConfig,
 		Message: "Scopes requested exceed app configuration",
@@ -1585,6 +1664,11 @@ Otherwise start your app for local development with: %s`,
 		Message: "Workflow not found",
 	},
 
+	ErrWorkflowRun: {
+		Code:    ErrWorkflowRun,
+		Message: "The workflow could not be run locally",
+	},
+
 	ErrYaml: {
 		Code:    ErrYaml,
 		Message: "An error occurred while parsing the app manifest YAML file",
@@ -0,0 +1,115 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package socketsession
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Diff returns a line for each value that differs between the recorded and
+// replayed responses. Responses that are not JSON are compared as text.
+func Diff(recorded json.RawMessage, replayed json.RawMessage) []string {
+	recordedValue, recordedOK := decode(recorded)
+	replayedValue, replayedOK := decode(replayed)
+	if !recordedOK || !replayedOK {
+		if bytes.Equal(bytes.TrimSpace(recorded), bytes.TrimSpace(replayed)) {
+			return []string{}
+		}
+		return []string{
+			fmt.Sprintf("- %s", strings.TrimSpace(string(recorded))),
+			fmt.Sprintf("+ %s", strings.TrimSpace(string(replayed))),
+		}
+	}
+	lines := []string{}
+	diffValues("", recordedValue, replayedValue, &lines)
+	return lines
+}
+
+// decode returns the value of a JSON document. An empty document is null.
+func decode(data json.RawMessage) (interface{}, bool) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, true
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// diffValues appends lines for differences between values at a path
+func diffValues(path string, recorded interface{}, replayed interface{}, lines *[]string) {
+	recordedObject, recordedIsObject := recorded.(map[string]interface{})
+	replayedObject, replayedIsObject := replayed.(map[string]interface{})
+	if recordedIsObject && replayedIsObject {
+		keys := map[string]bool{}
+		for key := range recordedObject {
+			keys[key] = true
+		}
+		for key := range replayedObject {
+			keys[key] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+		for _, key := range sorted {
+			recordedValue, inRecorded := recordedObject[key]
+			replayedValue, inReplayed := replayedObject[key]
+			switch {
+			case !inReplayed:
+				*lines = append(*lines, fmt.Sprintf("- %s: %s", joinPath(path, key), text(recordedValue)))
+			case !inRecorded:
+				*lines = append(*lines, fmt.Sprintf("+ %s: %s", joinPath(path, key), text(replayedValue)))
+			default:
+				diffValues(joinPath(path, key), recordedValue, replayedValue, lines)
+			}
+		}
+		return
+	}
+	if reflect.DeepEqual(recorded, replayed) {
+		return
+	}
+	if path == "" {
+		path = "."
+	}
+	*lines = append(*lines,
+		fmt.Sprintf("- %s: %s", path, text(recorded)),
+		fmt.Sprintf("+ %s: %s", path, text(replayed)),
+	)
+}
+
+// joinPath returns the path of a key within an object
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// text returns a value as compact JSON
+func text(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
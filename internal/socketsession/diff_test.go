@@ -0,0 +1,71 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package socketsession
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		recorded string
+		replayed string
+		expected []string
+	}{
+		"matches equal responses with keys in any order": {
+			recorded: `{"text": "hi", "blocks": [1, 2]}`,
+			replayed: `{"blocks": [1, 2], "text": "hi"}`,
+			expected: []string{},
+		},
+		"matches empty responses": {
+			recorded: ``,
+			replayed: `  `,
+			expected: []string{},
+		},
+		"lists changed, removed, and added values by path": {
+			recorded: `{"outputs": {"greeting": "Hello Ada", "count": 1}, "ok": true}`,
+			replayed: `{"outputs": {"greeting": "Hi Ada", "extra": [1]}, "ok": true}`,
+			expected: []string{
+				`- outputs.count: 1`,
+				`+ outputs.extra: [1]`,
+				`- outputs.greeting: "Hello Ada"`,
+				`+ outputs.greeting: "Hi Ada"`,
+			},
+		},
+		"lists changed arrays as a whole": {
+			recorded: `{"blocks": [1, 2]}`,
+			replayed: `{"blocks": [2, 1]}`,
+			expected: []string{`- blocks: [1,2]`, `+ blocks: [2,1]`},
+		},
+		"lists a changed document at the root": {
+			recorded: `{}`,
+			replayed: `[]`,
+			expected: []string{`- .: {}`, `+ .: []`},
+		},
+		"compares responses that are not JSON as text": {
+			recorded: `ok`,
+			replayed: `failed`,
+			expected: []string{`- ok`, `+ failed`},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Diff(json.RawMessage(tt.recorded), json.RawMessage(tt.replayed)))
+		})
+	}
+}
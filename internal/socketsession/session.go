@@ -0,0 +1,138 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package socketsession records the Socket Mode envelopes of a local run to a
+// session file so the envelopes can be replayed and compared later.
+package socketsession
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/goutils"
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+)
+
+// maxLineSize is the longest envelope that can be read from a session file
+const maxLineSize = 16 * 1024 * 1024
+
+// Envelope is a socket message received during a local run and the response
+// the app returned
+type Envelope struct {
+	// Type is the type of the socket message, such as "events_api"
+	Type string `json:"type"`
+	// EnvelopeID identifies the message to acknowledge
+	EnvelopeID string `json:"envelope_id,omitempty"`
+	// ReceivedAt is when the message was received
+	ReceivedAt time.Time `json:"received_at"`
+	// Payload is the event body that was piped through the start hook
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Stdout is the output the start hook wrote while handling the event
+	Stdout string `json:"stdout,omitempty"`
+	// Response is the payload sent back over the socket connection
+	Response json.RawMessage `json:"response,omitempty"`
+	// Error is the error of the start hook if it failed
+	Error string `json:"error,omitempty"`
+	// LatencyMs is the milliseconds between receiving the message and
+	// responding
+	LatencyMs int64 `json:"latency_ms"`
+}
+
+// Redacted returns the envelope with the tokens of the payload and response
+// replaced so recorded envelopes are safe to share
+func (e Envelope) Redacted() Envelope {
+	if len(e.Payload) > 0 {
+		e.Payload = goutils.RedactJSONTokens(e.Payload)
+	}
+	if len(e.Response) > 0 {
+		e.Response = goutils.RedactJSONTokens(e.Response)
+	}
+	return e
+}
+
+// Recorder appends envelopes to a session file as a line of JSON each
+type Recorder struct {
+	fs   afero.Fs
+	path string
+	mu   sync.Mutex
+}
+
+// NewRecorder returns a recorder that appends to the session file at path
+func NewRecorder(fs afero.Fs, path string) (*Recorder, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := fs.MkdirAll(dir, 0755); err != nil {
+			return nil, slackerror.Wrap(err, slackerror.ErrSocketSession)
+		}
+	}
+	return &Recorder{fs: fs, path: path}, nil
+}
+
+// Path returns the session file that envelopes are written to
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// Record appends an envelope to the session file with tokens redacted
+func (r *Recorder) Record(envelope Envelope) error {
+	line, err := json.Marshal(envelope.Redacted())
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrSocketSession)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, err := r.fs.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return slackerror.Wrap(err, slackerror.ErrSocketSession)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return slackerror.Wrap(err, slackerror.ErrSocketSession)
+	}
+	return nil
+}
+
+// ReadSession returns the envelopes of a session file in the order received
+func ReadSession(fs afero.Fs, path string) ([]Envelope, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrSocketSession).
+			WithMessage("The session file \"%s\" could not be read", path)
+	}
+	envelopes := []Envelope{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var envelope Envelope
+		if err := json.Unmarshal(scanner.Bytes(), &envelope); err != nil {
+			return nil, slackerror.Wrap(err, slackerror.ErrSocketSession).
+				WithMessage("Line %d of the session file \"%s\" is not an envelope", line, path)
+		}
+		envelopes = append(envelopes, envelope)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, slackerror.Wrap(err, slackerror.ErrSocketSession)
+	}
+	return envelopes, nil
+}
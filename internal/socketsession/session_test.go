@@ -0,0 +1,97 @@
+// Copyright 2022-2025 Salesforce, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package socketsession
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/toughtackle/slack-cli/internal/slackerror"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	path := filepath.Join("sessions", "session.jsonl")
+	recorder, err := NewRecorder(fs, path)
+	require.NoError(t, err)
+	assert.Equal(t, path, recorder.Path())
+
+	envelopes := []Envelope{
+		{
+			Type:       "events_api",
+			EnvelopeID: "E1",
+			ReceivedAt: time.Unix(1700000000, 0).UTC(),
+			Payload:    json.RawMessage(`{"event":{"type":"app_mention"}}`),
+			Stdout:     "handled the mention\n{}",
+			Response:   json.RawMessage(`{}`),
+			LatencyMs:  12,
+		},
+		{
+			Type:       "interactive",
+			EnvelopeID: "E2",
+			ReceivedAt: time.Unix(1700000001, 0).UTC(),
+			Payload:    json.RawMessage(`{"type":"block_actions"}`),
+			Error:      "the start hook failed",
+			LatencyMs:  3,
+		},
+	}
+	for _, envelope := range envelopes {
+		require.NoError(t, recorder.Record(envelope))
+	}
+
+	recorded, err := ReadSession(fs, path)
+	require.NoError(t, err)
+	assert.Equal(t, envelopes, recorded)
+}
+
+func TestEnvelope_Redacted(t *testing.T) {
+	envelope := Envelope{
+		Type:     "events_api",
+		Payload:  json.RawMessage(`{"token":"verification","event":{"type":"function_executed","bot_access_token":"xoxb-123"}}`),
+		Response: json.RawMessage(`{"outputs":{"token":"xoxp-456"}}`),
+	}
+	redacted := envelope.Redacted()
+	assert.JSONEq(t, `{"token":"...","event":{"type":"function_executed","bot_access_token":"..."}}`, string(redacted.Payload))
+	assert.JSONEq(t, `{"outputs":{"token":"..."}}`, string(redacted.Response))
+	assert.Contains(t, string(envelope.Payload), "xoxb-123")
+
+	fs := afero.NewMemMapFs()
+	recorder, err := NewRecorder(fs, "session.jsonl")
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(envelope))
+	data, err := afero.ReadFile(fs, "session.jsonl")
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "xoxb-123")
+	assert.NotContains(t, string(data), "xoxp-456")
+}
+
+func TestReadSession_Errors(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "session.jsonl", []byte("{\"type\":\"events_api\"}\n\nnot json\n"), 0600))
+
+	_, err := ReadSession(fs, "session.jsonl")
+	require.Error(t, err)
+	assert.Equal(t, slackerror.ErrSocketSession, slackerror.ToSlackError(err).Code)
+	assert.Contains(t, slackerror.ToSlackError(err).Message, "Line 3 of the session file")
+
+	_, err = ReadSession(fs, "missing.jsonl")
+	require.Error(t, err)
+	assert.Contains(t, slackerror.ToSlackError(err).Message, "could not be read")
+}
@@ -50,6 +50,11 @@ func ToggleSpinner(spins bool) {
 	isSpinAllowed = spins
 }
 
+// IsSpinnerEnabled returns true if the spinner animates in place
+func IsSpinnerEnabled() bool {
+	return isSpinAllowed
+}
+
 // Start makes the spinner active with prepared text
 func (s *Spinner) Start() {
 	s.spinner.Suffix = " " + s.text
@@ -120,6 +120,11 @@ func (u *UpdateNotification) Dependencies() []Dependency {
 func (u *UpdateNotification) PrintAndPromptUpdates(cmd *cobra.Command, cliVersion string) error {
 	ctx := cmd.Context()
 
+	// Structured output is parsed by scripts so notices are not added to it
+	if u.clients.IO.IsStructuredOutput() {
+		return nil
+	}
+
 	if updateNotification.WaitForCheckForUpdateInBackground() {
 		for _, dependency := range updateNotification.Dependencies() {
 			hasUpdate, err := dependency.HasUpdate()
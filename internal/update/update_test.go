@@ -21,6 +21,7 @@ import (
 	"github.com/toughtackle/slack-cli/internal/config"
 	"github.com/toughtackle/slack-cli/internal/hooks"
 	"github.com/toughtackle/slack-cli/internal/shared"
+	"github.com/toughtackle/slack-cli/internal/slackcontext"
 	"github.com/toughtackle/slack-cli/internal/slackdeps"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
@@ -109,3 +110,25 @@ func Test_Update_HasUpdate(t *testing.T) {
 		})
 	}
 }
+
+func Test_Update_PrintAndPromptUpdates(t *testing.T) {
+	ctx := slackcontext.MockContext(t.Context())
+	clientsMock := shared.NewClientsMock()
+	clientsMock.AddDefaultMocks()
+	clientsMock.Config.OutputFlag = "json"
+	clients := shared.NewClientFactory(clientsMock.MockClientFactory())
+	dependency := &mockDependency{}
+	updateNotification = &UpdateNotification{
+		clients:      clients,
+		enabled:      true,
+		envDisabled:  "SLACK_SKIP_UPDATE",
+		hoursToWait:  defaultHoursToWait,
+		dependencies: []Dependency{dependency},
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+
+	require.NoError(t, updateNotification.PrintAndPromptUpdates(cmd, "v1.0.0"))
+	dependency.AssertNotCalled(t, "HasUpdate")
+	dependency.AssertNotCalled(t, "PrintUpdateNotification", mock.Anything)
+}